@@ -29,6 +29,12 @@ const (
 	Query_DelegatorValidators_FullMethodName         = "/cosmos.distribution.v1beta1.Query/DelegatorValidators"
 	Query_DelegatorWithdrawAddress_FullMethodName    = "/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress"
 	Query_CommunityPool_FullMethodName               = "/cosmos.distribution.v1beta1.Query/CommunityPool"
+	Query_TotalRewardsBurned_FullMethodName          = "/cosmos.distribution.v1beta1.Query/TotalRewardsBurned"
+	Query_UndistributedFees_FullMethodName           = "/cosmos.distribution.v1beta1.Query/UndistributedFees"
+	Query_AllValidatorCommissions_FullMethodName     = "/cosmos.distribution.v1beta1.Query/AllValidatorCommissions"
+	Query_ValidatorMissedAllocations_FullMethodName  = "/cosmos.distribution.v1beta1.Query/ValidatorMissedAllocations"
+	Query_ValidatorSlashEvents_FullMethodName        = "/cosmos.distribution.v1beta1.Query/ValidatorSlashEvents"
+	Query_TotalOutstandingRewards_FullMethodName     = "/cosmos.distribution.v1beta1.Query/TotalOutstandingRewards"
 )
 
 // QueryClient is the client API for Query service.
@@ -56,6 +62,23 @@ type QueryClient interface {
 	DelegatorWithdrawAddress(ctx context.Context, in *QueryDelegatorWithdrawAddressRequest, opts ...grpc.CallOption) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// TotalRewardsBurned queries the cumulative rewards burned for the burn
+	// validators.
+	TotalRewardsBurned(ctx context.Context, in *QueryTotalRewardsBurnedRequest, opts ...grpc.CallOption) (*QueryTotalRewardsBurnedResponse, error)
+	// UndistributedFees queries the fees collected since the last allocation.
+	UndistributedFees(ctx context.Context, in *QueryUndistributedFeesRequest, opts ...grpc.CallOption) (*QueryUndistributedFeesResponse, error)
+	// AllValidatorCommissions queries the commission rates and accumulated
+	// commission of all validators.
+	AllValidatorCommissions(ctx context.Context, in *QueryAllValidatorCommissionsRequest, opts ...grpc.CallOption) (*QueryAllValidatorCommissionsResponse, error)
+	// ValidatorMissedAllocations queries the number of fee allocations a
+	// validator was skipped from.
+	ValidatorMissedAllocations(ctx context.Context, in *QueryValidatorMissedAllocationsRequest, opts ...grpc.CallOption) (*QueryValidatorMissedAllocationsResponse, error)
+	// ValidatorSlashEvents queries the slash events of a validator recorded
+	// within a block height range.
+	ValidatorSlashEvents(ctx context.Context, in *QueryValidatorSlashEventsRequest, opts ...grpc.CallOption) (*QueryValidatorSlashEventsResponse, error)
+	// TotalOutstandingRewards queries the sum of the outstanding rewards of all
+	// validators.
+	TotalOutstandingRewards(ctx context.Context, in *QueryTotalOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryTotalOutstandingRewardsResponse, error)
 }
 
 type queryClient struct {
@@ -156,6 +179,60 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) TotalRewardsBurned(ctx context.Context, in *QueryTotalRewardsBurnedRequest, opts ...grpc.CallOption) (*QueryTotalRewardsBurnedResponse, error) {
+	out := new(QueryTotalRewardsBurnedResponse)
+	err := c.cc.Invoke(ctx, Query_TotalRewardsBurned_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UndistributedFees(ctx context.Context, in *QueryUndistributedFeesRequest, opts ...grpc.CallOption) (*QueryUndistributedFeesResponse, error) {
+	out := new(QueryUndistributedFeesResponse)
+	err := c.cc.Invoke(ctx, Query_UndistributedFees_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllValidatorCommissions(ctx context.Context, in *QueryAllValidatorCommissionsRequest, opts ...grpc.CallOption) (*QueryAllValidatorCommissionsResponse, error) {
+	out := new(QueryAllValidatorCommissionsResponse)
+	err := c.cc.Invoke(ctx, Query_AllValidatorCommissions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorMissedAllocations(ctx context.Context, in *QueryValidatorMissedAllocationsRequest, opts ...grpc.CallOption) (*QueryValidatorMissedAllocationsResponse, error) {
+	out := new(QueryValidatorMissedAllocationsResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorMissedAllocations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorSlashEvents(ctx context.Context, in *QueryValidatorSlashEventsRequest, opts ...grpc.CallOption) (*QueryValidatorSlashEventsResponse, error) {
+	out := new(QueryValidatorSlashEventsResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorSlashEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalOutstandingRewards(ctx context.Context, in *QueryTotalOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryTotalOutstandingRewardsResponse, error) {
+	out := new(QueryTotalOutstandingRewardsResponse)
+	err := c.cc.Invoke(ctx, Query_TotalOutstandingRewards_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -181,6 +258,23 @@ type QueryServer interface {
 	DelegatorWithdrawAddress(context.Context, *QueryDelegatorWithdrawAddressRequest) (*QueryDelegatorWithdrawAddressResponse, error)
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// TotalRewardsBurned queries the cumulative rewards burned for the burn
+	// validators.
+	TotalRewardsBurned(context.Context, *QueryTotalRewardsBurnedRequest) (*QueryTotalRewardsBurnedResponse, error)
+	// UndistributedFees queries the fees collected since the last allocation.
+	UndistributedFees(context.Context, *QueryUndistributedFeesRequest) (*QueryUndistributedFeesResponse, error)
+	// AllValidatorCommissions queries the commission rates and accumulated
+	// commission of all validators.
+	AllValidatorCommissions(context.Context, *QueryAllValidatorCommissionsRequest) (*QueryAllValidatorCommissionsResponse, error)
+	// ValidatorMissedAllocations queries the number of fee allocations a
+	// validator was skipped from.
+	ValidatorMissedAllocations(context.Context, *QueryValidatorMissedAllocationsRequest) (*QueryValidatorMissedAllocationsResponse, error)
+	// ValidatorSlashEvents queries the slash events of a validator recorded
+	// within a block height range.
+	ValidatorSlashEvents(context.Context, *QueryValidatorSlashEventsRequest) (*QueryValidatorSlashEventsResponse, error)
+	// TotalOutstandingRewards queries the sum of the outstanding rewards of all
+	// validators.
+	TotalOutstandingRewards(context.Context, *QueryTotalOutstandingRewardsRequest) (*QueryTotalOutstandingRewardsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -218,6 +312,24 @@ func (UnimplementedQueryServer) DelegatorWithdrawAddress(context.Context, *Query
 func (UnimplementedQueryServer) CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (UnimplementedQueryServer) TotalRewardsBurned(context.Context, *QueryTotalRewardsBurnedRequest) (*QueryTotalRewardsBurnedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalRewardsBurned not implemented")
+}
+func (UnimplementedQueryServer) UndistributedFees(context.Context, *QueryUndistributedFeesRequest) (*QueryUndistributedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndistributedFees not implemented")
+}
+func (UnimplementedQueryServer) AllValidatorCommissions(context.Context, *QueryAllValidatorCommissionsRequest) (*QueryAllValidatorCommissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllValidatorCommissions not implemented")
+}
+func (UnimplementedQueryServer) ValidatorMissedAllocations(context.Context, *QueryValidatorMissedAllocationsRequest) (*QueryValidatorMissedAllocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorMissedAllocations not implemented")
+}
+func (UnimplementedQueryServer) ValidatorSlashEvents(context.Context, *QueryValidatorSlashEventsRequest) (*QueryValidatorSlashEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSlashEvents not implemented")
+}
+func (UnimplementedQueryServer) TotalOutstandingRewards(context.Context, *QueryTotalOutstandingRewardsRequest) (*QueryTotalOutstandingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalOutstandingRewards not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -411,6 +523,114 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TotalRewardsBurned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalRewardsBurnedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalRewardsBurned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TotalRewardsBurned_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalRewardsBurned(ctx, req.(*QueryTotalRewardsBurnedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UndistributedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUndistributedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UndistributedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UndistributedFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UndistributedFees(ctx, req.(*QueryUndistributedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllValidatorCommissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllValidatorCommissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllValidatorCommissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AllValidatorCommissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllValidatorCommissions(ctx, req.(*QueryAllValidatorCommissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorMissedAllocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorMissedAllocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorMissedAllocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorMissedAllocations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorMissedAllocations(ctx, req.(*QueryValidatorMissedAllocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorSlashEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorSlashEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSlashEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorSlashEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSlashEvents(ctx, req.(*QueryValidatorSlashEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalOutstandingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalOutstandingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalOutstandingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TotalOutstandingRewards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalOutstandingRewards(ctx, req.(*QueryTotalOutstandingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -458,6 +678,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "TotalRewardsBurned",
+			Handler:    _Query_TotalRewardsBurned_Handler,
+		},
+		{
+			MethodName: "UndistributedFees",
+			Handler:    _Query_UndistributedFees_Handler,
+		},
+		{
+			MethodName: "AllValidatorCommissions",
+			Handler:    _Query_AllValidatorCommissions_Handler,
+		},
+		{
+			MethodName: "ValidatorMissedAllocations",
+			Handler:    _Query_ValidatorMissedAllocations_Handler,
+		},
+		{
+			MethodName: "ValidatorSlashEvents",
+			Handler:    _Query_ValidatorSlashEvents_Handler,
+		},
+		{
+			MethodName: "TotalOutstandingRewards",
+			Handler:    _Query_TotalOutstandingRewards_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -19,20 +19,45 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Validators_FullMethodName                    = "/cosmos.staking.v1beta1.Query/Validators"
-	Query_Validator_FullMethodName                     = "/cosmos.staking.v1beta1.Query/Validator"
-	Query_ValidatorDelegations_FullMethodName          = "/cosmos.staking.v1beta1.Query/ValidatorDelegations"
-	Query_ValidatorUnbondingDelegations_FullMethodName = "/cosmos.staking.v1beta1.Query/ValidatorUnbondingDelegations"
-	Query_Delegation_FullMethodName                    = "/cosmos.staking.v1beta1.Query/Delegation"
-	Query_UnbondingDelegation_FullMethodName           = "/cosmos.staking.v1beta1.Query/UnbondingDelegation"
-	Query_DelegatorDelegations_FullMethodName          = "/cosmos.staking.v1beta1.Query/DelegatorDelegations"
-	Query_DelegatorUnbondingDelegations_FullMethodName = "/cosmos.staking.v1beta1.Query/DelegatorUnbondingDelegations"
-	Query_Redelegations_FullMethodName                 = "/cosmos.staking.v1beta1.Query/Redelegations"
-	Query_DelegatorValidators_FullMethodName           = "/cosmos.staking.v1beta1.Query/DelegatorValidators"
-	Query_DelegatorValidator_FullMethodName            = "/cosmos.staking.v1beta1.Query/DelegatorValidator"
-	Query_HistoricalInfo_FullMethodName                = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
-	Query_Pool_FullMethodName                          = "/cosmos.staking.v1beta1.Query/Pool"
-	Query_Params_FullMethodName                        = "/cosmos.staking.v1beta1.Query/Params"
+	Query_Validators_FullMethodName                      = "/cosmos.staking.v1beta1.Query/Validators"
+	Query_Validator_FullMethodName                       = "/cosmos.staking.v1beta1.Query/Validator"
+	Query_ValidatorDelegations_FullMethodName            = "/cosmos.staking.v1beta1.Query/ValidatorDelegations"
+	Query_ValidatorUnbondingDelegations_FullMethodName   = "/cosmos.staking.v1beta1.Query/ValidatorUnbondingDelegations"
+	Query_Delegation_FullMethodName                      = "/cosmos.staking.v1beta1.Query/Delegation"
+	Query_UnbondingDelegation_FullMethodName             = "/cosmos.staking.v1beta1.Query/UnbondingDelegation"
+	Query_DelegatorDelegations_FullMethodName            = "/cosmos.staking.v1beta1.Query/DelegatorDelegations"
+	Query_DelegatorUnbondingDelegations_FullMethodName   = "/cosmos.staking.v1beta1.Query/DelegatorUnbondingDelegations"
+	Query_Redelegations_FullMethodName                   = "/cosmos.staking.v1beta1.Query/Redelegations"
+	Query_DelegatorValidators_FullMethodName             = "/cosmos.staking.v1beta1.Query/DelegatorValidators"
+	Query_DelegatorValidator_FullMethodName              = "/cosmos.staking.v1beta1.Query/DelegatorValidator"
+	Query_HistoricalInfo_FullMethodName                  = "/cosmos.staking.v1beta1.Query/HistoricalInfo"
+	Query_Pool_FullMethodName                            = "/cosmos.staking.v1beta1.Query/Pool"
+	Query_Params_FullMethodName                          = "/cosmos.staking.v1beta1.Query/Params"
+	Query_ValidatorPowerDelta_FullMethodName             = "/cosmos.staking.v1beta1.Query/ValidatorPowerDelta"
+	Query_ValidatorCommissionDistribution_FullMethodName = "/cosmos.staking.v1beta1.Query/ValidatorCommissionDistribution"
+	Query_EstimateSlash_FullMethodName                   = "/cosmos.staking.v1beta1.Query/EstimateSlash"
+	Query_ValidatorsByMoniker_FullMethodName             = "/cosmos.staking.v1beta1.Query/ValidatorsByMoniker"
+	Query_ActiveSetHeadroom_FullMethodName               = "/cosmos.staking.v1beta1.Query/ActiveSetHeadroom"
+	Query_TotalStakedBreakdown_FullMethodName            = "/cosmos.staking.v1beta1.Query/TotalStakedBreakdown"
+	Query_ValidatorCreationHeight_FullMethodName         = "/cosmos.staking.v1beta1.Query/ValidatorCreationHeight"
+	Query_NakamotoCoefficient_FullMethodName             = "/cosmos.staking.v1beta1.Query/NakamotoCoefficient"
+	Query_ValidatorPowerHistory_FullMethodName           = "/cosmos.staking.v1beta1.Query/ValidatorPowerHistory"
+	Query_ValidatorsByStatus_FullMethodName              = "/cosmos.staking.v1beta1.Query/ValidatorsByStatus"
+	Query_SlashScenarioJails_FullMethodName              = "/cosmos.staking.v1beta1.Query/SlashScenarioJails"
+	Query_ZeroPowerBondedValidators_FullMethodName       = "/cosmos.staking.v1beta1.Query/ZeroPowerBondedValidators"
+	Query_ValidatorEvmOrigin_FullMethodName              = "/cosmos.staking.v1beta1.Query/ValidatorEvmOrigin"
+	Query_UnbondingValidatorQueueDepth_FullMethodName    = "/cosmos.staking.v1beta1.Query/UnbondingValidatorQueueDepth"
+	Query_ProspectiveRewardShare_FullMethodName          = "/cosmos.staking.v1beta1.Query/ProspectiveRewardShare"
+	Query_ValidatorConsPubKey_FullMethodName             = "/cosmos.staking.v1beta1.Query/ValidatorConsPubKey"
+	Query_ValidatorUnbonding_FullMethodName              = "/cosmos.staking.v1beta1.Query/ValidatorUnbonding"
+	Query_PendingEvmValidators_FullMethodName            = "/cosmos.staking.v1beta1.Query/PendingEvmValidators"
+	Query_ValidatorExchangeRate_FullMethodName           = "/cosmos.staking.v1beta1.Query/ValidatorExchangeRate"
+	Query_ValidatorsWithCommissionBelow_FullMethodName   = "/cosmos.staking.v1beta1.Query/ValidatorsWithCommissionBelow"
+	Query_ValidatorsStream_FullMethodName                = "/cosmos.staking.v1beta1.Query/ValidatorsStream"
+	Query_ValidatorJailHistory_FullMethodName            = "/cosmos.staking.v1beta1.Query/ValidatorJailHistory"
+	Query_TotalDelegatorShares_FullMethodName            = "/cosmos.staking.v1beta1.Query/TotalDelegatorShares"
+	Query_ValidatorsUnbondingSoon_FullMethodName         = "/cosmos.staking.v1beta1.Query/ValidatorsUnbondingSoon"
+	Query_ValidatorVotingPowerPercentage_FullMethodName  = "/cosmos.staking.v1beta1.Query/ValidatorVotingPowerPercentage"
 )
 
 // QueryClient is the client API for Query service.
@@ -92,6 +117,78 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ValidatorPowerDelta queries the current consensus power of a validator
+	// together with its power recorded at the end of the last block.
+	ValidatorPowerDelta(ctx context.Context, in *QueryValidatorPowerDeltaRequest, opts ...grpc.CallOption) (*QueryValidatorPowerDeltaResponse, error)
+	// ValidatorCommissionDistribution queries the number of validators whose
+	// commission rate falls into each bucket of the given size.
+	ValidatorCommissionDistribution(ctx context.Context, in *QueryValidatorCommissionDistributionRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionDistributionResponse, error)
+	// EstimateSlash queries the tokens a slash of the given fraction would burn
+	// from a validator together with the resulting validator tokens and shares.
+	EstimateSlash(ctx context.Context, in *QueryEstimateSlashRequest, opts ...grpc.CallOption) (*QueryEstimateSlashResponse, error)
+	// ValidatorsByMoniker queries the validators whose moniker contains the
+	// given substring, ignoring case.
+	ValidatorsByMoniker(ctx context.Context, in *QueryValidatorsByMonikerRequest, opts ...grpc.CallOption) (*QueryValidatorsByMonikerResponse, error)
+	// ActiveSetHeadroom queries how many more validators can join the active
+	// set and the power of the lowest active validator.
+	ActiveSetHeadroom(ctx context.Context, in *QueryActiveSetHeadroomRequest, opts ...grpc.CallOption) (*QueryActiveSetHeadroomResponse, error)
+	// TotalStakedBreakdown queries the total validator tokens per bond status.
+	TotalStakedBreakdown(ctx context.Context, in *QueryTotalStakedBreakdownRequest, opts ...grpc.CallOption) (*QueryTotalStakedBreakdownResponse, error)
+	// ValidatorCreationHeight queries the block height at which a validator was
+	// created.
+	ValidatorCreationHeight(ctx context.Context, in *QueryValidatorCreationHeightRequest, opts ...grpc.CallOption) (*QueryValidatorCreationHeightResponse, error)
+	// NakamotoCoefficient queries the minimum number of bonded validators whose
+	// combined power exceeds one third of the total bonded power.
+	NakamotoCoefficient(ctx context.Context, in *QueryNakamotoCoefficientRequest, opts ...grpc.CallOption) (*QueryNakamotoCoefficientResponse, error)
+	// ValidatorPowerHistory queries the latest consensus power changes of a
+	// validator.
+	ValidatorPowerHistory(ctx context.Context, in *QueryValidatorPowerHistoryRequest, opts ...grpc.CallOption) (*QueryValidatorPowerHistoryResponse, error)
+	// ValidatorsByStatus queries the validators with the given bond status.
+	ValidatorsByStatus(ctx context.Context, in *QueryValidatorsByStatusRequest, opts ...grpc.CallOption) (*QueryValidatorsByStatusResponse, error)
+	// SlashScenarioJails queries whether a slash of the given fraction would drop
+	// the self-delegation of a validator below its minimum, jailing it.
+	SlashScenarioJails(ctx context.Context, in *QuerySlashScenarioJailsRequest, opts ...grpc.CallOption) (*QuerySlashScenarioJailsResponse, error)
+	// ZeroPowerBondedValidators queries the bonded validators without consensus
+	// power. Such validators should never exist and indicate a bug.
+	ZeroPowerBondedValidators(ctx context.Context, in *QueryZeroPowerBondedValidatorsRequest, opts ...grpc.CallOption) (*QueryZeroPowerBondedValidatorsResponse, error)
+	// ValidatorEvmOrigin queries whether a validator was created through the EVM.
+	ValidatorEvmOrigin(ctx context.Context, in *QueryValidatorEvmOriginRequest, opts ...grpc.CallOption) (*QueryValidatorEvmOriginResponse, error)
+	// UnbondingValidatorQueueDepth queries the number of time slices and queued
+	// validators in the unbonding validator queue.
+	UnbondingValidatorQueueDepth(ctx context.Context, in *QueryUnbondingValidatorQueueDepthRequest, opts ...grpc.CallOption) (*QueryUnbondingValidatorQueueDepthResponse, error)
+	// ProspectiveRewardShare queries the fraction of the future rewards of a
+	// validator, net of commission, that a new delegation would earn.
+	ProspectiveRewardShare(ctx context.Context, in *QueryProspectiveRewardShareRequest, opts ...grpc.CallOption) (*QueryProspectiveRewardShareResponse, error)
+	// ValidatorConsPubKey queries the consensus public key of a validator.
+	ValidatorConsPubKey(ctx context.Context, in *QueryValidatorConsPubKeyRequest, opts ...grpc.CallOption) (*QueryValidatorConsPubKeyResponse, error)
+	// ValidatorUnbonding queries the unbonding state of a validator, including
+	// the holds that keep it from finalizing.
+	ValidatorUnbonding(ctx context.Context, in *QueryValidatorUnbondingRequest, opts ...grpc.CallOption) (*QueryValidatorUnbondingResponse, error)
+	// PendingEvmValidators queries the EVM validator registrations whose
+	// validator has not been created yet.
+	PendingEvmValidators(ctx context.Context, in *QueryPendingEvmValidatorsRequest, opts ...grpc.CallOption) (*QueryPendingEvmValidatorsResponse, error)
+	// ValidatorExchangeRate queries the tokens backing each delegator share of a
+	// validator.
+	ValidatorExchangeRate(ctx context.Context, in *QueryValidatorExchangeRateRequest, opts ...grpc.CallOption) (*QueryValidatorExchangeRateResponse, error)
+	// ValidatorsWithCommissionBelow queries the bonded validators whose
+	// commission rate is below the given rate, sorted by ascending rate.
+	ValidatorsWithCommissionBelow(ctx context.Context, in *QueryValidatorsWithCommissionBelowRequest, opts ...grpc.CallOption) (*QueryValidatorsWithCommissionBelowResponse, error)
+	// ValidatorsStream streams the validators matching the given status one at a
+	// time in store order, so clients can process large validator sets without
+	// buffering them whole.
+	ValidatorsStream(ctx context.Context, in *QueryValidatorsStreamRequest, opts ...grpc.CallOption) (Query_ValidatorsStreamClient, error)
+	// ValidatorJailHistory queries the latest jailings of a validator.
+	ValidatorJailHistory(ctx context.Context, in *QueryValidatorJailHistoryRequest, opts ...grpc.CallOption) (*QueryValidatorJailHistoryResponse, error)
+	// TotalDelegatorShares queries the sum of the delegator shares issued by all
+	// the validators.
+	TotalDelegatorShares(ctx context.Context, in *QueryTotalDelegatorSharesRequest, opts ...grpc.CallOption) (*QueryTotalDelegatorSharesResponse, error)
+	// ValidatorsUnbondingSoon queries the unbonding validators whose unbonding
+	// completes within the given duration from the current block time, with
+	// their estimated completion time and height, in queue order.
+	ValidatorsUnbondingSoon(ctx context.Context, in *QueryValidatorsUnbondingSoonRequest, opts ...grpc.CallOption) (*QueryValidatorsUnbondingSoonResponse, error)
+	// ValidatorVotingPowerPercentage queries the fraction of the total bonded
+	// power held by a validator.
+	ValidatorVotingPowerPercentage(ctx context.Context, in *QueryValidatorVotingPowerPercentageRequest, opts ...grpc.CallOption) (*QueryValidatorVotingPowerPercentageResponse, error)
 }
 
 type queryClient struct {
@@ -228,6 +325,254 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) ValidatorPowerDelta(ctx context.Context, in *QueryValidatorPowerDeltaRequest, opts ...grpc.CallOption) (*QueryValidatorPowerDeltaResponse, error) {
+	out := new(QueryValidatorPowerDeltaResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorPowerDelta_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorCommissionDistribution(ctx context.Context, in *QueryValidatorCommissionDistributionRequest, opts ...grpc.CallOption) (*QueryValidatorCommissionDistributionResponse, error) {
+	out := new(QueryValidatorCommissionDistributionResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorCommissionDistribution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateSlash(ctx context.Context, in *QueryEstimateSlashRequest, opts ...grpc.CallOption) (*QueryEstimateSlashResponse, error) {
+	out := new(QueryEstimateSlashResponse)
+	err := c.cc.Invoke(ctx, Query_EstimateSlash_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorsByMoniker(ctx context.Context, in *QueryValidatorsByMonikerRequest, opts ...grpc.CallOption) (*QueryValidatorsByMonikerResponse, error) {
+	out := new(QueryValidatorsByMonikerResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorsByMoniker_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ActiveSetHeadroom(ctx context.Context, in *QueryActiveSetHeadroomRequest, opts ...grpc.CallOption) (*QueryActiveSetHeadroomResponse, error) {
+	out := new(QueryActiveSetHeadroomResponse)
+	err := c.cc.Invoke(ctx, Query_ActiveSetHeadroom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalStakedBreakdown(ctx context.Context, in *QueryTotalStakedBreakdownRequest, opts ...grpc.CallOption) (*QueryTotalStakedBreakdownResponse, error) {
+	out := new(QueryTotalStakedBreakdownResponse)
+	err := c.cc.Invoke(ctx, Query_TotalStakedBreakdown_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorCreationHeight(ctx context.Context, in *QueryValidatorCreationHeightRequest, opts ...grpc.CallOption) (*QueryValidatorCreationHeightResponse, error) {
+	out := new(QueryValidatorCreationHeightResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorCreationHeight_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NakamotoCoefficient(ctx context.Context, in *QueryNakamotoCoefficientRequest, opts ...grpc.CallOption) (*QueryNakamotoCoefficientResponse, error) {
+	out := new(QueryNakamotoCoefficientResponse)
+	err := c.cc.Invoke(ctx, Query_NakamotoCoefficient_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorPowerHistory(ctx context.Context, in *QueryValidatorPowerHistoryRequest, opts ...grpc.CallOption) (*QueryValidatorPowerHistoryResponse, error) {
+	out := new(QueryValidatorPowerHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorPowerHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorsByStatus(ctx context.Context, in *QueryValidatorsByStatusRequest, opts ...grpc.CallOption) (*QueryValidatorsByStatusResponse, error) {
+	out := new(QueryValidatorsByStatusResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorsByStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SlashScenarioJails(ctx context.Context, in *QuerySlashScenarioJailsRequest, opts ...grpc.CallOption) (*QuerySlashScenarioJailsResponse, error) {
+	out := new(QuerySlashScenarioJailsResponse)
+	err := c.cc.Invoke(ctx, Query_SlashScenarioJails_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ZeroPowerBondedValidators(ctx context.Context, in *QueryZeroPowerBondedValidatorsRequest, opts ...grpc.CallOption) (*QueryZeroPowerBondedValidatorsResponse, error) {
+	out := new(QueryZeroPowerBondedValidatorsResponse)
+	err := c.cc.Invoke(ctx, Query_ZeroPowerBondedValidators_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorEvmOrigin(ctx context.Context, in *QueryValidatorEvmOriginRequest, opts ...grpc.CallOption) (*QueryValidatorEvmOriginResponse, error) {
+	out := new(QueryValidatorEvmOriginResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorEvmOrigin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbondingValidatorQueueDepth(ctx context.Context, in *QueryUnbondingValidatorQueueDepthRequest, opts ...grpc.CallOption) (*QueryUnbondingValidatorQueueDepthResponse, error) {
+	out := new(QueryUnbondingValidatorQueueDepthResponse)
+	err := c.cc.Invoke(ctx, Query_UnbondingValidatorQueueDepth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProspectiveRewardShare(ctx context.Context, in *QueryProspectiveRewardShareRequest, opts ...grpc.CallOption) (*QueryProspectiveRewardShareResponse, error) {
+	out := new(QueryProspectiveRewardShareResponse)
+	err := c.cc.Invoke(ctx, Query_ProspectiveRewardShare_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorConsPubKey(ctx context.Context, in *QueryValidatorConsPubKeyRequest, opts ...grpc.CallOption) (*QueryValidatorConsPubKeyResponse, error) {
+	out := new(QueryValidatorConsPubKeyResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorConsPubKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorUnbonding(ctx context.Context, in *QueryValidatorUnbondingRequest, opts ...grpc.CallOption) (*QueryValidatorUnbondingResponse, error) {
+	out := new(QueryValidatorUnbondingResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorUnbonding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingEvmValidators(ctx context.Context, in *QueryPendingEvmValidatorsRequest, opts ...grpc.CallOption) (*QueryPendingEvmValidatorsResponse, error) {
+	out := new(QueryPendingEvmValidatorsResponse)
+	err := c.cc.Invoke(ctx, Query_PendingEvmValidators_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorExchangeRate(ctx context.Context, in *QueryValidatorExchangeRateRequest, opts ...grpc.CallOption) (*QueryValidatorExchangeRateResponse, error) {
+	out := new(QueryValidatorExchangeRateResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorExchangeRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorsWithCommissionBelow(ctx context.Context, in *QueryValidatorsWithCommissionBelowRequest, opts ...grpc.CallOption) (*QueryValidatorsWithCommissionBelowResponse, error) {
+	out := new(QueryValidatorsWithCommissionBelowResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorsWithCommissionBelow_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorsStream(ctx context.Context, in *QueryValidatorsStreamRequest, opts ...grpc.CallOption) (Query_ValidatorsStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Query_ServiceDesc.Streams[0], Query_ValidatorsStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryValidatorsStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_ValidatorsStreamClient interface {
+	Recv() (*QueryValidatorsStreamResponse, error)
+	grpc.ClientStream
+}
+
+type queryValidatorsStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryValidatorsStreamClient) Recv() (*QueryValidatorsStreamResponse, error) {
+	m := new(QueryValidatorsStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *queryClient) ValidatorJailHistory(ctx context.Context, in *QueryValidatorJailHistoryRequest, opts ...grpc.CallOption) (*QueryValidatorJailHistoryResponse, error) {
+	out := new(QueryValidatorJailHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorJailHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalDelegatorShares(ctx context.Context, in *QueryTotalDelegatorSharesRequest, opts ...grpc.CallOption) (*QueryTotalDelegatorSharesResponse, error) {
+	out := new(QueryTotalDelegatorSharesResponse)
+	err := c.cc.Invoke(ctx, Query_TotalDelegatorShares_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorsUnbondingSoon(ctx context.Context, in *QueryValidatorsUnbondingSoonRequest, opts ...grpc.CallOption) (*QueryValidatorsUnbondingSoonResponse, error) {
+	out := new(QueryValidatorsUnbondingSoonResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorsUnbondingSoon_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorVotingPowerPercentage(ctx context.Context, in *QueryValidatorVotingPowerPercentageRequest, opts ...grpc.CallOption) (*QueryValidatorVotingPowerPercentageResponse, error) {
+	out := new(QueryValidatorVotingPowerPercentageResponse)
+	err := c.cc.Invoke(ctx, Query_ValidatorVotingPowerPercentage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -285,6 +630,78 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ValidatorPowerDelta queries the current consensus power of a validator
+	// together with its power recorded at the end of the last block.
+	ValidatorPowerDelta(context.Context, *QueryValidatorPowerDeltaRequest) (*QueryValidatorPowerDeltaResponse, error)
+	// ValidatorCommissionDistribution queries the number of validators whose
+	// commission rate falls into each bucket of the given size.
+	ValidatorCommissionDistribution(context.Context, *QueryValidatorCommissionDistributionRequest) (*QueryValidatorCommissionDistributionResponse, error)
+	// EstimateSlash queries the tokens a slash of the given fraction would burn
+	// from a validator together with the resulting validator tokens and shares.
+	EstimateSlash(context.Context, *QueryEstimateSlashRequest) (*QueryEstimateSlashResponse, error)
+	// ValidatorsByMoniker queries the validators whose moniker contains the
+	// given substring, ignoring case.
+	ValidatorsByMoniker(context.Context, *QueryValidatorsByMonikerRequest) (*QueryValidatorsByMonikerResponse, error)
+	// ActiveSetHeadroom queries how many more validators can join the active
+	// set and the power of the lowest active validator.
+	ActiveSetHeadroom(context.Context, *QueryActiveSetHeadroomRequest) (*QueryActiveSetHeadroomResponse, error)
+	// TotalStakedBreakdown queries the total validator tokens per bond status.
+	TotalStakedBreakdown(context.Context, *QueryTotalStakedBreakdownRequest) (*QueryTotalStakedBreakdownResponse, error)
+	// ValidatorCreationHeight queries the block height at which a validator was
+	// created.
+	ValidatorCreationHeight(context.Context, *QueryValidatorCreationHeightRequest) (*QueryValidatorCreationHeightResponse, error)
+	// NakamotoCoefficient queries the minimum number of bonded validators whose
+	// combined power exceeds one third of the total bonded power.
+	NakamotoCoefficient(context.Context, *QueryNakamotoCoefficientRequest) (*QueryNakamotoCoefficientResponse, error)
+	// ValidatorPowerHistory queries the latest consensus power changes of a
+	// validator.
+	ValidatorPowerHistory(context.Context, *QueryValidatorPowerHistoryRequest) (*QueryValidatorPowerHistoryResponse, error)
+	// ValidatorsByStatus queries the validators with the given bond status.
+	ValidatorsByStatus(context.Context, *QueryValidatorsByStatusRequest) (*QueryValidatorsByStatusResponse, error)
+	// SlashScenarioJails queries whether a slash of the given fraction would drop
+	// the self-delegation of a validator below its minimum, jailing it.
+	SlashScenarioJails(context.Context, *QuerySlashScenarioJailsRequest) (*QuerySlashScenarioJailsResponse, error)
+	// ZeroPowerBondedValidators queries the bonded validators without consensus
+	// power. Such validators should never exist and indicate a bug.
+	ZeroPowerBondedValidators(context.Context, *QueryZeroPowerBondedValidatorsRequest) (*QueryZeroPowerBondedValidatorsResponse, error)
+	// ValidatorEvmOrigin queries whether a validator was created through the EVM.
+	ValidatorEvmOrigin(context.Context, *QueryValidatorEvmOriginRequest) (*QueryValidatorEvmOriginResponse, error)
+	// UnbondingValidatorQueueDepth queries the number of time slices and queued
+	// validators in the unbonding validator queue.
+	UnbondingValidatorQueueDepth(context.Context, *QueryUnbondingValidatorQueueDepthRequest) (*QueryUnbondingValidatorQueueDepthResponse, error)
+	// ProspectiveRewardShare queries the fraction of the future rewards of a
+	// validator, net of commission, that a new delegation would earn.
+	ProspectiveRewardShare(context.Context, *QueryProspectiveRewardShareRequest) (*QueryProspectiveRewardShareResponse, error)
+	// ValidatorConsPubKey queries the consensus public key of a validator.
+	ValidatorConsPubKey(context.Context, *QueryValidatorConsPubKeyRequest) (*QueryValidatorConsPubKeyResponse, error)
+	// ValidatorUnbonding queries the unbonding state of a validator, including
+	// the holds that keep it from finalizing.
+	ValidatorUnbonding(context.Context, *QueryValidatorUnbondingRequest) (*QueryValidatorUnbondingResponse, error)
+	// PendingEvmValidators queries the EVM validator registrations whose
+	// validator has not been created yet.
+	PendingEvmValidators(context.Context, *QueryPendingEvmValidatorsRequest) (*QueryPendingEvmValidatorsResponse, error)
+	// ValidatorExchangeRate queries the tokens backing each delegator share of a
+	// validator.
+	ValidatorExchangeRate(context.Context, *QueryValidatorExchangeRateRequest) (*QueryValidatorExchangeRateResponse, error)
+	// ValidatorsWithCommissionBelow queries the bonded validators whose
+	// commission rate is below the given rate, sorted by ascending rate.
+	ValidatorsWithCommissionBelow(context.Context, *QueryValidatorsWithCommissionBelowRequest) (*QueryValidatorsWithCommissionBelowResponse, error)
+	// ValidatorsStream streams the validators matching the given status one at a
+	// time in store order, so clients can process large validator sets without
+	// buffering them whole.
+	ValidatorsStream(*QueryValidatorsStreamRequest, Query_ValidatorsStreamServer) error
+	// ValidatorJailHistory queries the latest jailings of a validator.
+	ValidatorJailHistory(context.Context, *QueryValidatorJailHistoryRequest) (*QueryValidatorJailHistoryResponse, error)
+	// TotalDelegatorShares queries the sum of the delegator shares issued by all
+	// the validators.
+	TotalDelegatorShares(context.Context, *QueryTotalDelegatorSharesRequest) (*QueryTotalDelegatorSharesResponse, error)
+	// ValidatorsUnbondingSoon queries the unbonding validators whose unbonding
+	// completes within the given duration from the current block time, with
+	// their estimated completion time and height, in queue order.
+	ValidatorsUnbondingSoon(context.Context, *QueryValidatorsUnbondingSoonRequest) (*QueryValidatorsUnbondingSoonResponse, error)
+	// ValidatorVotingPowerPercentage queries the fraction of the total bonded
+	// power held by a validator.
+	ValidatorVotingPowerPercentage(context.Context, *QueryValidatorVotingPowerPercentageRequest) (*QueryValidatorVotingPowerPercentageResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -334,6 +751,81 @@ func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*Query
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) ValidatorPowerDelta(context.Context, *QueryValidatorPowerDeltaRequest) (*QueryValidatorPowerDeltaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPowerDelta not implemented")
+}
+func (UnimplementedQueryServer) ValidatorCommissionDistribution(context.Context, *QueryValidatorCommissionDistributionRequest) (*QueryValidatorCommissionDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorCommissionDistribution not implemented")
+}
+func (UnimplementedQueryServer) EstimateSlash(context.Context, *QueryEstimateSlashRequest) (*QueryEstimateSlashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSlash not implemented")
+}
+func (UnimplementedQueryServer) ValidatorsByMoniker(context.Context, *QueryValidatorsByMonikerRequest) (*QueryValidatorsByMonikerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorsByMoniker not implemented")
+}
+func (UnimplementedQueryServer) ActiveSetHeadroom(context.Context, *QueryActiveSetHeadroomRequest) (*QueryActiveSetHeadroomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActiveSetHeadroom not implemented")
+}
+func (UnimplementedQueryServer) TotalStakedBreakdown(context.Context, *QueryTotalStakedBreakdownRequest) (*QueryTotalStakedBreakdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalStakedBreakdown not implemented")
+}
+func (UnimplementedQueryServer) ValidatorCreationHeight(context.Context, *QueryValidatorCreationHeightRequest) (*QueryValidatorCreationHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorCreationHeight not implemented")
+}
+func (UnimplementedQueryServer) NakamotoCoefficient(context.Context, *QueryNakamotoCoefficientRequest) (*QueryNakamotoCoefficientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NakamotoCoefficient not implemented")
+}
+func (UnimplementedQueryServer) ValidatorPowerHistory(context.Context, *QueryValidatorPowerHistoryRequest) (*QueryValidatorPowerHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorPowerHistory not implemented")
+}
+func (UnimplementedQueryServer) ValidatorsByStatus(context.Context, *QueryValidatorsByStatusRequest) (*QueryValidatorsByStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorsByStatus not implemented")
+}
+func (UnimplementedQueryServer) SlashScenarioJails(context.Context, *QuerySlashScenarioJailsRequest) (*QuerySlashScenarioJailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashScenarioJails not implemented")
+}
+func (UnimplementedQueryServer) ZeroPowerBondedValidators(context.Context, *QueryZeroPowerBondedValidatorsRequest) (*QueryZeroPowerBondedValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ZeroPowerBondedValidators not implemented")
+}
+func (UnimplementedQueryServer) ValidatorEvmOrigin(context.Context, *QueryValidatorEvmOriginRequest) (*QueryValidatorEvmOriginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorEvmOrigin not implemented")
+}
+func (UnimplementedQueryServer) UnbondingValidatorQueueDepth(context.Context, *QueryUnbondingValidatorQueueDepthRequest) (*QueryUnbondingValidatorQueueDepthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingValidatorQueueDepth not implemented")
+}
+func (UnimplementedQueryServer) ProspectiveRewardShare(context.Context, *QueryProspectiveRewardShareRequest) (*QueryProspectiveRewardShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProspectiveRewardShare not implemented")
+}
+func (UnimplementedQueryServer) ValidatorConsPubKey(context.Context, *QueryValidatorConsPubKeyRequest) (*QueryValidatorConsPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorConsPubKey not implemented")
+}
+func (UnimplementedQueryServer) ValidatorUnbonding(context.Context, *QueryValidatorUnbondingRequest) (*QueryValidatorUnbondingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorUnbonding not implemented")
+}
+func (UnimplementedQueryServer) PendingEvmValidators(context.Context, *QueryPendingEvmValidatorsRequest) (*QueryPendingEvmValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingEvmValidators not implemented")
+}
+func (UnimplementedQueryServer) ValidatorExchangeRate(context.Context, *QueryValidatorExchangeRateRequest) (*QueryValidatorExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorExchangeRate not implemented")
+}
+func (UnimplementedQueryServer) ValidatorsWithCommissionBelow(context.Context, *QueryValidatorsWithCommissionBelowRequest) (*QueryValidatorsWithCommissionBelowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorsWithCommissionBelow not implemented")
+}
+func (UnimplementedQueryServer) ValidatorsStream(*QueryValidatorsStreamRequest, Query_ValidatorsStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ValidatorsStream not implemented")
+}
+func (UnimplementedQueryServer) ValidatorJailHistory(context.Context, *QueryValidatorJailHistoryRequest) (*QueryValidatorJailHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorJailHistory not implemented")
+}
+func (UnimplementedQueryServer) TotalDelegatorShares(context.Context, *QueryTotalDelegatorSharesRequest) (*QueryTotalDelegatorSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalDelegatorShares not implemented")
+}
+func (UnimplementedQueryServer) ValidatorsUnbondingSoon(context.Context, *QueryValidatorsUnbondingSoonRequest) (*QueryValidatorsUnbondingSoonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorsUnbondingSoon not implemented")
+}
+func (UnimplementedQueryServer) ValidatorVotingPowerPercentage(context.Context, *QueryValidatorVotingPowerPercentageRequest) (*QueryValidatorVotingPowerPercentageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorVotingPowerPercentage not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -599,70 +1091,625 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
-// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Query_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.staking.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Validators",
-			Handler:    _Query_Validators_Handler,
-		},
-		{
-			MethodName: "Validator",
-			Handler:    _Query_Validator_Handler,
-		},
-		{
-			MethodName: "ValidatorDelegations",
-			Handler:    _Query_ValidatorDelegations_Handler,
-		},
-		{
-			MethodName: "ValidatorUnbondingDelegations",
-			Handler:    _Query_ValidatorUnbondingDelegations_Handler,
-		},
-		{
-			MethodName: "Delegation",
-			Handler:    _Query_Delegation_Handler,
-		},
-		{
-			MethodName: "UnbondingDelegation",
-			Handler:    _Query_UnbondingDelegation_Handler,
-		},
-		{
-			MethodName: "DelegatorDelegations",
-			Handler:    _Query_DelegatorDelegations_Handler,
-		},
-		{
-			MethodName: "DelegatorUnbondingDelegations",
-			Handler:    _Query_DelegatorUnbondingDelegations_Handler,
-		},
-		{
-			MethodName: "Redelegations",
-			Handler:    _Query_Redelegations_Handler,
-		},
-		{
-			MethodName: "DelegatorValidators",
-			Handler:    _Query_DelegatorValidators_Handler,
-		},
-		{
-			MethodName: "DelegatorValidator",
-			Handler:    _Query_DelegatorValidator_Handler,
-		},
-		{
-			MethodName: "HistoricalInfo",
-			Handler:    _Query_HistoricalInfo_Handler,
-		},
-		{
-			MethodName: "Pool",
-			Handler:    _Query_Pool_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
+func _Query_ValidatorPowerDelta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorPowerDeltaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorPowerDelta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorPowerDelta_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorPowerDelta(ctx, req.(*QueryValidatorPowerDeltaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorCommissionDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorCommissionDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorCommissionDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorCommissionDistribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorCommissionDistribution(ctx, req.(*QueryValidatorCommissionDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateSlash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateSlashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSlash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EstimateSlash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSlash(ctx, req.(*QueryEstimateSlashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorsByMoniker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorsByMonikerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorsByMoniker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorsByMoniker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorsByMoniker(ctx, req.(*QueryValidatorsByMonikerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActiveSetHeadroom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActiveSetHeadroomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActiveSetHeadroom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ActiveSetHeadroom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActiveSetHeadroom(ctx, req.(*QueryActiveSetHeadroomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalStakedBreakdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalStakedBreakdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalStakedBreakdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TotalStakedBreakdown_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalStakedBreakdown(ctx, req.(*QueryTotalStakedBreakdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorCreationHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorCreationHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorCreationHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorCreationHeight_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorCreationHeight(ctx, req.(*QueryValidatorCreationHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NakamotoCoefficient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNakamotoCoefficientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NakamotoCoefficient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_NakamotoCoefficient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NakamotoCoefficient(ctx, req.(*QueryNakamotoCoefficientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorPowerHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorPowerHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorPowerHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorPowerHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorPowerHistory(ctx, req.(*QueryValidatorPowerHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorsByStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorsByStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorsByStatus(ctx, req.(*QueryValidatorsByStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SlashScenarioJails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashScenarioJailsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SlashScenarioJails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SlashScenarioJails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SlashScenarioJails(ctx, req.(*QuerySlashScenarioJailsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ZeroPowerBondedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryZeroPowerBondedValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ZeroPowerBondedValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ZeroPowerBondedValidators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ZeroPowerBondedValidators(ctx, req.(*QueryZeroPowerBondedValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorEvmOrigin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorEvmOriginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorEvmOrigin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorEvmOrigin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorEvmOrigin(ctx, req.(*QueryValidatorEvmOriginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingValidatorQueueDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingValidatorQueueDepthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingValidatorQueueDepth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UnbondingValidatorQueueDepth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingValidatorQueueDepth(ctx, req.(*QueryUnbondingValidatorQueueDepthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProspectiveRewardShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProspectiveRewardShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProspectiveRewardShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProspectiveRewardShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProspectiveRewardShare(ctx, req.(*QueryProspectiveRewardShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorConsPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorConsPubKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorConsPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorConsPubKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorConsPubKey(ctx, req.(*QueryValidatorConsPubKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorUnbonding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorUnbondingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorUnbonding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorUnbonding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorUnbonding(ctx, req.(*QueryValidatorUnbondingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingEvmValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingEvmValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingEvmValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingEvmValidators_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingEvmValidators(ctx, req.(*QueryPendingEvmValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorExchangeRate(ctx, req.(*QueryValidatorExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorsWithCommissionBelow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorsWithCommissionBelowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorsWithCommissionBelow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorsWithCommissionBelow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorsWithCommissionBelow(ctx, req.(*QueryValidatorsWithCommissionBelowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(QueryValidatorsStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).ValidatorsStream(m, &queryValidatorsStreamServer{stream})
+}
+
+type Query_ValidatorsStreamServer interface {
+	Send(*QueryValidatorsStreamResponse) error
+	grpc.ServerStream
+}
+
+type queryValidatorsStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryValidatorsStreamServer) Send(m *QueryValidatorsStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Query_ValidatorJailHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorJailHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorJailHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorJailHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorJailHistory(ctx, req.(*QueryValidatorJailHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalDelegatorShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalDelegatorSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalDelegatorShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TotalDelegatorShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalDelegatorShares(ctx, req.(*QueryTotalDelegatorSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorsUnbondingSoon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorsUnbondingSoonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorsUnbondingSoon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorsUnbondingSoon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorsUnbondingSoon(ctx, req.(*QueryValidatorsUnbondingSoonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorVotingPowerPercentage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorVotingPowerPercentageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorVotingPowerPercentage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ValidatorVotingPowerPercentage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorVotingPowerPercentage(ctx, req.(*QueryValidatorVotingPowerPercentageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.staking.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Validators",
+			Handler:    _Query_Validators_Handler,
+		},
+		{
+			MethodName: "Validator",
+			Handler:    _Query_Validator_Handler,
+		},
+		{
+			MethodName: "ValidatorDelegations",
+			Handler:    _Query_ValidatorDelegations_Handler,
+		},
+		{
+			MethodName: "ValidatorUnbondingDelegations",
+			Handler:    _Query_ValidatorUnbondingDelegations_Handler,
+		},
+		{
+			MethodName: "Delegation",
+			Handler:    _Query_Delegation_Handler,
+		},
+		{
+			MethodName: "UnbondingDelegation",
+			Handler:    _Query_UnbondingDelegation_Handler,
+		},
+		{
+			MethodName: "DelegatorDelegations",
+			Handler:    _Query_DelegatorDelegations_Handler,
+		},
+		{
+			MethodName: "DelegatorUnbondingDelegations",
+			Handler:    _Query_DelegatorUnbondingDelegations_Handler,
+		},
+		{
+			MethodName: "Redelegations",
+			Handler:    _Query_Redelegations_Handler,
+		},
+		{
+			MethodName: "DelegatorValidators",
+			Handler:    _Query_DelegatorValidators_Handler,
+		},
+		{
+			MethodName: "DelegatorValidator",
+			Handler:    _Query_DelegatorValidator_Handler,
+		},
+		{
+			MethodName: "HistoricalInfo",
+			Handler:    _Query_HistoricalInfo_Handler,
+		},
+		{
+			MethodName: "Pool",
+			Handler:    _Query_Pool_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ValidatorPowerDelta",
+			Handler:    _Query_ValidatorPowerDelta_Handler,
+		},
+		{
+			MethodName: "ValidatorCommissionDistribution",
+			Handler:    _Query_ValidatorCommissionDistribution_Handler,
+		},
+		{
+			MethodName: "EstimateSlash",
+			Handler:    _Query_EstimateSlash_Handler,
+		},
+		{
+			MethodName: "ValidatorsByMoniker",
+			Handler:    _Query_ValidatorsByMoniker_Handler,
+		},
+		{
+			MethodName: "ActiveSetHeadroom",
+			Handler:    _Query_ActiveSetHeadroom_Handler,
+		},
+		{
+			MethodName: "TotalStakedBreakdown",
+			Handler:    _Query_TotalStakedBreakdown_Handler,
+		},
+		{
+			MethodName: "ValidatorCreationHeight",
+			Handler:    _Query_ValidatorCreationHeight_Handler,
+		},
+		{
+			MethodName: "NakamotoCoefficient",
+			Handler:    _Query_NakamotoCoefficient_Handler,
+		},
+		{
+			MethodName: "ValidatorPowerHistory",
+			Handler:    _Query_ValidatorPowerHistory_Handler,
+		},
+		{
+			MethodName: "ValidatorsByStatus",
+			Handler:    _Query_ValidatorsByStatus_Handler,
+		},
+		{
+			MethodName: "SlashScenarioJails",
+			Handler:    _Query_SlashScenarioJails_Handler,
+		},
+		{
+			MethodName: "ZeroPowerBondedValidators",
+			Handler:    _Query_ZeroPowerBondedValidators_Handler,
+		},
+		{
+			MethodName: "ValidatorEvmOrigin",
+			Handler:    _Query_ValidatorEvmOrigin_Handler,
+		},
+		{
+			MethodName: "UnbondingValidatorQueueDepth",
+			Handler:    _Query_UnbondingValidatorQueueDepth_Handler,
+		},
+		{
+			MethodName: "ProspectiveRewardShare",
+			Handler:    _Query_ProspectiveRewardShare_Handler,
+		},
+		{
+			MethodName: "ValidatorConsPubKey",
+			Handler:    _Query_ValidatorConsPubKey_Handler,
+		},
+		{
+			MethodName: "ValidatorUnbonding",
+			Handler:    _Query_ValidatorUnbonding_Handler,
+		},
+		{
+			MethodName: "PendingEvmValidators",
+			Handler:    _Query_PendingEvmValidators_Handler,
+		},
+		{
+			MethodName: "ValidatorExchangeRate",
+			Handler:    _Query_ValidatorExchangeRate_Handler,
+		},
+		{
+			MethodName: "ValidatorsWithCommissionBelow",
+			Handler:    _Query_ValidatorsWithCommissionBelow_Handler,
+		},
+		{
+			MethodName: "ValidatorJailHistory",
+			Handler:    _Query_ValidatorJailHistory_Handler,
+		},
+		{
+			MethodName: "TotalDelegatorShares",
+			Handler:    _Query_TotalDelegatorShares_Handler,
+		},
+		{
+			MethodName: "ValidatorsUnbondingSoon",
+			Handler:    _Query_ValidatorsUnbondingSoon_Handler,
+		},
+		{
+			MethodName: "ValidatorVotingPowerPercentage",
+			Handler:    _Query_ValidatorVotingPowerPercentage_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ValidatorsStream",
+			Handler:       _Query_ValidatorsStream_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/staking/v1beta1/query.proto",
 }
@@ -22,9 +22,9 @@ func (app *BaseApp) GRPCQueryRouter() *GRPCQueryRouter { return app.grpcQueryRou
 
 // RegisterGRPCServer registers gRPC services directly with the gRPC server.
 func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
-	// Define an interceptor for all gRPC queries: this interceptor will create
-	// a new sdk.Context, and pass it into the query handler.
-	interceptor := func(grpcCtx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	// queryContext creates a new sdk.Context for a gRPC query and attaches it
+	// into the gRPC's context.Context.
+	queryContext := func(grpcCtx context.Context) (_ context.Context, err error) {
 		// If there's some metadata in the context, retrieve it.
 		md, ok := metadata.FromIncomingContext(grpcCtx)
 		if !ok {
@@ -65,9 +65,30 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			app.logger.Error("failed to set gRPC header", "err", err)
 		}
 
+		return grpcCtx, nil
+	}
+
+	// Define an interceptor for all gRPC queries: this interceptor will create
+	// a new sdk.Context, and pass it into the query handler.
+	interceptor := func(grpcCtx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		grpcCtx, err = queryContext(grpcCtx)
+		if err != nil {
+			return nil, err
+		}
+
 		return handler(grpcCtx, req)
 	}
 
+	// Streaming queries get the same sdk.Context through their stream.
+	streamInterceptor := func(srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		grpcCtx, err := queryContext(stream.Context())
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, queryServerStream{ServerStream: stream, ctx: grpcCtx})
+	}
+
 	// Loop through all services and methods, add the interceptor, and register
 	// the service.
 	for _, data := range app.GRPCQueryRouter().serviceData {
@@ -87,14 +108,43 @@ func (app *BaseApp) RegisterGRPCServer(server gogogrpc.Server) {
 			}
 		}
 
+		newStreams := make([]grpc.StreamDesc, len(desc.Streams))
+		for i, stream := range desc.Streams {
+			streamHandler := stream.Handler
+			info := &grpc.StreamServerInfo{
+				FullMethod:     "/" + desc.ServiceName + "/" + stream.StreamName,
+				IsClientStream: stream.ClientStreams,
+				IsServerStream: stream.ServerStreams,
+			}
+			newStreams[i] = stream
+			newStreams[i].Handler = func(srv interface{}, stream grpc.ServerStream) error {
+				return grpcmiddleware.ChainStreamServer(
+					grpcrecovery.StreamServerInterceptor(),
+					streamInterceptor,
+				)(srv, stream, info, streamHandler)
+			}
+		}
+
 		newDesc := &grpc.ServiceDesc{
 			ServiceName: desc.ServiceName,
 			HandlerType: desc.HandlerType,
 			Methods:     newMethods,
-			Streams:     desc.Streams,
+			Streams:     newStreams,
 			Metadata:    desc.Metadata,
 		}
 
 		server.RegisterService(newDesc, data.handler)
 	}
 }
+
+// queryServerStream is a grpc.ServerStream carrying the sdk.Context of a
+// streaming query.
+type queryServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context implements grpc.ServerStream.
+func (s queryServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,102 @@
+package baseapp_test
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// heightStreamServer streams a single validator whose moniker is the height of
+// the sdk.Context attached to the stream.
+type heightStreamServer struct {
+	stakingtypes.UnimplementedQueryServer
+}
+
+func (heightStreamServer) ValidatorsStream(_ *stakingtypes.QueryValidatorsStreamRequest, stream stakingtypes.Query_ValidatorsStreamServer) error {
+	ctx := sdk.UnwrapSDKContext(stream.Context())
+	return stream.Send(&stakingtypes.QueryValidatorsStreamResponse{
+		Validator: stakingtypes.Validator{Description: stakingtypes.Description{Moniker: strconv.FormatInt(ctx.BlockHeight(), 10)}},
+	})
+}
+
+func TestRegisterGRPCServerStream(t *testing.T) {
+	suite := NewBaseAppSuite(t)
+	app := suite.baseApp
+	stakingtypes.RegisterQueryServer(app.GRPCQueryRouter(), &heightStreamServer{})
+
+	app.InitChain(abci.RequestInitChain{ConsensusParams: &tmproto.ConsensusParams{}})
+	for height := int64(1); height <= 2; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		app.Commit()
+	}
+
+	// serve the app over a real gRPC connection
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(grpc.ForceServerCodec(suite.cdc.GRPCCodec()))
+	app.RegisterGRPCServer(server)
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	conn, err := grpc.Dial(
+		"bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(suite.cdc.GRPCCodec())),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := stakingtypes.NewQueryClient(conn)
+
+	testCases := []struct {
+		name   string
+		header string
+		expErr bool
+		expHgt string
+	}{
+		{"latest height", "", false, "2"},
+		{"height header", "1", false, "1"},
+		{"invalid height header", "foo", true, ""},
+		{"negative height header", "-1", true, ""},
+		{"future height header", "3", true, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.header != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, tc.header)
+			}
+
+			stream, err := client.ValidatorsStream(ctx, &stakingtypes.QueryValidatorsStreamRequest{})
+			require.NoError(t, err)
+
+			res, err := stream.Recv()
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// the handler ran with an sdk.Context at the queried height
+			require.Equal(t, tc.expHgt, res.Validator.Description.Moniker)
+
+			header, err := stream.Header()
+			require.NoError(t, err)
+			require.Equal(t, []string{tc.expHgt}, header.Get(grpctypes.GRPCBlockHeightHeader))
+		})
+	}
+}
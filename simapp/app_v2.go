@@ -342,6 +342,18 @@ func (app *SimApp) GetKey(storeKey string) *storetypes.KVStoreKey {
 	return kvStoreKey
 }
 
+// GetTKey returns the TransientStoreKey for the provided store key.
+//
+// NOTE: This is solely to be used for testing purposes.
+func (app *SimApp) GetTKey(storeKey string) *storetypes.TransientStoreKey {
+	sk := app.UnsafeFindStoreKey(storeKey)
+	tStoreKey, ok := sk.(*storetypes.TransientStoreKey)
+	if !ok {
+		return nil
+	}
+	return tStoreKey
+}
+
 func (app *SimApp) kvStoreKeys() map[string]*storetypes.KVStoreKey {
 	keys := make(map[string]*storetypes.KVStoreKey)
 	for _, k := range app.GetStoreKeys() {
@@ -5,6 +5,7 @@ type GovEventType int
 const (
 	GovEventCheckValidatorStatus GovEventType = 1 // check validator status
 	GovEventSetValidatorStatus   GovEventType = 2 // set validator status
+	GovEventQueryValidatorStatus GovEventType = 3 // query validator status
 )
 
 type GovEvent struct {
@@ -12,4 +13,11 @@ type GovEvent struct {
 	Data interface{}
 }
 
+// ValidatorStatusQuery is the data of a GovEventQueryValidatorStatus event, the
+// callback reports the validator status by setting Status.
+type ValidatorStatusQuery struct {
+	ValidatorAddress string
+	Status           string
+}
+
 type GovEventCallback func(ctx Context, e *GovEvent) error
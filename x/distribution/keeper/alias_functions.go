@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
@@ -11,6 +13,11 @@ func (k Keeper) GetValidatorOutstandingRewardsCoins(ctx sdk.Context, val sdk.Val
 	return k.GetValidatorOutstandingRewards(ctx, val).Rewards
 }
 
+// get the truncated integer outstanding rewards of a single denom
+func (k Keeper) GetValidatorOutstandingRewardsDenom(ctx sdk.Context, val sdk.ValAddress, denom string) math.Int {
+	return k.GetValidatorOutstandingRewardsCoins(ctx, val).AmountOf(denom).TruncateInt()
+}
+
 // get the community coins
 func (k Keeper) GetFeePoolCommunityCoins(ctx sdk.Context) sdk.DecCoins {
 	return k.GetFeePool(ctx).CommunityPool
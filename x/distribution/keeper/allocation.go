@@ -1,9 +1,14 @@
 package keeper
 
 import (
+	"bytes"
+	"sort"
+	"strconv"
+
 	"cosmossdk.io/math"
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -19,27 +24,43 @@ func (k Keeper) AllocateTokens(ctx sdk.Context, totalPreviousPower int64, bonded
 	// called in BeginBlock, collected fees will be from the previous block
 	// (and distributed to the previous proposer)
 	feeCollector := k.authKeeper.GetModuleAccount(ctx, k.feeCollectorName)
-	feesCollectedInt := k.bankKeeper.GetAllBalances(ctx, feeCollector.GetAddress())
+	feesCollectedInt := k.drainedFees(params, k.bankKeeper.GetAllBalances(ctx, feeCollector.GetAddress()))
+	// the voter share is left in the fee collector
+	voterFees := sdk.NewCoins()
+	// the fractional miner fees carried over from previous blocks
+	var carry sdk.DecCoins
 	if !ratio.IsZero() {
-		minerRatio := math.LegacyOneDec().Sub(ratio)
-		balances := sdk.NewDecCoinsFromCoins(feesCollectedInt...)
-		feeMultiplier := balances.MulDecTruncate(minerRatio)
-		feesCollectedInt = k.DecCoins2Coins(feeMultiplier)
-		logger.Info("[mint] AllocateTokens", "miner-ratio", minerRatio, "balances", balances, "miner-fees", feesCollectedInt)
+		totalFees := feesCollectedInt
+		feesCollectedInt, carry = k.minerFees(ctx, ratio, totalFees)
+		voterFees = totalFees.Sub(sdk.NewCoins(feesCollectedInt...)...)
+		logger.Info("[mint] AllocateTokens", "miner-ratio", math.LegacyOneDec().Sub(ratio), "balances", totalFees, "miner-fees", feesCollectedInt)
 	}
 	feesCollected := sdk.NewDecCoinsFromCoins(feesCollectedInt...)
 	// transfer collected fees to the distribution module account
+	// a failed transfer must not halt the chain: the fees are left in the fee
+	// collector and picked up again on the next block
 	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, feesCollectedInt)
 	if err != nil {
-		panic(err)
+		logger.Error("[distribution] failed to transfer collected fees, skipping allocation", "fees", feesCollectedInt.String(), "error", err.Error())
+		return
 	}
+	k.SetFeeCarry(ctx, carry)
+
+	k.emitAllocationEvent(ctx,
+		sdk.NewEvent(
+			types.EventTypeFeeSplit,
+			sdk.NewAttribute(types.AttributeKeyMinerAmount, sdk.NewCoins(feesCollectedInt...).String()),
+			sdk.NewAttribute(types.AttributeKeyVoterAmount, voterAmount(feesCollectedInt, voterFees).String()),
+		),
+	)
 
 	// temporary workaround to keep CanWithdrawInvariant happy
 	// general discussions here: https://github.com/cosmos/cosmos-sdk/issues/2906#issuecomment-441867634
-	feePool := k.GetFeePool(ctx)
 	if totalPreviousPower == 0 {
+		feePool := k.GetFeePool(ctx)
 		feePool.CommunityPool = feePool.CommunityPool.Add(feesCollected...)
 		k.SetFeePool(ctx, feePool)
+		k.emitAllocationSummary(ctx, feesCollectedInt, voterFees, allocationTotals{communityPool: feesCollected})
 		return
 	}
 
@@ -49,48 +70,387 @@ func (k Keeper) AllocateTokens(ctx sdk.Context, totalPreviousPower int64, bonded
 	voteMultiplier := math.LegacyOneDec().Sub(communityTax)
 	feeMultiplier := feesCollected.MulDecTruncate(voteMultiplier)
 
+	// the treasury takes its cut off the top, the rest is split by power
+	var treasury sdk.DecCoins
+	if cut := k.treasuryCut(params, feeMultiplier); !cut.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.treasuryModule, cut); err != nil {
+			logger.Error("[distribution] failed to transfer the treasury cut, skipping it", "cut", cut.String(), "error", err.Error())
+		} else {
+			treasury = sdk.NewDecCoinsFromCoins(cut...)
+			feeMultiplier = feeMultiplier.Sub(treasury)
+			remaining = remaining.Sub(treasury)
+			k.emitAllocationEvent(ctx,
+				sdk.NewEvent(
+					types.EventTypeTreasuryCut,
+					sdk.NewAttribute(sdk.AttributeKeyAmount, cut.String()),
+				),
+			)
+		}
+	}
+
 	// allocate tokens proportionally to voting power
 	//
 	// TODO: Consider parallelizing later
 	//
 	// Ref: https://github.com/cosmos/cosmos-sdk/pull/3099#discussion_r246276376
+	burnValidators := burnValidatorSet(params)
+	var totals allocationTotals
+	var distributed sdk.DecCoins
+	var votedPower int64
+	var recipients []stakingtypes.ValidatorI
 	for _, vote := range bondedVotes {
-		validator := k.stakingKeeper.ValidatorByConsAddr(ctx, vote.Validator.Address)
 		// TODO: Consider micro-slashing for missing votes.
 		//
 		// Ref: https://github.com/cosmos/cosmos-sdk/issues/2525#issuecomment-430838701
 		powerFraction := math.LegacyNewDec(vote.Validator.Power).QuoTruncate(math.LegacyNewDec(totalPreviousPower))
 		reward := feeMultiplier.MulDecTruncate(powerFraction)
-		k.allocateTokensToBeneficiaries(ctx, validator, reward)
+		distributed = distributed.Add(reward...)
+		votedPower += vote.Validator.Power
+
+		validator := k.stakingKeeper.ValidatorByConsAddr(ctx, vote.Validator.Address)
+		// the validator may have been removed since it voted, its share is
+		// left to the community pool
+		if validator == nil {
+			logger.Error("[distribution] validator not found, skipping allocation", "consAddr", sdk.ConsAddress(vote.Validator.Address).String())
+			continue
+		}
+		if params.WithholdJailedRewards && validator.IsJailed() {
+			logger.Info("[distribution] validator jailed, skipping allocation", "validator", validator.GetOperator().String())
+			k.IncrementValidatorMissedAllocations(ctx, vote.Validator.Address)
+			continue
+		}
+		// rewards withheld for low participation are left to the community pool
+		if penalty := k.participationPenalty(ctx, params.ParticipationPenalty, vote); penalty.IsPositive() {
+			reward = reward.Sub(reward.MulDecTruncate(penalty))
+		}
+		totals.add(k.allocateTokensToBeneficiaries(ctx, validator, reward, burnValidators))
 		remaining = remaining.Sub(reward)
+		recipients = append(recipients, validator)
 	}
 
-	// allocate community funding
+	// the dust truncated from the validator shares is credited to a single
+	// validator, rotating through the allocated validators across blocks
+	votedShare := feeMultiplier.MulDecTruncate(math.LegacyNewDec(votedPower).QuoTruncate(math.LegacyNewDec(totalPreviousPower)))
+	if dust, hasNeg := votedShare.SafeSub(distributed); !hasNeg && !dust.IsZero() && len(recipients) > 0 {
+		index := k.GetDustRecipientIndex(ctx)
+		totals.add(k.allocateTokensToBeneficiaries(ctx, recipients[index%uint64(len(recipients))], dust, burnValidators))
+		remaining = remaining.Sub(dust)
+		k.SetDustRecipientIndex(ctx, index+1)
+	}
+
+	// allocate community funding, the fee pool is loaded only now as capped
+	// validator rewards overflow into it
+	feePool := k.GetFeePool(ctx)
 	feePool.CommunityPool = feePool.CommunityPool.Add(remaining...)
 	k.SetFeePool(ctx, feePool)
+
+	totals.communityPool = remaining
+	totals.treasury = treasury
+	totals.validators = len(recipients)
+	k.emitAllocationSummary(ctx, feesCollectedInt, voterFees, totals)
+}
+
+// allocationTotals holds where the fees of a block reward allocation went.
+type allocationTotals struct {
+	// rewards accrued by the validators
+	rewards sdk.DecCoins
+	// rewards of the burn validators
+	burned sdk.DecCoins
+	// rewards past the MaxAccruedRewards cap, sent to the community pool
+	overflow sdk.DecCoins
+	// cut sent to the treasury module
+	treasury sdk.DecCoins
+	// remainder left to the community pool
+	communityPool sdk.DecCoins
+	// number of rewarded validators
+	validators int
+}
+
+// add accumulates the allocation to a single validator.
+func (t *allocationTotals) add(a beneficiaryAllocation) {
+	t.rewards = t.rewards.Add(a.rewards...)
+	t.burned = t.burned.Add(a.burned...)
+	t.overflow = t.overflow.Add(a.overflow...)
+}
+
+// emitAllocationSummary emits the totals of the block reward allocation in a
+// single event when the event verbosity is reduced to a summary.
+func (k Keeper) emitAllocationSummary(ctx sdk.Context, minerFees, voterFees sdk.Coins, totals allocationTotals) {
+	if k.eventVerbosity != types.EventVerbositySummary {
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAllocationSummary,
+			sdk.NewAttribute(types.AttributeKeyMinerAmount, sdk.NewCoins(minerFees...).String()),
+			sdk.NewAttribute(types.AttributeKeyVoterAmount, voterAmount(minerFees, voterFees).String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, totals.rewards.String()),
+			sdk.NewAttribute(types.AttributeKeyBurned, totals.burned.String()),
+			sdk.NewAttribute(types.AttributeKeyOverflow, totals.overflow.String()),
+			sdk.NewAttribute(types.AttributeKeyTreasury, totals.treasury.String()),
+			sdk.NewAttribute(types.AttributeKeyCommunityPool, totals.communityPool.String()),
+			sdk.NewAttribute(types.AttributeKeyValidators, strconv.Itoa(totals.validators)),
+		),
+	)
+}
+
+// voterAmount returns the voter share of the fees, as explicit zero coins of
+// the denoms of the miner fees when there is none.
+func voterAmount(minerFees, voterFees sdk.Coins) sdk.Coins {
+	if !voterFees.IsZero() {
+		return voterFees
+	}
+
+	// Note, we do not call the NewCoins constructor as we do not want the zero
+	// coins removed.
+	zero := make(sdk.Coins, 0, len(minerFees))
+	for _, fee := range minerFees {
+		zero = append(zero, sdk.NewCoin(fee.Denom, math.ZeroInt()))
+	}
+
+	return zero
+}
+
+// minerFees returns the share of the fees allocated to the validators given the
+// voter rewards ratio. Only whole units can be transferred, the remainder is
+// kept in the fee collector and returned as carry to be added back on the next
+// block.
+func (k Keeper) minerFees(ctx sdk.Context, ratio math.LegacyDec, fees sdk.Coins) (sdk.Coins, sdk.DecCoins) {
+	minerRatio := math.LegacyOneDec().Sub(ratio)
+	feeMultiplier := sdk.NewDecCoinsFromCoins(fees...).MulDecTruncate(minerRatio)
+	return feeMultiplier.Add(k.GetFeeCarry(ctx)...).TruncateDecimal()
+}
+
+// drainedFees returns the share of the fee collector balance drained for
+// allocation given the FeeDrainFraction param. Only whole units are drained,
+// the remainder is kept in the fee collector as a reserve.
+func (k Keeper) drainedFees(params types.Params, balance sdk.Coins) sdk.Coins {
+	fraction := params.DrainFraction()
+	if fraction.GTE(math.LegacyOneDec()) {
+		return balance
+	}
+
+	drained, _ := sdk.NewDecCoinsFromCoins(balance...).MulDecTruncate(fraction).TruncateDecimal()
+	return drained
+}
+
+// treasuryCut returns the share of the validator rewards sent to the treasury
+// module given the TreasuryTax param. Only whole units are sent, the remainder
+// is split by power along with the rest of the rewards.
+func (k Keeper) treasuryCut(params types.Params, rewards sdk.DecCoins) sdk.Coins {
+	tax := params.TreasuryTaxRate()
+	if k.treasuryModule == "" || !tax.IsPositive() {
+		return nil
+	}
+
+	cut, _ := rewards.MulDecTruncate(tax).TruncateDecimal()
+	return cut
+}
+
+// SimulateAllocation returns the rewards each bonded validator would receive,
+// and the remainder left to the community pool, if the given fees were
+// allocated on the next block. Validators are weighted by their current
+// consensus power and assumed to sign the block. The state is not modified.
+func (k Keeper) SimulateAllocation(ctx sdk.Context, assumedFees sdk.Coins) ([]types.ValidatorAllocation, sdk.DecCoins, error) {
+	params := k.GetParams(ctx)
+	powerReduction := k.stakingKeeper.PowerReduction(ctx)
+
+	minerFees := k.drainedFees(params, assumedFees)
+	if !params.VoterRewards.Ratio.IsZero() {
+		minerFees, _ = k.minerFees(ctx, params.VoterRewards.Ratio, minerFees)
+	}
+	feesCollected := sdk.NewDecCoinsFromCoins(minerFees...)
+
+	var validators []stakingtypes.ValidatorI
+	totalPower := int64(0)
+	k.stakingKeeper.IterateValidators(ctx, func(_ int64, validator stakingtypes.ValidatorI) (stop bool) {
+		if validator.IsBonded() {
+			validators = append(validators, validator)
+			totalPower += validator.GetConsensusPower(powerReduction)
+		}
+		return false
+	})
+
+	allocations := []types.ValidatorAllocation{}
+	if totalPower == 0 {
+		return allocations, feesCollected, nil
+	}
+
+	remaining := feesCollected
+	voteMultiplier := math.LegacyOneDec().Sub(k.GetCommunityTax(ctx))
+	feeMultiplier := feesCollected.MulDecTruncate(voteMultiplier)
+	if cut := k.treasuryCut(params, feeMultiplier); !cut.IsZero() {
+		cutDec := sdk.NewDecCoinsFromCoins(cut...)
+		feeMultiplier = feeMultiplier.Sub(cutDec)
+		remaining = remaining.Sub(cutDec)
+	}
+	rewards := make([]sdk.DecCoins, len(validators))
+	var distributed sdk.DecCoins
+	for i, validator := range validators {
+		powerFraction := math.LegacyNewDec(validator.GetConsensusPower(powerReduction)).QuoTruncate(math.LegacyNewDec(totalPower))
+		reward := feeMultiplier.MulDecTruncate(powerFraction)
+		distributed = distributed.Add(reward...)
+		if pp := params.ParticipationPenalty; pp != nil && pp.Window > 0 {
+			consAddr, err := validator.GetConsAddr()
+			if err != nil {
+				return nil, nil, err
+			}
+			signedBlocks := trimParticipation(append(k.GetValidatorParticipation(ctx, consAddr).SignedBlocks, true), pp.Window)
+			if penalty := penaltyForParticipation(pp, signedBlocks); penalty.IsPositive() {
+				reward = reward.Sub(reward.MulDecTruncate(penalty))
+			}
+		}
+		rewards[i] = reward
+	}
+
+	// the dust truncated from the validator shares goes to the next validator
+	// in the rotation, the votes of a block being ordered like the validator
+	// set, by decreasing power and then by address
+	votedShare := feeMultiplier.MulDecTruncate(math.LegacyNewDec(totalPower).QuoTruncate(math.LegacyNewDec(totalPower)))
+	if dust, hasNeg := votedShare.SafeSub(distributed); !hasNeg && !dust.IsZero() {
+		voteOrder := make([]int, len(validators))
+		for i := range voteOrder {
+			voteOrder[i] = i
+		}
+		sort.SliceStable(voteOrder, func(i, j int) bool {
+			valI, valJ := validators[voteOrder[i]], validators[voteOrder[j]]
+			powerI, powerJ := valI.GetConsensusPower(powerReduction), valJ.GetConsensusPower(powerReduction)
+			if powerI != powerJ {
+				return powerI > powerJ
+			}
+			consAddrI, _ := valI.GetConsAddr()
+			consAddrJ, _ := valJ.GetConsAddr()
+			return bytes.Compare(consAddrI, consAddrJ) < 0
+		})
+
+		recipient := voteOrder[k.GetDustRecipientIndex(ctx)%uint64(len(validators))]
+		rewards[recipient] = rewards[recipient].Add(dust...)
+	}
+
+	burnValidators := burnValidatorSet(params)
+	for i, validator := range validators {
+		reward := rewards[i]
+		_, isBurnValidator := burnValidators[validator.GetOperator().String()]
+		if !isBurnValidator {
+			// rewards accrued past the cap are left to the community pool
+			current := k.GetValidatorCurrentRewards(ctx, validator.GetOperator()).Rewards
+			reward, _ = capAccruedRewards(current, reward, params.MaxAccruedRewards)
+		}
+		allocations = append(allocations, types.ValidatorAllocation{
+			ValidatorAddress: validator.GetOperator(),
+			Reward:           reward,
+			Burned:           isBurnValidator,
+		})
+		remaining = remaining.Sub(reward)
+	}
+
+	return allocations, remaining, nil
+}
+
+// participationPenalty records the vote of the validator in its participation
+// over the trailing window and returns the fraction of its rewards to withhold.
+func (k Keeper) participationPenalty(ctx sdk.Context, pp *types.ParticipationPenalty, vote abci.VoteInfo) math.LegacyDec {
+	if pp == nil || pp.Window == 0 {
+		return math.LegacyZeroDec()
+	}
+
+	consAddr := sdk.ConsAddress(vote.Validator.Address)
+	participation := k.GetValidatorParticipation(ctx, consAddr)
+	participation.SignedBlocks = trimParticipation(append(participation.SignedBlocks, vote.SignedLastBlock), pp.Window)
+	k.SetValidatorParticipation(ctx, consAddr, participation)
+
+	return penaltyForParticipation(pp, participation.SignedBlocks)
+}
+
+// trimParticipation keeps the last window entries of the signed blocks.
+func trimParticipation(signedBlocks []bool, window uint64) []bool {
+	if uint64(len(signedBlocks)) > window {
+		return signedBlocks[uint64(len(signedBlocks))-window:]
+	}
+
+	return signedBlocks
 }
 
-func (k Keeper) allocateTokensToBeneficiaries(ctx sdk.Context, validator stakingtypes.ValidatorI, reward sdk.DecCoins) {
+// penaltyForParticipation returns the fraction of the rewards to withhold given
+// the signed blocks over the trailing window.
+func penaltyForParticipation(pp *types.ParticipationPenalty, signedBlocks []bool) math.LegacyDec {
+	// only validators with a full window are penalized
+	if uint64(len(signedBlocks)) < pp.Window {
+		return math.LegacyZeroDec()
+	}
+
+	signed := 0
+	for _, s := range signedBlocks {
+		if s {
+			signed++
+		}
+	}
+
+	if math.LegacyNewDec(int64(signed)).QuoInt64(int64(pp.Window)).LT(pp.MinParticipation) {
+		return pp.Penalty
+	}
+
+	return math.LegacyZeroDec()
+}
+
+// allocateTokensToBeneficiaries credits the reward to the validator, or burns
+// it if the validator is in the burn validator set.
+func (k Keeper) allocateTokensToBeneficiaries(ctx sdk.Context, validator stakingtypes.ValidatorI, reward sdk.DecCoins, burnValidators map[string]struct{}) beneficiaryAllocation {
 	var err error
 	logger := ctx.Logger()
 	var coins sdk.Coins
 	coins = k.DecCoins2Coins(reward)
 	var ok bool
 	// rewards will be burned by this address list
-	ok = k.IsBurnValidator(ctx, validator)
+	_, ok = burnValidators[validator.GetOperator().String()]
 	if ok {
 		burnCoins := reward //all miner reward will be burned
-		coins = k.DecCoins2Coins(burnCoins)
-		err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
-		if err != nil {
-			logger.Error("[distribution] burn tokens", "error", err.Error())
-			return
+		// only whole units can be burned, the truncated dust is carried over
+		// and burned once it adds up to whole units
+		var dust sdk.DecCoins
+		coins, dust = burnCoins.Add(k.GetBurnDust(ctx)...).TruncateDecimal()
+		if !coins.IsZero() {
+			err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+			if err != nil {
+				logger.Error("[distribution] burn tokens", "error", err.Error())
+				return beneficiaryAllocation{}
+			}
 		}
+		k.SetBurnDust(ctx, dust)
+		k.SetTotalRewardsBurned(ctx, k.GetTotalRewardsBurned(ctx).Add(coins...))
+
+		k.emitAllocationEvent(ctx,
+			sdk.NewEvent(
+				types.EventTypeBurnRewards,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, burnCoins.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, validator.GetOperator().String()),
+			),
+		)
 		logger.Info("[distribution] burn tokens", "validator", validator.GetOperator().String(), "reward", burnCoins.String())
-	} else {
-		k.AllocateTokensToValidator(ctx, validator, reward)
-		logger.Info("[distribution] allocate tokens", "validator", validator.GetOperator().String(), "reward", reward.String())
+		return beneficiaryAllocation{burned: burnCoins}
+	}
+
+	overflow := k.AllocateTokensToValidator(ctx, validator, reward)
+	logger.Info("[distribution] allocate tokens", "validator", validator.GetOperator().String(), "reward", reward.String())
+	return beneficiaryAllocation{rewards: reward.Sub(overflow), overflow: overflow}
+}
+
+// beneficiaryAllocation holds where the reward of a single validator went.
+type beneficiaryAllocation struct {
+	rewards  sdk.DecCoins
+	burned   sdk.DecCoins
+	overflow sdk.DecCoins
+}
+
+// burnValidatorSet returns the burn validators of the params as a set, so
+// that allocating to every validator of a block does not rescan the list.
+func burnValidatorSet(params types.Params) map[string]struct{} {
+	burnValidators := make(map[string]struct{}, len(params.BurnValidators))
+	for _, v := range params.BurnValidators {
+		burnValidators[v] = struct{}{}
 	}
+	return burnValidators
 }
 
 func (k Keeper) IsBurnValidator(ctx sdk.Context, validator stakingtypes.ValidatorI) bool {
@@ -111,16 +471,58 @@ func (k Keeper) DecCoins2Coins(dcs sdk.DecCoins) (coins sdk.Coins) {
 	return coins
 }
 
+// AllocateTokensToValidators credits the given tokens to each validator, keyed
+// by operator address, in sorted operator order. All validators are resolved
+// before any of them is credited.
+func (k Keeper) AllocateTokensToValidators(ctx sdk.Context, allocations map[string]sdk.DecCoins) error {
+	operators := make([]string, 0, len(allocations))
+	for operator := range allocations {
+		operators = append(operators, operator)
+	}
+	sort.Strings(operators)
+
+	validators := make([]stakingtypes.ValidatorI, 0, len(operators))
+	for _, operator := range operators {
+		valAddr, err := sdk.ValAddressFromBech32(operator)
+		if err != nil {
+			return err
+		}
+
+		validator := k.stakingKeeper.Validator(ctx, valAddr)
+		if validator == nil {
+			return sdkerrors.Wrap(types.ErrNoValidatorExists, operator)
+		}
+		validators = append(validators, validator)
+	}
+
+	for i, validator := range validators {
+		k.AllocateTokensToValidator(ctx, validator, allocations[operators[i]])
+	}
+
+	return nil
+}
+
 // AllocateTokensToValidator allocate tokens to a particular validator,
-// splitting according to commission.
-func (k Keeper) AllocateTokensToValidator(ctx sdk.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins) {
-	// update current rewards
+// splitting according to commission. The tokens past the MaxAccruedRewards cap
+// are sent to the community pool and returned.
+func (k Keeper) AllocateTokensToValidator(ctx sdk.Context, val stakingtypes.ValidatorI, tokens sdk.DecCoins) sdk.DecCoins {
 	currentRewards := k.GetValidatorCurrentRewards(ctx, val.GetOperator())
+
+	// rewards accrued past the cap go to the community pool
+	var overflow sdk.DecCoins
+	tokens, overflow = capAccruedRewards(currentRewards.Rewards, tokens, k.GetParams(ctx).MaxAccruedRewards)
+	if !overflow.IsZero() {
+		feePool := k.GetFeePool(ctx)
+		feePool.CommunityPool = feePool.CommunityPool.Add(overflow...)
+		k.SetFeePool(ctx, feePool)
+	}
+
+	// update current rewards
 	currentRewards.Rewards = currentRewards.Rewards.Add(tokens...)
 	k.SetValidatorCurrentRewards(ctx, val.GetOperator(), currentRewards)
 
 	// update outstanding rewards
-	ctx.EventManager().EmitEvent(
+	k.emitAllocationEvent(ctx,
 		sdk.NewEvent(
 			types.EventTypeRewards,
 			sdk.NewAttribute(sdk.AttributeKeyAmount, tokens.String()),
@@ -131,4 +533,41 @@ func (k Keeper) AllocateTokensToValidator(ctx sdk.Context, val stakingtypes.Vali
 	outstanding := k.GetValidatorOutstandingRewards(ctx, val.GetOperator())
 	outstanding.Rewards = outstanding.Rewards.Add(tokens...)
 	k.SetValidatorOutstandingRewards(ctx, val.GetOperator(), outstanding)
+
+	// a failing hook must not halt the reward allocation, the state it wrote
+	// is discarded
+	if k.hooks != nil {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.hooks.AfterValidatorRewardAllocated(cacheCtx, val.GetOperator(), tokens); err != nil {
+			k.Logger(ctx).Error("failed to call after validator reward allocated hook", "validator", val.GetOperator().String(), "error", err)
+		} else {
+			write()
+		}
+	}
+
+	return overflow
+}
+
+// capAccruedRewards splits the tokens allocated to a validator into the part it
+// can accrue on top of its current rewards without exceeding the cap of each
+// denom and the overflow. Denoms without a cap are accrued in full.
+func capAccruedRewards(current, tokens, maxAccrued sdk.DecCoins) (accrued, overflow sdk.DecCoins) {
+	if maxAccrued.IsZero() {
+		return tokens, nil
+	}
+
+	for _, token := range tokens {
+		limit := maxAccrued.AmountOf(token.Denom)
+		if !limit.IsPositive() {
+			accrued = accrued.Add(token)
+			continue
+		}
+
+		room := sdk.MaxDec(limit.Sub(current.AmountOf(token.Denom)), math.LegacyZeroDec())
+		amount := sdk.MinDec(token.Amount, room)
+		accrued = accrued.Add(sdk.NewDecCoinFromDec(token.Denom, amount))
+		overflow = overflow.Add(sdk.NewDecCoinFromDec(token.Denom, token.Amount.Sub(amount)))
+	}
+
+	return accrued, overflow
 }
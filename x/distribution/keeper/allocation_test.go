@@ -1,16 +1,24 @@
 package keeper_test
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
 	"testing"
 	"time"
 
 	"cosmossdk.io/math"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -262,3 +270,1568 @@ func TestAllocateTokensTruncation(t *testing.T) {
 	require.True(t, distrKeeper.GetValidatorOutstandingRewards(ctx, valAddr1).Rewards.IsValid())
 	require.True(t, distrKeeper.GetValidatorOutstandingRewards(ctx, valAddr2).Rewards.IsValid())
 }
+
+func TestGetValidatorOutstandingRewardsDenom(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+
+	// allocate multi-denom rewards with fractional amounts
+	tokens := sdk.DecCoins{
+		{Denom: "atom", Amount: math.LegacyNewDecWithPrec(255, 1)},
+		{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDecWithPrec(109, 1)},
+	}
+	distrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+
+	outstanding := distrKeeper.GetValidatorOutstandingRewardsCoins(ctx, val.GetOperator())
+	for _, denom := range []string{"atom", sdk.DefaultBondDenom} {
+		expected := outstanding.AmountOf(denom).TruncateInt()
+		require.Equal(t, expected, distrKeeper.GetValidatorOutstandingRewardsDenom(ctx, val.GetOperator(), denom))
+	}
+	require.Equal(t, math.NewInt(25), distrKeeper.GetValidatorOutstandingRewardsDenom(ctx, val.GetOperator(), "atom"))
+	require.Equal(t, math.NewInt(10), distrKeeper.GetValidatorOutstandingRewardsDenom(ctx, val.GetOperator(), sdk.DefaultBondDenom))
+	require.True(t, distrKeeper.GetValidatorOutstandingRewardsDenom(ctx, val.GetOperator(), "unknown").IsZero())
+}
+
+func TestAllocateTokensFeeTransferFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// reset fee pool & set params
+	distrKeeper.SetParams(ctx, disttypes.DefaultParams())
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+
+	// the fee transfer fails, so neither the validator nor the staking keeper
+	// may be touched and no further bank calls are expected
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, gomock.Any()).Return(errors.New("transient bank error"))
+
+	votes := []abci.VoteInfo{
+		{
+			Validator:       abci.Validator{Address: valConsPk0.Address(), Power: 100},
+			SignedLastBlock: true,
+		},
+	}
+	require.NotPanics(t, func() { distrKeeper.AllocateTokens(ctx, 100, votes) })
+
+	// fees stay in the fee collector: nothing was credited to the community pool
+	// or to the validator
+	require.True(t, distrKeeper.GetFeePool(ctx).CommunityPool.IsZero())
+	require.True(t, distrKeeper.GetValidatorOutstandingRewards(ctx, val0.GetOperator()).Rewards.IsZero())
+}
+
+func TestTotalRewardsBurned(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	querier := keeper.NewQuerier(distrKeeper)
+
+	val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val0).AnyTimes()
+	val1, err := distrtestutil.CreateValidator(valConsPk1, math.NewInt(100))
+	require.NoError(t, err)
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk1)).Return(val1).AnyTimes()
+
+	// both validators burn their rewards, no voter share
+	params := disttypes.DefaultParams()
+	params.VoterRewards.Ratio = math.LegacyZeroDec()
+	params.BurnValidators = []string{val0.GetOperator().String(), val1.GetOperator().String()}
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	res, err := querier.TotalRewardsBurned(ctx, &disttypes.QueryTotalRewardsBurnedRequest{})
+	require.NoError(t, err)
+	require.True(t, res.Burned.IsZero())
+
+	votes := []abci.VoteInfo{
+		{Validator: abci.Validator{Address: valConsPk0.Address(), Power: 100}, SignedLastBlock: true},
+		{Validator: abci.Validator{Address: valConsPk1.Address(), Power: 100}, SignedLastBlock: true},
+	}
+	for _, amount := range []int64{100, 40} {
+		fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount)))
+		bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+		bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+		bankKeeper.EXPECT().BurnCoins(gomock.Any(), disttypes.ModuleName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount/2)))).Times(2)
+
+		distrKeeper.AllocateTokens(ctx, 200, votes)
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	}
+
+	expected := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(140)))
+	require.Equal(t, expected, distrKeeper.GetTotalRewardsBurned(ctx))
+
+	res, err = querier.TotalRewardsBurned(ctx, &disttypes.QueryTotalRewardsBurnedRequest{})
+	require.NoError(t, err)
+	require.Equal(t, expected, res.Burned)
+}
+
+func TestBurnDustCarriedOver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val0).AnyTimes()
+
+	params := disttypes.DefaultParams()
+	params.VoterRewards.Ratio = math.LegacyZeroDec()
+	params.BurnValidators = []string{val0.GetOperator().String()}
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	// the burn validator holds half of the power, so each block it is
+	// allocated half a unit of the single collected fee unit
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees).Times(3)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees).Times(3)
+	votes := []abci.VoteInfo{
+		{Validator: abci.Validator{Address: valConsPk0.Address(), Power: 100}, SignedLastBlock: true},
+	}
+
+	// first block: nothing can be burned yet, the half unit is kept as dust
+	distrKeeper.AllocateTokens(ctx, 200, votes)
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(5, 1))}, distrKeeper.GetBurnDust(ctx))
+	require.True(t, distrKeeper.GetTotalRewardsBurned(ctx).IsZero())
+
+	// second block: the dust adds up to a whole unit which is burned
+	bankKeeper.EXPECT().BurnCoins(gomock.Any(), disttypes.ModuleName, fees)
+	distrKeeper.AllocateTokens(ctx, 200, votes)
+	require.True(t, distrKeeper.GetBurnDust(ctx).IsZero())
+	require.Equal(t, fees, distrKeeper.GetTotalRewardsBurned(ctx))
+
+	// third block: dust again
+	distrKeeper.AllocateTokens(ctx, 200, votes)
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(5, 1))}, distrKeeper.GetBurnDust(ctx))
+	require.Equal(t, fees, distrKeeper.GetTotalRewardsBurned(ctx))
+}
+
+func TestAllocateTokensFeeSplitEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// 30% of the fees are left to the voters
+	params := disttypes.DefaultParams()
+	params.VoterRewards.Ratio = math.LegacyNewDecWithPrec(3, 1)
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	minerFees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(70)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees).Times(2)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, minerFees)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	distrKeeper.AllocateTokens(ctx, 0, nil)
+
+	feeSplit := func(events sdk.Events) []sdk.Event {
+		var found []sdk.Event
+		for _, event := range events {
+			if event.Type == disttypes.EventTypeFeeSplit {
+				found = append(found, event)
+			}
+		}
+		return found
+	}
+
+	events := feeSplit(ctx.EventManager().Events())
+	require.Len(t, events, 1)
+	require.Equal(t, []abci.EventAttribute{
+		{Key: disttypes.AttributeKeyMinerAmount, Value: minerFees.String()},
+		{Key: disttypes.AttributeKeyVoterAmount, Value: fees.Sub(minerFees...).String()},
+	}, events[0].Attributes)
+
+	// without a voter share all fees go to the miners
+	params.VoterRewards.Ratio = math.LegacyZeroDec()
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	distrKeeper.AllocateTokens(ctx, 0, nil)
+
+	events = feeSplit(ctx.EventManager().Events())
+	require.Len(t, events, 1)
+	require.Equal(t, []abci.EventAttribute{
+		{Key: disttypes.AttributeKeyMinerAmount, Value: fees.String()},
+		{Key: disttypes.AttributeKeyVoterAmount, Value: "0" + sdk.DefaultBondDenom},
+	}, events[0].Attributes)
+
+	// the zero voter amount is reported in the denoms of the fees
+	fees = sdk.NewCoins(sdk.NewCoin("ufee", sdk.NewInt(40)), sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	distrKeeper.AllocateTokens(ctx, 0, nil)
+
+	events = feeSplit(ctx.EventManager().Events())
+	require.Len(t, events, 1)
+	require.Equal(t, []abci.EventAttribute{
+		{Key: disttypes.AttributeKeyMinerAmount, Value: fees.String()},
+		{Key: disttypes.AttributeKeyVoterAmount, Value: "0ufee,0" + sdk.DefaultBondDenom},
+	}, events[0].Attributes)
+}
+
+func TestAllocateTokensFeeCarry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// 30% of the fees are left to the voters, the miners get 0.7 units per block
+	params := disttypes.DefaultParams()
+	params.VoterRewards.Ratio = math.LegacyNewDecWithPrec(3, 1)
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	const blocks = 13
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1)))
+	transferred := sdk.NewCoins()
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees).Times(blocks)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, gomock.Any()).
+		DoAndReturn(func(_ sdk.Context, _, _ string, amt sdk.Coins) error {
+			transferred = transferred.Add(amt...)
+			return nil
+		}).Times(blocks)
+
+	for i := 0; i < blocks; i++ {
+		distrKeeper.AllocateTokens(ctx, 0, nil)
+	}
+
+	// nothing leaks: everything transferred ended up in the community pool and
+	// together with the carry it matches the miner share of the fees exactly
+	minerShare := sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDecWithPrec(7, 1).MulInt64(blocks)))
+	communityPool := distrKeeper.GetFeePool(ctx).CommunityPool
+	carry := distrKeeper.GetFeeCarry(ctx)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(transferred...), communityPool)
+	require.Equal(t, minerShare, communityPool.Add(carry...))
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(9))), transferred)
+}
+
+func TestAllocateTokensParticipationPenalty(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// validators signing less than 3 of the last 4 blocks lose half their rewards
+	params := disttypes.DefaultParams()
+	params.VoterRewards.Ratio = math.LegacyZeroDec()
+	params.ParticipationPenalty = &disttypes.ParticipationPenalty{
+		Window:           4,
+		MinParticipation: math.LegacyNewDecWithPrec(75, 2),
+		Penalty:          math.LegacyNewDecWithPrec(5, 1),
+	}
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val0).AnyTimes()
+	val1, err := distrtestutil.CreateValidator(valConsPk1, math.NewInt(100))
+	require.NoError(t, err)
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk1)).Return(val1).AnyTimes()
+
+	distrKeeper.SetValidatorParticipation(ctx, sdk.GetConsAddress(valConsPk0), disttypes.ValidatorParticipation{SignedBlocks: []bool{true, true, false, false}})
+	distrKeeper.SetValidatorParticipation(ctx, sdk.GetConsAddress(valConsPk1), disttypes.ValidatorParticipation{SignedBlocks: []bool{true, true, true}})
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+	votes := []abci.VoteInfo{
+		{Validator: abci.Validator{Address: valConsPk0.Address(), Power: 100}, SignedLastBlock: false},
+		{Validator: abci.Validator{Address: valConsPk1.Address(), Power: 100}, SignedLastBlock: true},
+	}
+	distrKeeper.AllocateTokens(ctx, 200, votes)
+
+	// the window only keeps the last 4 blocks
+	require.Equal(t, []bool{true, false, false, false}, distrKeeper.GetValidatorParticipation(ctx, sdk.GetConsAddress(valConsPk0)).SignedBlocks)
+	require.Equal(t, []bool{true, true, true, true}, distrKeeper.GetValidatorParticipation(ctx, sdk.GetConsAddress(valConsPk1)).SignedBlocks)
+
+	// the first validator gets half of its 50 and the penalty goes to the community pool
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(25)}}, distrKeeper.GetValidatorOutstandingRewards(ctx, val0.GetOperator()).Rewards)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(50)}}, distrKeeper.GetValidatorOutstandingRewards(ctx, val1.GetOperator()).Rewards)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(25)}}, distrKeeper.GetFeePool(ctx).CommunityPool)
+}
+
+func TestSimulateAllocation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	params := disttypes.DefaultParams()
+	params.CommunityTax = math.LegacyNewDecWithPrec(2, 2)
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	val0, err := distrtestutil.CreateValidator(valConsPk0, sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction))
+	require.NoError(t, err)
+	val0.Status = stakingtypes.Bonded
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val0).AnyTimes()
+	val1, err := distrtestutil.CreateValidator(valConsPk1, sdk.TokensFromConsensusPower(300, sdk.DefaultPowerReduction))
+	require.NoError(t, err)
+	val1.Status = stakingtypes.Bonded
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk1)).Return(val1).AnyTimes()
+	// unbonded validators get nothing
+	val2, err := distrtestutil.CreateValidator(valConsPk2, sdk.TokensFromConsensusPower(500, sdk.DefaultPowerReduction))
+	require.NoError(t, err)
+	stakingKeeper.EXPECT().IterateValidators(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, fn func(index int64, validator stakingtypes.ValidatorI) (stop bool)) {
+			for i, val := range []stakingtypes.Validator{val0, val1, val2} {
+				if fn(int64(i), val) {
+					return
+				}
+			}
+		},
+	).Times(2)
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1234)))
+	stakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction)
+	allocations, communityPool, err := distrKeeper.SimulateAllocation(ctx, fees)
+	require.NoError(t, err)
+	require.Len(t, allocations, 2)
+	require.Equal(t, val0.GetOperator(), allocations[0].ValidatorAddress)
+	require.Equal(t, val1.GetOperator(), allocations[1].ValidatorAddress)
+	require.False(t, allocations[0].Burned)
+
+	// the simulation does not modify the state
+	require.Empty(t, distrKeeper.GetValidatorOutstandingRewards(ctx, val0.GetOperator()).Rewards)
+	require.Empty(t, distrKeeper.GetFeePool(ctx).CommunityPool)
+
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, gomock.Any())
+
+	votes := []abci.VoteInfo{
+		{Validator: abci.Validator{Address: valConsPk0.Address(), Power: 100}, SignedLastBlock: true},
+		{Validator: abci.Validator{Address: valConsPk1.Address(), Power: 300}, SignedLastBlock: true},
+	}
+	distrKeeper.AllocateTokens(ctx, 400, votes)
+
+	require.Equal(t, allocations[0].Reward, distrKeeper.GetValidatorOutstandingRewards(ctx, val0.GetOperator()).Rewards)
+	require.Equal(t, allocations[1].Reward, distrKeeper.GetValidatorOutstandingRewards(ctx, val1.GetOperator()).Rewards)
+	require.Equal(t, communityPool, distrKeeper.GetFeePool(ctx).CommunityPool)
+
+	// validators are weighted with the power reduction of the staking keeper,
+	// 200 default units per unit of power leave the first one without power
+	stakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.TokensFromConsensusPower(200, sdk.DefaultPowerReduction))
+	allocations, _, err = distrKeeper.SimulateAllocation(ctx, fees)
+	require.NoError(t, err)
+	require.Len(t, allocations, 2)
+	require.True(t, allocations[0].Reward.IsZero())
+	require.False(t, allocations[1].Reward.IsZero())
+}
+
+func TestSimulateAllocationMaxAccruedRewards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	params := disttypes.DefaultParams()
+	params.VoterRewards.Ratio = math.LegacyZeroDec()
+	params.MaxAccruedRewards = sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(30)}}
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+
+	val, err := distrtestutil.CreateValidator(valConsPk0, sdk.TokensFromConsensusPower(100, sdk.DefaultPowerReduction))
+	require.NoError(t, err)
+	val.Status = stakingtypes.Bonded
+	stakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction)
+	stakingKeeper.EXPECT().IterateValidators(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, fn func(index int64, validator stakingtypes.ValidatorI) (stop bool)) {
+			fn(0, val)
+		},
+	)
+	distrKeeper.SetValidatorCurrentRewards(ctx, val.GetOperator(), disttypes.NewValidatorCurrentRewards(
+		sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(20)}}, 1,
+	))
+
+	// only 10 fit under the cap, the rest is left to the community pool
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	allocations, communityPool, err := distrKeeper.SimulateAllocation(ctx, fees)
+	require.NoError(t, err)
+	require.Len(t, allocations, 1)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(10)}}, allocations[0].Reward)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(90)}}, communityPool)
+}
+
+func TestSimulateAllocationDust(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	params := disttypes.DefaultParams()
+	params.VoterRewards.Ratio = math.LegacyZeroDec()
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+	distrKeeper.SetDustRecipientIndex(ctx, 1)
+
+	// three validators of equal power leave dust when splitting the fees
+	var validators []stakingtypes.ValidatorI
+	var votes []abci.VoteInfo
+	for _, pk := range PKS[:3] {
+		val, err := distrtestutil.CreateValidator(pk, sdk.TokensFromConsensusPower(10, sdk.DefaultPowerReduction))
+		require.NoError(t, err)
+		val.Status = stakingtypes.Bonded
+		stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val).AnyTimes()
+		validators = append(validators, val)
+		votes = append(votes, abci.VoteInfo{Validator: abci.Validator{Address: pk.Address(), Power: 10}, SignedLastBlock: true})
+	}
+	stakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction)
+	stakingKeeper.EXPECT().IterateValidators(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, fn func(index int64, validator stakingtypes.ValidatorI) (stop bool)) {
+			for i, val := range validators {
+				if fn(int64(i), val) {
+					return
+				}
+			}
+		},
+	)
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	allocations, communityPool, err := distrKeeper.SimulateAllocation(ctx, fees)
+	require.NoError(t, err)
+	require.Len(t, allocations, 3)
+	require.Empty(t, communityPool)
+
+	// the block votes are ordered like the validator set, by address for
+	// validators of equal power, the dust goes to the second one
+	sort.Slice(votes, func(i, j int) bool {
+		return bytes.Compare(votes[i].Validator.Address, votes[j].Validator.Address) < 0
+	})
+	for i, val := range validators {
+		consAddr, err := val.GetConsAddr()
+		require.NoError(t, err)
+		credited := allocations[i].Reward.AmountOf(sdk.DefaultBondDenom).GT(allocations[(i+1)%3].Reward.AmountOf(sdk.DefaultBondDenom))
+		require.Equal(t, bytes.Equal(consAddr, votes[1].Validator.Address), credited)
+	}
+
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+	distrKeeper.AllocateTokens(ctx, 30, votes)
+
+	// the preview credits the dust to the same validator
+	for _, allocation := range allocations {
+		require.Equal(t, allocation.Reward, distrKeeper.GetValidatorOutstandingRewards(ctx, allocation.ValidatorAddress).Rewards)
+	}
+}
+
+func TestAllocateTokensMissingValidator(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	params := disttypes.DefaultParams()
+	params.VoterRewards.Ratio = math.LegacyZeroDec()
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val0).AnyTimes()
+	// the second validator left the set after voting
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk1)).Return(nil).AnyTimes()
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+
+	votes := []abci.VoteInfo{
+		{Validator: abci.Validator{Address: valConsPk0.Address(), Power: 100}, SignedLastBlock: true},
+		{Validator: abci.Validator{Address: valConsPk1.Address(), Power: 100}, SignedLastBlock: true},
+	}
+	require.NotPanics(t, func() {
+		distrKeeper.AllocateTokens(ctx, 200, votes)
+	})
+
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(50)}}, distrKeeper.GetValidatorOutstandingRewards(ctx, val0.GetOperator()).Rewards)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(50)}}, distrKeeper.GetFeePool(ctx).CommunityPool)
+}
+
+func TestAllocateTokensRewardEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val0).AnyTimes()
+	val1, err := distrtestutil.CreateValidator(valConsPk1, math.NewInt(100))
+	require.NoError(t, err)
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk1)).Return(val1).AnyTimes()
+
+	// the rewards of the second validator are burned
+	params := disttypes.DefaultParams()
+	params.VoterRewards.Ratio = math.LegacyZeroDec()
+	params.BurnValidators = []string{val1.GetOperator().String()}
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+	bankKeeper.EXPECT().BurnCoins(gomock.Any(), disttypes.ModuleName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50))))
+
+	votes := []abci.VoteInfo{
+		{Validator: abci.Validator{Address: valConsPk0.Address(), Power: 100}, SignedLastBlock: true},
+		{Validator: abci.Validator{Address: valConsPk1.Address(), Power: 100}, SignedLastBlock: true},
+	}
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	distrKeeper.AllocateTokens(ctx, 200, votes)
+
+	rewarded := map[string][]string{}
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != disttypes.EventTypeRewards && event.Type != disttypes.EventTypeBurnRewards {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == disttypes.AttributeKeyValidator {
+				rewarded[event.Type] = append(rewarded[event.Type], attr.Value)
+			}
+		}
+	}
+	require.Equal(t, map[string][]string{
+		disttypes.EventTypeRewards:     {val0.GetOperator().String()},
+		disttypes.EventTypeBurnRewards: {val1.GetOperator().String()},
+	}, rewarded)
+}
+
+func TestAllocateTokensMissedAllocations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	querier := keeper.NewQuerier(distrKeeper)
+
+	params := disttypes.DefaultParams()
+	params.VoterRewards.Ratio = math.LegacyZeroDec()
+	params.WithholdJailedRewards = true
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val0).AnyTimes()
+	stakingKeeper.EXPECT().Validator(gomock.Any(), val0.GetOperator()).Return(val0).AnyTimes()
+	// the second validator was jailed after voting
+	val1, err := distrtestutil.CreateValidator(valConsPk1, math.NewInt(100))
+	require.NoError(t, err)
+	val1.Jailed = true
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk1)).Return(val1).AnyTimes()
+	stakingKeeper.EXPECT().Validator(gomock.Any(), val1.GetOperator()).Return(val1).AnyTimes()
+
+	votes := []abci.VoteInfo{
+		{Validator: abci.Validator{Address: valConsPk0.Address(), Power: 100}, SignedLastBlock: true},
+		{Validator: abci.Validator{Address: valConsPk1.Address(), Power: 100}, SignedLastBlock: true},
+	}
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	for i := 1; i <= 2; i++ {
+		bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+		bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+		distrKeeper.AllocateTokens(ctx, 200, votes)
+
+		res, err := querier.ValidatorMissedAllocations(ctx, &disttypes.QueryValidatorMissedAllocationsRequest{ValidatorAddress: val1.GetOperator().String()})
+		require.NoError(t, err)
+		require.Equal(t, uint64(i), res.Missed)
+	}
+
+	res, err := querier.ValidatorMissedAllocations(ctx, &disttypes.QueryValidatorMissedAllocationsRequest{ValidatorAddress: val0.GetOperator().String()})
+	require.NoError(t, err)
+	require.Zero(t, res.Missed)
+
+	// the share of the jailed validator went to the community pool
+	require.Empty(t, distrKeeper.GetValidatorOutstandingRewards(ctx, val1.GetOperator()).Rewards)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(100)}}, distrKeeper.GetFeePool(ctx).CommunityPool)
+
+	// jailed validators keep being rewarded unless rewards are withheld
+	params.WithholdJailedRewards = false
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+	distrKeeper.AllocateTokens(ctx, 200, votes)
+
+	res, err = querier.ValidatorMissedAllocations(ctx, &disttypes.QueryValidatorMissedAllocationsRequest{ValidatorAddress: val1.GetOperator().String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Missed)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(50)}}, distrKeeper.GetValidatorOutstandingRewards(ctx, val1.GetOperator()).Rewards)
+}
+
+func TestAllocateTokensRemovedValidatorNoMissedAllocations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	params := disttypes.DefaultParams()
+	params.VoterRewards.Ratio = math.LegacyZeroDec()
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	// the validator was removed after voting
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(nil).AnyTimes()
+
+	votes := []abci.VoteInfo{
+		{Validator: abci.Validator{Address: valConsPk0.Address(), Power: 100}, SignedLastBlock: true},
+	}
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+	distrKeeper.AllocateTokens(ctx, 100, votes)
+
+	// no counter is left behind for the removed validator
+	require.Zero(t, distrKeeper.GetValidatorMissedAllocations(ctx, sdk.GetConsAddress(valConsPk0)))
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(100)}}, distrKeeper.GetFeePool(ctx).CommunityPool)
+}
+
+func TestAllocateTokensToValidatorMaxAccruedRewards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// only the bond denom is capped
+	params := disttypes.DefaultParams()
+	params.MaxAccruedRewards = sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(15)}}
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+
+	tokens := sdk.DecCoins{
+		{Denom: "mytoken", Amount: math.LegacyNewDec(10)},
+		{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(10)},
+	}
+	distrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+	require.Equal(t, tokens, distrKeeper.GetValidatorCurrentRewards(ctx, val.GetOperator()).Rewards)
+	require.True(t, distrKeeper.GetFeePool(ctx).CommunityPool.IsZero())
+
+	// accruing past the cap routes the overflow to the community pool
+	distrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+	expected := sdk.DecCoins{
+		{Denom: "mytoken", Amount: math.LegacyNewDec(20)},
+		{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(15)},
+	}
+	require.Equal(t, expected, distrKeeper.GetValidatorCurrentRewards(ctx, val.GetOperator()).Rewards)
+	require.Equal(t, expected, distrKeeper.GetValidatorOutstandingRewards(ctx, val.GetOperator()).Rewards)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(5)}}, distrKeeper.GetFeePool(ctx).CommunityPool)
+
+	// once at the cap everything overflows
+	distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(3)}})
+	require.Equal(t, expected, distrKeeper.GetValidatorCurrentRewards(ctx, val.GetOperator()).Rewards)
+	require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(8)}}, distrKeeper.GetFeePool(ctx).CommunityPool)
+}
+
+func TestAllocateTokensToValidators(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	require.NoError(t, distrKeeper.SetParams(ctx, disttypes.DefaultParams()))
+
+	allocations := map[string]sdk.DecCoins{}
+	var operators []string
+	for i, pk := range PKS[:3] {
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+		stakingKeeper.EXPECT().Validator(gomock.Any(), val.GetOperator()).Return(val).AnyTimes()
+
+		allocations[val.GetOperator().String()] = sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(int64(10 * (i + 1)))}}
+		operators = append(operators, val.GetOperator().String())
+	}
+	sort.Strings(operators)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, distrKeeper.AllocateTokensToValidators(ctx, allocations))
+
+	for operator, tokens := range allocations {
+		valAddr, err := sdk.ValAddressFromBech32(operator)
+		require.NoError(t, err)
+		require.Equal(t, tokens, distrKeeper.GetValidatorOutstandingRewards(ctx, valAddr).Rewards)
+		require.Equal(t, tokens, distrKeeper.GetValidatorCurrentRewards(ctx, valAddr).Rewards)
+	}
+
+	// one event per validator, in sorted operator order
+	var credited []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != disttypes.EventTypeRewards {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == disttypes.AttributeKeyValidator {
+				credited = append(credited, attr.Value)
+			}
+		}
+	}
+	require.Equal(t, operators, credited)
+
+	// an unknown validator fails the whole batch
+	unknown := sdk.ValAddress(PKS[3].Address())
+	stakingKeeper.EXPECT().Validator(gomock.Any(), unknown).Return(nil)
+	allocations[unknown.String()] = sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(1)}}
+	require.ErrorIs(t, distrKeeper.AllocateTokensToValidators(ctx, allocations), disttypes.ErrNoValidatorExists)
+	for operator, tokens := range allocations {
+		valAddr, err := sdk.ValAddressFromBech32(operator)
+		require.NoError(t, err)
+		if operator == unknown.String() {
+			require.True(t, distrKeeper.GetValidatorOutstandingRewards(ctx, valAddr).Rewards.IsZero())
+			continue
+		}
+		require.Equal(t, tokens, distrKeeper.GetValidatorOutstandingRewards(ctx, valAddr).Rewards)
+	}
+}
+
+func TestAllocateTokensDustRotation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	params := disttypes.DefaultParams()
+	params.CommunityTax = math.LegacyZeroDec()
+	params.VoterRewards.Ratio = math.LegacyZeroDec()
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	var validators []stakingtypes.ValidatorI
+	var votes []abci.VoteInfo
+	for _, pk := range PKS[:3] {
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+		stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val).AnyTimes()
+		validators = append(validators, val)
+		votes = append(votes, abci.VoteInfo{Validator: abci.Validator{Address: pk.Address(), Power: 1}, SignedLastBlock: true})
+	}
+
+	// a third of the fees cannot be represented exactly, each block leaves
+	// 10^-17 of dust once the three shares are truncated
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees).Times(3)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees).Times(3)
+
+	share := math.LegacyMustNewDecFromStr("3.333333333333333330")
+	dust := math.LegacyNewDecWithPrec(1, 17)
+	for block := 0; block < 3; block++ {
+		require.Equal(t, uint64(block), distrKeeper.GetDustRecipientIndex(ctx))
+		distrKeeper.AllocateTokens(ctx, 3, votes)
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+		// the dust went to the validator at the rotating index, not to the pool
+		for i, val := range validators {
+			expected := share.MulInt64(int64(block + 1))
+			if i <= block {
+				expected = expected.Add(dust)
+			}
+			require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: expected}}, distrKeeper.GetValidatorOutstandingRewards(ctx, val.GetOperator()).Rewards)
+		}
+		require.True(t, distrKeeper.GetFeePool(ctx).CommunityPool.IsZero())
+	}
+
+	// every validator has been credited once and the rotation wraps around
+	require.Equal(t, uint64(3), distrKeeper.GetDustRecipientIndex(ctx))
+	for _, val := range validators {
+		require.Equal(t, sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(10)}}, distrKeeper.GetValidatorOutstandingRewards(ctx, val.GetOperator()).Rewards)
+	}
+}
+
+// recordingDistributionHooks records the rewards allocated to each validator.
+type recordingDistributionHooks struct {
+	allocations map[string]sdk.DecCoins
+}
+
+func (h recordingDistributionHooks) AfterValidatorRewardAllocated(_ sdk.Context, valAddr sdk.ValAddress, reward sdk.DecCoins) error {
+	h.allocations[valAddr.String()] = h.allocations[valAddr.String()].Add(reward...)
+	return nil
+}
+
+func TestAllocateTokensRewardHooks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	hooks := recordingDistributionHooks{allocations: map[string]sdk.DecCoins{}}
+	distrKeeper.SetHooks(disttypes.NewMultiDistributionHooks(hooks))
+	require.Panics(t, func() { distrKeeper.SetHooks(hooks) })
+
+	params := disttypes.DefaultParams()
+	params.CommunityTax = math.LegacyZeroDec()
+	params.VoterRewards.Ratio = math.LegacyZeroDec()
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	var votes []abci.VoteInfo
+	var validators []stakingtypes.ValidatorI
+	for i, pk := range PKS[:2] {
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+		stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val).AnyTimes()
+		validators = append(validators, val)
+		votes = append(votes, abci.VoteInfo{Validator: abci.Validator{Address: pk.Address(), Power: int64(10 * (i + 1))}, SignedLastBlock: true})
+	}
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(30)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+	distrKeeper.AllocateTokens(ctx, 30, votes)
+
+	// each allocation is observed with the rewards credited to the validator
+	require.Len(t, hooks.allocations, len(validators))
+	for _, val := range validators {
+		require.Equal(t, distrKeeper.GetValidatorOutstandingRewards(ctx, val.GetOperator()).Rewards, hooks.allocations[val.GetOperator().String()])
+	}
+}
+
+func TestAllocateTokensEventVerbosity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).Times(2)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	require.Equal(t, disttypes.EventVerbosityFull, distrKeeper.EventVerbosity())
+
+	params := disttypes.DefaultParams()
+	params.CommunityTax = math.LegacyNewDecWithPrec(1, 1)
+	params.VoterRewards.Ratio = math.LegacyZeroDec()
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	var votes []abci.VoteInfo
+	for _, pk := range PKS[:2] {
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+		stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val).AnyTimes()
+		votes = append(votes, abci.VoteInfo{Validator: abci.Validator{Address: pk.Address(), Power: 10}, SignedLastBlock: true})
+	}
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees).Times(2)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees).Times(2)
+
+	// a summary replaces the fee split and per validator events
+	distrKeeper.SetEventVerbosity(disttypes.EventVerbositySummary)
+	distrKeeper.AllocateTokens(ctx, 20, votes)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, disttypes.EventTypeAllocationSummary, events[0].Type)
+	attrs := map[string]string{}
+	for _, attr := range events[0].Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	require.Equal(t, "0"+sdk.DefaultBondDenom, attrs[disttypes.AttributeKeyVoterAmount])
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(90))).String(), attrs[sdk.AttributeKeyAmount])
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(10))).String(), attrs[disttypes.AttributeKeyCommunityPool])
+	require.Equal(t, "2", attrs[disttypes.AttributeKeyValidators])
+
+	// no allocation events at all when turned off
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	distrKeeper.SetEventVerbosity(disttypes.EventVerbosityOff)
+	distrKeeper.AllocateTokens(ctx, 20, votes)
+	require.Empty(t, ctx.EventManager().Events())
+}
+
+func TestAllocateTokensSummaryTotals(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	distrKeeper.SetTreasuryModule("treasury")
+	distrKeeper.SetEventVerbosity(disttypes.EventVerbositySummary)
+
+	var votes []abci.VoteInfo
+	var validators []stakingtypes.ValidatorI
+	for _, pk := range PKS[:2] {
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+		stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val).AnyTimes()
+		votes = append(votes, abci.VoteInfo{Validator: abci.Validator{Address: pk.Address(), Power: 10}, SignedLastBlock: true})
+		validators = append(validators, val)
+	}
+
+	treasuryTax := math.LegacyNewDecWithPrec(1, 1)
+	params := disttypes.DefaultParams()
+	params.VoterRewards.Ratio = math.LegacyZeroDec()
+	params.TreasuryTax = &treasuryTax
+	params.BurnValidators = []string{validators[0].GetOperator().String()}
+	params.MaxAccruedRewards = sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(30)}}
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	// the treasury takes 10 of the 100 fees, the validators get 45 each: the
+	// first burns it and the second accrues 30 with 15 past the cap
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), disttypes.ModuleName, "treasury", sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10))))
+	bankKeeper.EXPECT().BurnCoins(gomock.Any(), disttypes.ModuleName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(45))))
+
+	distrKeeper.AllocateTokens(ctx, 20, votes)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	attrs := map[string]string{}
+	for _, attr := range events[0].Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	decCoins := func(amount int64) string {
+		return sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(amount))).String()
+	}
+	require.Equal(t, decCoins(30), attrs[sdk.AttributeKeyAmount])
+	require.Equal(t, decCoins(45), attrs[disttypes.AttributeKeyBurned])
+	require.Equal(t, decCoins(15), attrs[disttypes.AttributeKeyOverflow])
+	require.Equal(t, decCoins(10), attrs[disttypes.AttributeKeyTreasury])
+	require.Equal(t, "", attrs[disttypes.AttributeKeyCommunityPool])
+	require.Equal(t, "2", attrs[disttypes.AttributeKeyValidators])
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(15))), distrKeeper.GetFeePoolCommunityCoins(ctx))
+}
+
+func TestAllocateTokensFeeDrainFraction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	fraction := math.LegacyNewDecWithPrec(5, 1)
+	params := disttypes.DefaultParams()
+	params.CommunityTax = math.LegacyZeroDec()
+	params.VoterRewards.Ratio = math.LegacyZeroDec()
+	params.FeeDrainFraction = &fraction
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(valConsPk0)).Return(val).AnyTimes()
+	votes := []abci.VoteInfo{{Validator: abci.Validator{Address: valConsPk0.Address(), Power: 10}, SignedLastBlock: true}}
+
+	// only half the fees leave the fee collector, the other half is kept
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(101)))
+	drained := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(50)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, drained)
+
+	distrKeeper.AllocateTokens(ctx, 10, votes)
+
+	require.Equal(t, sdk.NewDecCoinsFromCoins(drained...), distrKeeper.GetValidatorOutstandingRewards(ctx, val.GetOperator()).Rewards)
+	require.True(t, distrKeeper.GetFeePoolCommunityCoins(ctx).IsZero())
+}
+
+func TestAllocateTokensTreasuryCut(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc)
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	distrKeeper.SetTreasuryModule("treasury")
+	require.Equal(t, "treasury", distrKeeper.TreasuryModule())
+
+	treasuryTax := math.LegacyNewDecWithPrec(2, 1)
+	params := disttypes.DefaultParams()
+	params.CommunityTax = math.LegacyNewDecWithPrec(1, 1)
+	params.VoterRewards.Ratio = math.LegacyZeroDec()
+	params.TreasuryTax = &treasuryTax
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	var votes []abci.VoteInfo
+	var validators []stakingtypes.ValidatorI
+	for _, pk := range PKS[:2] {
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+		stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val).AnyTimes()
+		votes = append(votes, abci.VoteInfo{Validator: abci.Validator{Address: pk.Address(), Power: 10}, SignedLastBlock: true})
+		validators = append(validators, val)
+	}
+
+	// 10% community tax leaves 900, the treasury takes 20% of it and the
+	// validators split the remaining 720
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees)
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), disttypes.ModuleName, "treasury", sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(180))))
+
+	distrKeeper.AllocateTokens(ctx, 20, votes)
+
+	expected := sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(360)))
+	for _, val := range validators {
+		require.Equal(t, expected, distrKeeper.GetValidatorOutstandingRewards(ctx, val.GetOperator()).Rewards)
+	}
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(100))), distrKeeper.GetFeePoolCommunityCoins(ctx))
+}
+
+// setupBurnListAllocation returns a keeper allocating to numValidators
+// validators, the first of which burns its rewards, with burnListSize entries
+// in the burn validator list.
+func setupBurnListAllocation(ctrl *gomock.Controller, ctx sdk.Context, key *storetypes.KVStoreKey, numValidators, burnListSize int) (keeper.Keeper, []abci.VoteInfo, []stakingtypes.ValidatorI) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(int64(100*numValidators))))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees).AnyTimes()
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "fee_collector", disttypes.ModuleName, fees).Return(nil).AnyTimes()
+	bankKeeper.EXPECT().BurnCoins(gomock.Any(), disttypes.ModuleName, gomock.Any()).Return(nil).AnyTimes()
+
+	var votes []abci.VoteInfo
+	var validators []stakingtypes.ValidatorI
+	for _, pk := range simtestutil.CreateTestPubKeys(numValidators) {
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		if err != nil {
+			panic(err)
+		}
+		stakingKeeper.EXPECT().ValidatorByConsAddr(gomock.Any(), sdk.GetConsAddress(pk)).Return(val).AnyTimes()
+		stakingKeeper.EXPECT().Validator(gomock.Any(), val.GetOperator()).Return(val).AnyTimes()
+		validators = append(validators, val)
+		votes = append(votes, abci.VoteInfo{Validator: abci.Validator{Address: pk.Address(), Power: 100}, SignedLastBlock: true})
+	}
+
+	// the burning validator is matched last
+	params := disttypes.DefaultParams()
+	params.VoterRewards.Ratio = math.LegacyZeroDec()
+	for i := 0; i < burnListSize-1; i++ {
+		params.BurnValidators = append(params.BurnValidators, sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()).String())
+	}
+	params.BurnValidators = append(params.BurnValidators, validators[0].GetOperator().String())
+	if err := distrKeeper.SetParams(ctx, params); err != nil {
+		panic(err)
+	}
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+
+	return distrKeeper, votes, validators
+}
+
+func TestAllocateTokensLargeBurnList(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	distrKeeper, votes, validators := setupBurnListAllocation(ctrl, ctx, key, 4, 100)
+	distrKeeper.AllocateTokens(ctx, 400, votes)
+
+	// only the listed validator burns its rewards
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))), distrKeeper.GetTotalRewardsBurned(ctx))
+	require.True(t, distrKeeper.GetValidatorOutstandingRewards(ctx, validators[0].GetOperator()).Rewards.IsZero())
+	for i, val := range validators {
+		require.Equal(t, i == 0, distrKeeper.IsBurnValidator(ctx, val))
+		if i > 0 {
+			require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(100))), distrKeeper.GetValidatorOutstandingRewards(ctx, val.GetOperator()).Rewards)
+		}
+	}
+}
+
+func BenchmarkAllocateTokensBurnList(b *testing.B) {
+	for _, burnListSize := range []int{1, 100, 1000} {
+		b.Run(fmt.Sprintf("burn list %d", burnListSize), func(b *testing.B) {
+			ctrl := gomock.NewController(b)
+			key := sdk.NewKVStoreKey(disttypes.StoreKey)
+			ctx := testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_test")).
+				WithBlockHeader(tmproto.Header{Time: time.Now()}).
+				WithLogger(log.NewNopLogger())
+
+			distrKeeper, votes, _ := setupBurnListAllocation(ctrl, ctx, key, 100, burnListSize)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				distrKeeper.AllocateTokens(ctx, 10000, votes)
+			}
+		})
+	}
+}
+
+// writingDistributionHooks writes to the distribution store on every allocation
+// and fails for the given validator.
+type writingDistributionHooks struct {
+	key     storetypes.StoreKey
+	failing sdk.ValAddress
+}
+
+func (h writingDistributionHooks) AfterValidatorRewardAllocated(ctx sdk.Context, valAddr sdk.ValAddress, _ sdk.DecCoins) error {
+	ctx.KVStore(h.key).Set(append([]byte("hook"), valAddr...), []byte{1})
+	if valAddr.Equals(h.failing) {
+		return errors.New("hook failed")
+	}
+	return nil
+}
+
+func TestAllocateTokensToValidatorFailingHookState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	require.NoError(t, distrKeeper.SetParams(ctx, disttypes.DefaultParams()))
+
+	val0, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	val1, err := distrtestutil.CreateValidator(valConsPk1, math.NewInt(100))
+	require.NoError(t, err)
+	distrKeeper.SetHooks(writingDistributionHooks{key: key, failing: val1.GetOperator()})
+
+	tokens := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(10)}}
+	distrKeeper.AllocateTokensToValidator(ctx, val0, tokens)
+	distrKeeper.AllocateTokensToValidator(ctx, val1, tokens)
+
+	// the writes of the failing hook are discarded, the rewards are still allocated
+	store := ctx.KVStore(key)
+	require.True(t, store.Has(append([]byte("hook"), val0.GetOperator()...)))
+	require.False(t, store.Has(append([]byte("hook"), val1.GetOperator()...)))
+	require.Equal(t, tokens, distrKeeper.GetValidatorOutstandingRewards(ctx, val1.GetOperator()).Rewards)
+}
@@ -138,6 +138,31 @@ func (k Keeper) CalculateDelegationRewards(ctx sdk.Context, val stakingtypes.Val
 	return rewards
 }
 
+// GetDelegatorWithdrawableRewards returns the rewards the delegator can
+// withdraw from each validator it is delegated to, and their total. The state
+// is not modified.
+func (k Keeper) GetDelegatorWithdrawableRewards(ctx sdk.Context, delAddr sdk.AccAddress) (rewards []types.DelegationDelegatorReward, total sdk.DecCoins) {
+	// ending the validator periods writes to the store, keep it from the caller
+	ctx, _ = ctx.CacheContext()
+
+	total = sdk.DecCoins{}
+	k.stakingKeeper.IterateDelegations(
+		ctx, delAddr,
+		func(_ int64, del stakingtypes.DelegationI) (stop bool) {
+			valAddr := del.GetValidatorAddr()
+			val := k.stakingKeeper.Validator(ctx, valAddr)
+			endingPeriod := k.IncrementValidatorPeriod(ctx, val)
+			delReward := k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
+
+			rewards = append(rewards, types.NewDelegationDelegatorReward(valAddr, delReward))
+			total = total.Add(delReward...)
+			return false
+		},
+	)
+
+	return rewards, total
+}
+
 func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val stakingtypes.ValidatorI, del stakingtypes.DelegationI) (sdk.Coins, error) {
 	// check existence of delegator starting info
 	if !k.HasDelegatorStartingInfo(ctx, del.GetValidatorAddr(), del.GetDelegatorAddr()) {
@@ -169,6 +194,10 @@ func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, val stakingtypes.Vali
 	// add coins to user account
 	if !finalRewards.IsZero() {
 		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, del.GetDelegatorAddr())
+		// self-delegation rewards follow the validator withdraw address
+		if del.GetDelegatorAddr().Equals(sdk.AccAddress(val.GetOperator())) {
+			withdrawAddr = k.GetValidatorRewardWithdrawAddr(ctx, val.GetOperator())
+		}
 		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, finalRewards)
 		if err != nil {
 			return nil, err
@@ -9,6 +9,7 @@ import (
 
 	"cosmossdk.io/math"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
@@ -974,3 +975,78 @@ func Test100PercentCommissionReward(t *testing.T) {
 	}
 	require.True(t, hasValue)
 }
+
+func TestGetDelegatorWithdrawableRewards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(disttypes.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Height: 1})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// reset fee pool
+	distrKeeper.SetFeePool(ctx, disttypes.InitialFeePool())
+	distrKeeper.SetParams(ctx, disttypes.DefaultParams())
+
+	// the delegator is staked to two validators
+	addr := sdk.AccAddress(valConsAddr0)
+	var dels []stakingtypes.DelegationI
+	var vals []stakingtypes.Validator
+	for _, pk := range []cryptotypes.PubKey{valConsPk0, valConsPk1} {
+		val, err := distrtestutil.CreateValidator(pk, sdk.NewInt(1000))
+		require.NoError(t, err)
+		valAddr := val.GetOperator()
+
+		del := stakingtypes.NewDelegation(addr, valAddr, val.DelegatorShares)
+		stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(val).AnyTimes()
+		stakingKeeper.EXPECT().Delegation(gomock.Any(), addr, valAddr).Return(del).AnyTimes()
+
+		require.NoError(t, distrtestutil.CallCreateValidatorHooks(ctx, distrKeeper, addr, valAddr))
+		dels = append(dels, del)
+		vals = append(vals, val)
+	}
+	stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), addr, gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, _ sdk.AccAddress, fn func(int64, stakingtypes.DelegationI) bool) {
+			for i, del := range dels {
+				if fn(int64(i), del) {
+					return
+				}
+			}
+		},
+	).AnyTimes()
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	distrKeeper.AllocateTokensToValidator(ctx, vals[0], sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(10))))
+	distrKeeper.AllocateTokensToValidator(ctx, vals[1], sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(20))))
+
+	rewards, total := distrKeeper.GetDelegatorWithdrawableRewards(ctx, addr)
+	require.Equal(t, []disttypes.DelegationDelegatorReward{
+		disttypes.NewDelegationDelegatorReward(vals[0].GetOperator(), sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(10)))),
+		disttypes.NewDelegationDelegatorReward(vals[1].GetOperator(), sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(20)))),
+	}, rewards)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.NewInt(30))), total)
+
+	// the validator periods are left untouched
+	require.Equal(t, uint64(2), distrKeeper.GetValidatorCurrentRewards(ctx, vals[0].GetOperator()).Period)
+
+	// the query reports the same amounts
+	res, err := keeper.NewQuerier(distrKeeper).DelegationTotalRewards(ctx, &disttypes.QueryDelegationTotalRewardsRequest{DelegatorAddress: addr.String()})
+	require.NoError(t, err)
+	require.Equal(t, rewards, res.Rewards)
+	require.Equal(t, total, res.Total)
+}
@@ -213,27 +213,12 @@ func (k Querier) DelegationTotalRewards(c context.Context, req *types.QueryDeleg
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	total := sdk.DecCoins{}
-	var delRewards []types.DelegationDelegatorReward
-
 	delAdr, err := sdk.AccAddressFromBech32(req.DelegatorAddress)
 	if err != nil {
 		return nil, err
 	}
 
-	k.stakingKeeper.IterateDelegations(
-		ctx, delAdr,
-		func(_ int64, del stakingtypes.DelegationI) (stop bool) {
-			valAddr := del.GetValidatorAddr()
-			val := k.stakingKeeper.Validator(ctx, valAddr)
-			endingPeriod := k.IncrementValidatorPeriod(ctx, val)
-			delReward := k.CalculateDelegationRewards(ctx, val, del, endingPeriod)
-
-			delRewards = append(delRewards, types.NewDelegationDelegatorReward(valAddr, delReward))
-			total = total.Add(delReward...)
-			return false
-		},
-	)
+	delRewards, total := k.GetDelegatorWithdrawableRewards(ctx, delAdr)
 
 	return &types.QueryDelegationTotalRewardsResponse{Rewards: delRewards, Total: total}, nil
 }
@@ -293,3 +278,132 @@ func (k Querier) CommunityPool(c context.Context, req *types.QueryCommunityPoolR
 
 	return &types.QueryCommunityPoolResponse{Pool: pool}, nil
 }
+
+// TotalRewardsBurned returns the cumulative rewards burned for the burn validators
+func (k Querier) TotalRewardsBurned(c context.Context, req *types.QueryTotalRewardsBurnedRequest) (*types.QueryTotalRewardsBurnedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	burned := k.GetTotalRewardsBurned(ctx)
+
+	return &types.QueryTotalRewardsBurnedResponse{Burned: burned}, nil
+}
+
+// UndistributedFees returns the fees collected since the last allocation
+func (k Querier) UndistributedFees(c context.Context, req *types.QueryUndistributedFeesRequest) (*types.QueryUndistributedFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	feeCollector := k.authKeeper.GetModuleAccount(ctx, k.feeCollectorName)
+
+	return &types.QueryUndistributedFeesResponse{
+		FeeCollector: k.bankKeeper.GetAllBalances(ctx, feeCollector.GetAddress()),
+		BurnDust:     k.GetBurnDust(ctx),
+		FeeCarry:     k.GetFeeCarry(ctx),
+	}, nil
+}
+
+// AllValidatorCommissions returns the commission rates and accumulated commission of all validators
+func (k Querier) AllValidatorCommissions(c context.Context, req *types.QueryAllValidatorCommissionsRequest) (*types.QueryAllValidatorCommissionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validators := k.stakingKeeper.GetAllValidators(ctx)
+	total := uint64(len(validators))
+
+	commissions := []types.ValidatorCommissionInfo{}
+	for i := req.Offset; i < total && i < req.Offset+limit; i++ {
+		validator := validators[i]
+		commissions = append(commissions, types.ValidatorCommissionInfo{
+			ValidatorAddress: validator.OperatorAddress,
+			Rate:             validator.Commission.Rate,
+			MaxRate:          validator.Commission.MaxRate,
+			Accumulated:      k.GetValidatorAccumulatedCommission(ctx, validator.GetOperator()).Commission,
+		})
+	}
+
+	return &types.QueryAllValidatorCommissionsResponse{Commissions: commissions, Total: total}, nil
+}
+
+// ValidatorMissedAllocations returns the number of fee allocations a validator was skipped from
+func (k Querier) ValidatorMissedAllocations(c context.Context, req *types.QueryValidatorMissedAllocationsRequest) (*types.QueryValidatorMissedAllocationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAdr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator := k.stakingKeeper.Validator(ctx, valAdr)
+	if validator == nil {
+		return nil, sdkerrors.Wrap(types.ErrNoValidatorExists, req.ValidatorAddress)
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorMissedAllocationsResponse{Missed: k.GetValidatorMissedAllocations(ctx, consAddr)}, nil
+}
+
+// ValidatorSlashEvents queries the slash events of a validator within a block height range
+func (k Querier) ValidatorSlashEvents(c context.Context, req *types.QueryValidatorSlashEventsRequest) (*types.QueryValidatorSlashEventsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ValidatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty validator address")
+	}
+
+	if req.EndHeight < req.StartHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "start height greater than end height (%d > %d)", req.StartHeight, req.EndHeight)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAdr, err := sdk.ValAddressFromBech32(req.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	events := []types.ValidatorSlashEventRecord{}
+	k.IterateValidatorSlashEventsBetween(ctx, valAdr, req.StartHeight, req.EndHeight, func(height uint64, event types.ValidatorSlashEvent) (stop bool) {
+		events = append(events, types.ValidatorSlashEventRecord{
+			ValidatorAddress:    req.ValidatorAddress,
+			Height:              height,
+			Period:              event.ValidatorPeriod,
+			ValidatorSlashEvent: event,
+		})
+		return false
+	})
+
+	return &types.QueryValidatorSlashEventsResponse{SlashEvents: events}, nil
+}
+
+// TotalOutstandingRewards returns the sum of the outstanding rewards of all validators
+func (k Querier) TotalOutstandingRewards(c context.Context, req *types.QueryTotalOutstandingRewardsRequest) (*types.QueryTotalOutstandingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTotalOutstandingRewardsResponse{Rewards: k.GetTotalRewards(ctx)}, nil
+}
@@ -24,6 +24,13 @@ type Keeper struct {
 	authority string
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	hooks types.DistributionHooks
+
+	// eventVerbosity defines the events emitted by the reward allocation
+	eventVerbosity types.EventVerbosity
+
+	treasuryModule string // name of the treasury ModuleAccount, if any
 }
 
 // NewKeeper creates a new distribution Keeper instance
@@ -48,6 +55,50 @@ func NewKeeper(
 	}
 }
 
+// SetHooks sets the reward allocation hooks. In contrast to other receivers,
+// this method must take a pointer as the hooks are set after the keeper is built.
+func (k *Keeper) SetHooks(dh types.DistributionHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set distribution hooks twice")
+	}
+
+	k.hooks = dh
+
+	return k
+}
+
+// SetEventVerbosity sets the events emitted by the reward allocation. Busy
+// chains can trade the per-validator events for a single summary event per
+// block, or turn them off entirely.
+func (k *Keeper) SetEventVerbosity(verbosity types.EventVerbosity) {
+	k.eventVerbosity = verbosity
+}
+
+// EventVerbosity returns the events emitted by the reward allocation.
+func (k Keeper) EventVerbosity() types.EventVerbosity {
+	return k.eventVerbosity
+}
+
+// SetTreasuryModule sets the module account receiving the TreasuryTax cut of
+// the block rewards. The cut is not taken while no treasury module is set.
+func (k *Keeper) SetTreasuryModule(name string) {
+	k.treasuryModule = name
+}
+
+// TreasuryModule returns the module account receiving the TreasuryTax cut of
+// the block rewards.
+func (k Keeper) TreasuryModule() string {
+	return k.treasuryModule
+}
+
+// emitAllocationEvent emits an event of the reward allocation, unless the
+// event verbosity is reduced.
+func (k Keeper) emitAllocationEvent(ctx sdk.Context, event sdk.Event) {
+	if k.eventVerbosity == types.EventVerbosityFull {
+		ctx.EventManager().EmitEvent(event)
+	}
+}
+
 // GetAuthority returns the x/distribution module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -79,6 +130,36 @@ func (k Keeper) SetWithdrawAddr(ctx sdk.Context, delegatorAddr sdk.AccAddress, w
 	return nil
 }
 
+// SetValidatorRewardWithdrawAddress sets the address that receives the commission
+// and self-delegation rewards of a validator, overriding the withdraw address of
+// the operator account. An empty withdraw address clears the override.
+func (k Keeper) SetValidatorRewardWithdrawAddress(ctx sdk.Context, valAddr sdk.ValAddress, withdrawAddr sdk.AccAddress) error {
+	if !k.GetWithdrawAddrEnabled(ctx) {
+		return types.ErrSetWithdrawAddrDisabled
+	}
+
+	if withdrawAddr.Empty() {
+		k.DeleteValidatorRewardWithdrawAddr(ctx, valAddr)
+		withdrawAddr = k.GetValidatorRewardWithdrawAddr(ctx, valAddr)
+	} else {
+		if k.bankKeeper.BlockedAddr(withdrawAddr) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive external funds", withdrawAddr)
+		}
+
+		k.SetValidatorRewardWithdrawAddr(ctx, valAddr, withdrawAddr)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetWithdrawAddress,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddress, withdrawAddr.String()),
+		),
+	)
+
+	return nil
+}
+
 // withdraw rewards from a delegation
 func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error) {
 	val := k.stakingKeeper.Validator(ctx, valAddr)
@@ -118,8 +199,7 @@ func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddr
 	k.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: outstanding.Sub(sdk.NewDecCoinsFromCoins(commission...))})
 
 	if !commission.IsZero() {
-		accAddr := sdk.AccAddress(valAddr)
-		withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, accAddr)
+		withdrawAddr := k.GetValidatorRewardWithdrawAddr(ctx, valAddr)
 		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawAddr, commission)
 		if err != nil {
 			return nil, err
@@ -148,6 +228,52 @@ func (k Keeper) GetTotalRewards(ctx sdk.Context) (totalRewards sdk.DecCoins) {
 	return totalRewards
 }
 
+// ClawbackValidatorRewards moves the given fraction of the current rewards and
+// of the accumulated commission of a validator to the community pool and
+// returns the amount moved. It is meant to be called from evidence handling,
+// so that a validator slashed shortly after being rewarded does not keep the
+// rewards it unfairly earned. The rewards of the periods already ended are left
+// untouched as they are accounted for in the historical rewards.
+func (k Keeper) ClawbackValidatorRewards(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) (sdk.DecCoins, error) {
+	if fraction.IsNil() || fraction.IsNegative() || fraction.GT(sdk.OneDec()) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "clawback fraction must be within [0, 1]: %s", fraction)
+	}
+
+	current := k.GetValidatorCurrentRewards(ctx, valAddr)
+	commission := k.GetValidatorAccumulatedCommission(ctx, valAddr)
+	rewardsClawback := current.Rewards.MulDecTruncate(fraction)
+	commissionClawback := commission.Commission.MulDecTruncate(fraction)
+	clawback := rewardsClawback.Add(commissionClawback...)
+	if clawback.IsZero() {
+		return sdk.DecCoins{}, nil
+	}
+
+	outstanding, hasNeg := k.GetValidatorOutstandingRewardsCoins(ctx, valAddr).SafeSub(clawback)
+	if hasNeg {
+		return nil, sdkerrors.Wrapf(types.ErrNoValidatorDistInfo, "outstanding rewards of %s are below the clawback %s", valAddr, clawback)
+	}
+
+	current.Rewards = current.Rewards.Sub(rewardsClawback)
+	commission.Commission = commission.Commission.Sub(commissionClawback)
+	k.SetValidatorCurrentRewards(ctx, valAddr, current)
+	k.SetValidatorAccumulatedCommission(ctx, valAddr, commission)
+	k.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: outstanding})
+
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(clawback...)
+	k.SetFeePool(ctx, feePool)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClawbackRewards,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, clawback.String()),
+		),
+	)
+
+	return clawback, nil
+}
+
 // FundCommunityPool allows an account to directly fund the community fund pool.
 // The amount is first added to the distribution module account and then directly
 // added to the pool. An error is returned if the amount cannot be sent to the
@@ -163,3 +289,27 @@ func (k Keeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.
 
 	return nil
 }
+
+// FundCommunityPoolFromModule allows another module to fund the community pool.
+// The amount is first sent from the sender module account to the distribution
+// module account and then added to the pool. An error is returned if the amount
+// cannot be sent to the distribution module account.
+func (k Keeper) FundCommunityPoolFromModule(ctx sdk.Context, amount sdk.Coins, senderModule string) error {
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...)
+	k.SetFeePool(ctx, feePool)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCommunityPoolFunded,
+			sdk.NewAttribute(sdk.AttributeKeySender, senderModule),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
@@ -12,12 +12,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	distrtestutil "github.com/cosmos/cosmos-sdk/x/distribution/testutil"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func TestSetWithdrawAddr(t *testing.T) {
@@ -66,6 +68,71 @@ func TestSetWithdrawAddr(t *testing.T) {
 	require.Error(t, distrKeeper.SetWithdrawAddr(ctx, delegatorAddr, distrAcc.GetAddress()))
 }
 
+func TestSetValidatorRewardWithdrawAddress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+	addrs := simtestutil.CreateIncrementalAccounts(3)
+
+	valAddr := sdk.ValAddress(addrs[0])
+	coldWallet := addrs[1]
+	newColdWallet := addrs[2]
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	bankKeeper.EXPECT().BlockedAddr(coldWallet).Return(false).AnyTimes()
+	bankKeeper.EXPECT().BlockedAddr(newColdWallet).Return(false).AnyTimes()
+	bankKeeper.EXPECT().BlockedAddr(distrAcc.GetAddress()).Return(true).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	params := types.DefaultParams()
+	params.WithdrawAddrEnabled = false
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+
+	// defaults to the operator account
+	require.Equal(t, addrs[0], distrKeeper.GetValidatorRewardWithdrawAddr(ctx, valAddr))
+	require.ErrorIs(t, distrKeeper.SetValidatorRewardWithdrawAddress(ctx, valAddr, coldWallet), types.ErrSetWithdrawAddrDisabled)
+
+	params.WithdrawAddrEnabled = true
+	require.NoError(t, distrKeeper.SetParams(ctx, params))
+
+	// set
+	require.NoError(t, distrKeeper.SetValidatorRewardWithdrawAddress(ctx, valAddr, coldWallet))
+	require.Equal(t, coldWallet, distrKeeper.GetValidatorRewardWithdrawAddr(ctx, valAddr))
+	require.Error(t, distrKeeper.SetValidatorRewardWithdrawAddress(ctx, valAddr, distrAcc.GetAddress()))
+
+	// override, commission is routed to the new address
+	require.NoError(t, distrKeeper.SetValidatorRewardWithdrawAddress(ctx, valAddr, newColdWallet))
+	require.Equal(t, newColdWallet, distrKeeper.GetValidatorRewardWithdrawAddr(ctx, valAddr))
+
+	valCommission := sdk.DecCoins{sdk.NewDecCoinFromDec("stake", math.LegacyNewDec(2))}
+	distrKeeper.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: valCommission})
+	distrKeeper.SetValidatorAccumulatedCommission(ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: valCommission})
+	coins := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(2)))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), "distribution", newColdWallet, coins).Return(nil)
+	_, err := distrKeeper.WithdrawValidatorCommission(ctx, valAddr)
+	require.NoError(t, err)
+
+	// clear, falls back to the operator account
+	require.NoError(t, distrKeeper.SetValidatorRewardWithdrawAddress(ctx, valAddr, nil))
+	require.Equal(t, addrs[0], distrKeeper.GetValidatorRewardWithdrawAddr(ctx, valAddr))
+}
+
 func TestWithdrawValidatorCommission(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	key := sdk.NewKVStoreKey(types.StoreKey)
@@ -199,3 +266,410 @@ func TestFundCommunityPool(t *testing.T) {
 
 	require.Equal(t, initPool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...), distrKeeper.GetFeePool(ctx).CommunityPool)
 }
+
+func TestFundCommunityPoolFromModule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+
+	// reset fee pool
+	distrKeeper.SetFeePool(ctx, types.InitialFeePool())
+
+	initPool := distrKeeper.GetFeePool(ctx)
+	require.Empty(t, initPool.CommunityPool)
+
+	// a failed module transfer leaves the pool untouched
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "mock", "distribution", amount).Return(sdkerrors.ErrInsufficientFunds)
+	err := distrKeeper.FundCommunityPoolFromModule(ctx, amount, "mock")
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	require.Empty(t, distrKeeper.GetFeePool(ctx).CommunityPool)
+
+	bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), "mock", "distribution", amount).Return(nil)
+	err = distrKeeper.FundCommunityPoolFromModule(ctx, amount, "mock")
+	require.NoError(t, err)
+
+	require.Equal(t, initPool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(amount...)...), distrKeeper.GetFeePool(ctx).CommunityPool)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeCommunityPoolFunded, events[0].Type)
+}
+
+func TestUndistributedFees(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	feeCollectorAcc := authtypes.NewEmptyModuleAccount("fee_collector")
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), "fee_collector").Return(feeCollectorAcc).AnyTimes()
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	querier := keeper.NewQuerier(distrKeeper)
+
+	// fund the fee collector
+	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(250)))
+	bankKeeper.EXPECT().GetAllBalances(gomock.Any(), feeCollectorAcc.GetAddress()).Return(fees)
+	dust := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDecWithPrec(5, 1)}}
+	distrKeeper.SetBurnDust(ctx, dust)
+	carry := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDecWithPrec(7, 1)}}
+	distrKeeper.SetFeeCarry(ctx, carry)
+
+	res, err := querier.UndistributedFees(ctx, &types.QueryUndistributedFeesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, fees, res.FeeCollector)
+	require.Equal(t, dust, res.BurnDust)
+	require.Equal(t, carry, res.FeeCarry)
+
+	_, err = querier.UndistributedFees(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestAllValidatorCommissions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	querier := keeper.NewQuerier(distrKeeper)
+
+	var validators []stakingtypes.Validator
+	for i, pk := range simtestutil.CreateTestPubKeys(5) {
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+		val.Commission = stakingtypes.NewCommission(math.LegacyNewDecWithPrec(int64(i+1), 2), math.LegacyNewDecWithPrec(int64(i+1), 1), math.LegacyZeroDec())
+		validators = append(validators, val)
+
+		accumulated := sdk.DecCoins{{Denom: sdk.DefaultBondDenom, Amount: math.LegacyNewDec(int64(10 * (i + 1)))}}
+		distrKeeper.SetValidatorAccumulatedCommission(ctx, val.GetOperator(), types.ValidatorAccumulatedCommission{Commission: accumulated})
+	}
+	stakingKeeper.EXPECT().GetAllValidators(gomock.Any()).Return(validators).AnyTimes()
+
+	var commissions []types.ValidatorCommissionInfo
+	for offset := uint64(0); offset < 5; offset += 2 {
+		res, err := querier.AllValidatorCommissions(ctx, &types.QueryAllValidatorCommissionsRequest{Limit: 2, Offset: offset})
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), res.Total)
+		commissions = append(commissions, res.Commissions...)
+	}
+
+	require.Len(t, commissions, 5)
+	for i, val := range validators {
+		require.Equal(t, val.OperatorAddress, commissions[i].ValidatorAddress)
+		require.Equal(t, val.Commission.Rate, commissions[i].Rate)
+		require.Equal(t, val.Commission.MaxRate, commissions[i].MaxRate)
+		require.Equal(t, distrKeeper.GetValidatorAccumulatedCommission(ctx, val.GetOperator()).Commission, commissions[i].Accumulated)
+	}
+
+	// out of range
+	res, err := querier.AllValidatorCommissions(ctx, &types.QueryAllValidatorCommissionsRequest{Offset: 5})
+	require.NoError(t, err)
+	require.Empty(t, res.Commissions)
+}
+
+func TestValidatorSlashEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	querier := keeper.NewQuerier(distrKeeper)
+
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	stakingKeeper.EXPECT().Validator(gomock.Any(), val.GetOperator()).Return(val).AnyTimes()
+	require.NoError(t, distrKeeper.Hooks().AfterValidatorCreated(ctx, val.GetOperator()))
+
+	// record two slashes at different heights
+	fractions := []math.LegacyDec{math.LegacyNewDecWithPrec(5, 2), math.LegacyNewDecWithPrec(1, 1)}
+	heights := []int64{10, 20}
+	for i, fraction := range fractions {
+		require.NoError(t, distrKeeper.Hooks().BeforeValidatorSlashed(ctx.WithBlockHeight(heights[i]), val.GetOperator(), fraction))
+	}
+
+	res, err := querier.ValidatorSlashEvents(ctx, &types.QueryValidatorSlashEventsRequest{
+		ValidatorAddress: val.GetOperator().String(),
+		StartHeight:      1,
+		EndHeight:        20,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.SlashEvents, 2)
+	for i, event := range res.SlashEvents {
+		require.Equal(t, uint64(heights[i]), event.Height)
+		require.Equal(t, fractions[i], event.ValidatorSlashEvent.Fraction)
+	}
+
+	// the range bounds are inclusive
+	res, err = querier.ValidatorSlashEvents(ctx, &types.QueryValidatorSlashEventsRequest{
+		ValidatorAddress: val.GetOperator().String(),
+		StartHeight:      11,
+		EndHeight:        20,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.SlashEvents, 1)
+	require.Equal(t, fractions[1], res.SlashEvents[0].ValidatorSlashEvent.Fraction)
+
+	_, err = querier.ValidatorSlashEvents(ctx, &types.QueryValidatorSlashEventsRequest{
+		ValidatorAddress: val.GetOperator().String(),
+		StartHeight:      20,
+		EndHeight:        10,
+	})
+	require.Error(t, err)
+}
+
+func TestClawbackValidatorRewards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	distrKeeper.SetFeePool(ctx, types.InitialFeePool())
+
+	val, err := distrtestutil.CreateValidator(valConsPk0, math.NewInt(100))
+	require.NoError(t, err)
+	valAddr := val.GetOperator()
+
+	// the validator was rewarded and accrued some commission
+	distrKeeper.AllocateTokensToValidator(ctx, val, sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDec(10))})
+	commission := sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDec(4))}
+	distrKeeper.SetValidatorAccumulatedCommission(ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: commission})
+	distrKeeper.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{
+		Rewards: distrKeeper.GetValidatorOutstandingRewardsCoins(ctx, valAddr).Add(commission...),
+	})
+
+	_, err = distrKeeper.ClawbackValidatorRewards(ctx, valAddr, math.LegacyNewDecWithPrec(15, 1))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = distrKeeper.ClawbackValidatorRewards(ctx, valAddr, math.LegacyNewDec(-1))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	clawback, err := distrKeeper.ClawbackValidatorRewards(ctx, valAddr, math.LegacyNewDecWithPrec(5, 1))
+	require.NoError(t, err)
+
+	// the pool gains the clawback, the validator rewards and commission halve
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDec(7))}, clawback)
+	require.Equal(t, clawback, distrKeeper.GetFeePool(ctx).CommunityPool)
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDec(5))}, distrKeeper.GetValidatorCurrentRewards(ctx, valAddr).Rewards)
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyNewDec(2))}, distrKeeper.GetValidatorAccumulatedCommission(ctx, valAddr).Commission)
+	require.Equal(t, clawback, distrKeeper.GetValidatorOutstandingRewardsCoins(ctx, valAddr))
+
+	// nothing is clawed back from a validator without rewards
+	clawback, err = distrKeeper.ClawbackValidatorRewards(ctx, sdk.ValAddress(valConsAddr1), math.LegacyOneDec())
+	require.NoError(t, err)
+	require.True(t, clawback.IsZero())
+}
+
+func TestTotalOutstandingRewards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	require.NoError(t, distrKeeper.SetParams(ctx, types.DefaultParams()))
+	querier := keeper.NewQuerier(distrKeeper)
+
+	res, err := querier.TotalOutstandingRewards(ctx, &types.QueryTotalOutstandingRewardsRequest{})
+	require.NoError(t, err)
+	require.True(t, res.Rewards.IsZero())
+
+	var expected sdk.DecCoins
+	for i, pk := range PKS[:2] {
+		val, err := distrtestutil.CreateValidator(pk, math.NewInt(100))
+		require.NoError(t, err)
+
+		tokens := sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(int64(105*(i+1)), 1)),
+			sdk.NewDecCoinFromDec("atom", math.LegacyNewDec(int64(i+1))),
+		)
+		distrKeeper.AllocateTokensToValidator(ctx, val, tokens)
+		expected = expected.Add(distrKeeper.GetValidatorOutstandingRewardsCoins(ctx, val.GetOperator())...)
+	}
+
+	res, err = querier.TotalOutstandingRewards(ctx, &types.QueryTotalOutstandingRewardsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, expected, res.Rewards)
+	require.Equal(t, sdk.DecCoins{
+		sdk.NewDecCoinFromDec("atom", math.LegacyNewDec(3)),
+		sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(315, 1)),
+	}, res.Rewards)
+
+	_, err = querier.TotalOutstandingRewards(ctx, nil)
+	require.Error(t, err)
+}
+
+func TestAfterValidatorRemovedResidualRewards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	distrKeeper.SetFeePool(ctx, types.InitialFeePool())
+
+	valAddr := sdk.ValAddress(valConsAddr0)
+	outstanding := sdk.DecCoins{sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(125, 1))}
+	commission := sdk.DecCoins{sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(25, 1))}
+	distrKeeper.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: outstanding})
+	distrKeeper.SetValidatorAccumulatedCommission(ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: commission})
+
+	// the integral commission is force-withdrawn, its remainder and the residual
+	// rewards land in the pool exactly once
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.AccAddress(valAddr), sdk.NewCoins(sdk.NewInt64Coin("stake", 2)))
+	require.NoError(t, distrKeeper.Hooks().AfterValidatorRemoved(ctx, valConsAddr0, valAddr))
+	require.Equal(t, sdk.DecCoins{sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(105, 1))}, distrKeeper.GetFeePool(ctx).CommunityPool)
+	require.True(t, distrKeeper.GetValidatorOutstandingRewardsCoins(ctx, valAddr).IsZero())
+	require.True(t, distrKeeper.GetValidatorAccumulatedCommission(ctx, valAddr).Commission.IsZero())
+}
+
+func TestAfterValidatorRemovedRewardWithdrawAddress(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, sdk.NewTransientStoreKey("transient_test"))
+	encCfg := moduletestutil.MakeTestEncodingConfig(distribution.AppModuleBasic{})
+	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: time.Now()})
+
+	bankKeeper := distrtestutil.NewMockBankKeeper(ctrl)
+	stakingKeeper := distrtestutil.NewMockStakingKeeper(ctrl)
+	accountKeeper := distrtestutil.NewMockAccountKeeper(ctrl)
+
+	accountKeeper.EXPECT().GetModuleAddress("distribution").Return(distrAcc.GetAddress())
+
+	distrKeeper := keeper.NewKeeper(
+		encCfg.Codec,
+		key,
+		accountKeeper,
+		bankKeeper,
+		stakingKeeper,
+		"fee_collector",
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	require.NoError(t, distrKeeper.SetParams(ctx, types.DefaultParams()))
+	distrKeeper.SetFeePool(ctx, types.InitialFeePool())
+
+	valAddr := sdk.ValAddress(valConsAddr0)
+	coldWallet := sdk.AccAddress(valConsAddr1)
+	bankKeeper.EXPECT().BlockedAddr(coldWallet).Return(false)
+	require.NoError(t, distrKeeper.SetValidatorRewardWithdrawAddress(ctx, valAddr, coldWallet))
+
+	commission := sdk.DecCoins{sdk.NewDecCoinFromDec("stake", math.LegacyNewDec(5))}
+	distrKeeper.SetValidatorOutstandingRewards(ctx, valAddr, types.ValidatorOutstandingRewards{Rewards: commission})
+	distrKeeper.SetValidatorAccumulatedCommission(ctx, valAddr, types.ValidatorAccumulatedCommission{Commission: commission})
+
+	// the force-withdrawn commission goes to the override, as on a manual withdrawal
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, coldWallet, sdk.NewCoins(sdk.NewInt64Coin("stake", 5)))
+	require.NoError(t, distrKeeper.Hooks().AfterValidatorRemoved(ctx, valConsAddr0, valAddr))
+
+	// the override is cleared with the validator
+	require.Equal(t, sdk.AccAddress(valAddr), distrKeeper.GetValidatorRewardWithdrawAddr(ctx, valAddr))
+}
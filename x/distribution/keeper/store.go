@@ -29,6 +29,29 @@ func (k Keeper) DeleteDelegatorWithdrawAddr(ctx sdk.Context, delAddr, withdrawAd
 	store.Delete(types.GetDelegatorWithdrawAddrKey(delAddr))
 }
 
+// get the validator reward withdraw address, defaulting to the withdraw address
+// of the validator operator account
+func (k Keeper) GetValidatorRewardWithdrawAddr(ctx sdk.Context, valAddr sdk.ValAddress) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetValidatorRewardWithdrawAddrKey(valAddr))
+	if b == nil {
+		return k.GetDelegatorWithdrawAddr(ctx, sdk.AccAddress(valAddr))
+	}
+	return sdk.AccAddress(b)
+}
+
+// set the validator reward withdraw address
+func (k Keeper) SetValidatorRewardWithdrawAddr(ctx sdk.Context, valAddr sdk.ValAddress, withdrawAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorRewardWithdrawAddrKey(valAddr), withdrawAddr.Bytes())
+}
+
+// delete a validator reward withdraw addr
+func (k Keeper) DeleteValidatorRewardWithdrawAddr(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorRewardWithdrawAddrKey(valAddr))
+}
+
 // iterate over delegator withdraw addrs
 func (k Keeper) IterateDelegatorWithdrawAddrs(ctx sdk.Context, handler func(del sdk.AccAddress, addr sdk.AccAddress) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
@@ -61,6 +84,126 @@ func (k Keeper) SetFeePool(ctx sdk.Context, feePool types.FeePool) {
 	store.Set(types.FeePoolKey, b)
 }
 
+// get the cumulative rewards burned for the burn validators
+func (k Keeper) GetTotalRewardsBurned(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.TotalRewardsBurnedKey)
+	if b == nil {
+		return sdk.NewCoins()
+	}
+	var burned types.RewardsBurned
+	k.cdc.MustUnmarshal(b, &burned)
+	return burned.Amount
+}
+
+// set the cumulative rewards burned for the burn validators
+func (k Keeper) SetTotalRewardsBurned(ctx sdk.Context, burned sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&types.RewardsBurned{Amount: burned})
+	store.Set(types.TotalRewardsBurnedKey, b)
+}
+
+// get the sub-unit remainders of burned rewards carried over to the next burn
+func (k Keeper) GetBurnDust(ctx sdk.Context) sdk.DecCoins {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.BurnDustKey)
+	if b == nil {
+		return sdk.DecCoins{}
+	}
+	var dust types.BurnDust
+	k.cdc.MustUnmarshal(b, &dust)
+	return dust.Amount
+}
+
+// set the sub-unit remainders of burned rewards carried over to the next burn
+func (k Keeper) SetBurnDust(ctx sdk.Context, dust sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&types.BurnDust{Amount: dust})
+	store.Set(types.BurnDustKey, b)
+}
+
+// get the fractional miner fees carried over to the next allocation
+func (k Keeper) GetFeeCarry(ctx sdk.Context) sdk.DecCoins {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.FeeCarryKey)
+	if b == nil {
+		return sdk.DecCoins{}
+	}
+	var carry types.FeeCarry
+	k.cdc.MustUnmarshal(b, &carry)
+	return carry.Amount
+}
+
+// set the fractional miner fees carried over to the next allocation
+func (k Keeper) SetFeeCarry(ctx sdk.Context, carry sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&types.FeeCarry{Amount: carry})
+	store.Set(types.FeeCarryKey, b)
+}
+
+// get the vote participation of a validator over the trailing blocks
+func (k Keeper) GetValidatorParticipation(ctx sdk.Context, consAddr sdk.ConsAddress) (participation types.ValidatorParticipation) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetValidatorParticipationKey(consAddr))
+	if b == nil {
+		return participation
+	}
+	k.cdc.MustUnmarshal(b, &participation)
+	return participation
+}
+
+// set the vote participation of a validator over the trailing blocks
+func (k Keeper) SetValidatorParticipation(ctx sdk.Context, consAddr sdk.ConsAddress, participation types.ValidatorParticipation) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&participation)
+	store.Set(types.GetValidatorParticipationKey(consAddr), b)
+}
+
+// delete the vote participation of a validator
+func (k Keeper) DeleteValidatorParticipation(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorParticipationKey(consAddr))
+}
+
+// get the number of allocations a validator was skipped from
+func (k Keeper) GetValidatorMissedAllocations(ctx sdk.Context, consAddr sdk.ConsAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GetValidatorMissedAllocationsKey(consAddr))
+	if b == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(b)
+}
+
+// increment the number of allocations a validator was skipped from
+func (k Keeper) IncrementValidatorMissedAllocations(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	missed := k.GetValidatorMissedAllocations(ctx, consAddr) + 1
+	store.Set(types.GetValidatorMissedAllocationsKey(consAddr), sdk.Uint64ToBigEndian(missed))
+}
+
+// delete the missed allocations counter of a validator
+func (k Keeper) DeleteValidatorMissedAllocations(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorMissedAllocationsKey(consAddr))
+}
+
+// get the rotating index of the validator credited with the truncation dust
+func (k Keeper) GetDustRecipientIndex(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.DustRecipientIndexKey)
+	if b == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(b)
+}
+
+// set the rotating index of the validator credited with the truncation dust
+func (k Keeper) SetDustRecipientIndex(ctx sdk.Context, index uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DustRecipientIndexKey, sdk.Uint64ToBigEndian(index))
+}
+
 // GetPreviousProposerConsAddr returns the proposer consensus address for the
 // current block.
 func (k Keeper) GetPreviousProposerConsAddr(ctx sdk.Context) sdk.ConsAddress {
@@ -41,6 +41,27 @@ type Params struct {
 	BurnValidators []string `protobuf:"bytes,5,rep,name=burn_validators,json=burnValidators,proto3" json:"burn_validators,omitempty"`
 	// voter_rewards defines the voter rewards ratio and beneficiary address
 	VoterRewards *VoterRewards `protobuf:"bytes,6,opt,name=voter_rewards,json=voterRewards,proto3" json:"voter_rewards,omitempty"`
+	// participation_penalty defines the optional reward penalty for validators
+	// with a low vote participation
+	ParticipationPenalty *ParticipationPenalty `protobuf:"bytes,7,opt,name=participation_penalty,json=participationPenalty,proto3" json:"participation_penalty,omitempty"`
+	// max_accrued_rewards defines the optional per denom cap on the current
+	// rewards a validator accrues, the overflow goes to the community pool
+	MaxAccruedRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,8,rep,name=max_accrued_rewards,json=maxAccruedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"max_accrued_rewards"`
+	// min_validator_share defines the optional minimum share of the block
+	// rewards left to validators once the community tax and the voter rewards
+	// ratio are applied, i.e. (1 - community_tax) * (1 - voter_rewards.ratio)
+	MinValidatorShare *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=min_validator_share,json=minValidatorShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_validator_share,omitempty"`
+	// fee_drain_fraction defines the optional fraction of the fee collector
+	// balance drained for allocation each block, the remainder is kept in the
+	// fee collector as a reserve. Unset drains the whole balance.
+	FeeDrainFraction *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=fee_drain_fraction,json=feeDrainFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_drain_fraction,omitempty"`
+	// treasury_tax defines the optional share of the block rewards left once the
+	// community tax is applied that is sent to the treasury module before the
+	// split by power. It only applies when the keeper has a treasury module set.
+	TreasuryTax *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=treasury_tax,json=treasuryTax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"treasury_tax,omitempty"`
+	// withhold_jailed_rewards defines whether validators jailed since they voted
+	// are skipped by the allocation, their share going to the community pool
+	WithholdJailedRewards bool `protobuf:"varint,12,opt,name=withhold_jailed_rewards,json=withholdJailedRewards,proto3" json:"withhold_jailed_rewards,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -96,6 +117,27 @@ func (m *Params) GetVoterRewards() *VoterRewards {
 	return nil
 }
 
+func (m *Params) GetParticipationPenalty() *ParticipationPenalty {
+	if m != nil {
+		return m.ParticipationPenalty
+	}
+	return nil
+}
+
+func (m *Params) GetMaxAccruedRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MaxAccruedRewards
+	}
+	return nil
+}
+
+func (m *Params) GetWithholdJailedRewards() bool {
+	if m != nil {
+		return m.WithholdJailedRewards
+	}
+	return false
+}
+
 // VoterRewards defines voter beneficiary ratio and address from minted block.
 type VoterRewards struct {
 	Ratio         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=ratio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ratio"`
@@ -142,6 +184,106 @@ func (m *VoterRewards) GetSettleHeights() int64 {
 	return 0
 }
 
+// ParticipationPenalty defines the reward penalty applied to validators whose
+// vote participation over the trailing window is below the threshold. The
+// withheld rewards go to the community pool.
+type ParticipationPenalty struct {
+	// window is the number of trailing blocks the participation is tracked over.
+	// Zero disables the penalty.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// min_participation is the fraction of signed blocks below which the penalty
+	// applies.
+	MinParticipation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_participation,json=minParticipation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_participation"`
+	// penalty is the fraction of the rewards withheld from the validator.
+	Penalty github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=penalty,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"penalty"`
+}
+
+func (m *ParticipationPenalty) Reset()         { *m = ParticipationPenalty{} }
+func (m *ParticipationPenalty) String() string { return proto.CompactTextString(m) }
+func (*ParticipationPenalty) ProtoMessage()    {}
+func (*ParticipationPenalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{2}
+}
+func (m *ParticipationPenalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipationPenalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipationPenalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipationPenalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipationPenalty.Merge(m, src)
+}
+func (m *ParticipationPenalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipationPenalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipationPenalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipationPenalty proto.InternalMessageInfo
+
+func (m *ParticipationPenalty) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// ValidatorParticipation records whether a validator signed each of the
+// trailing blocks, oldest first.
+type ValidatorParticipation struct {
+	SignedBlocks []bool `protobuf:"varint,1,rep,packed,name=signed_blocks,json=signedBlocks,proto3" json:"signed_blocks,omitempty"`
+}
+
+func (m *ValidatorParticipation) Reset()         { *m = ValidatorParticipation{} }
+func (m *ValidatorParticipation) String() string { return proto.CompactTextString(m) }
+func (*ValidatorParticipation) ProtoMessage()    {}
+func (*ValidatorParticipation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{3}
+}
+func (m *ValidatorParticipation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorParticipation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorParticipation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorParticipation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorParticipation.Merge(m, src)
+}
+func (m *ValidatorParticipation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorParticipation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorParticipation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorParticipation proto.InternalMessageInfo
+
+func (m *ValidatorParticipation) GetSignedBlocks() []bool {
+	if m != nil {
+		return m.SignedBlocks
+	}
+	return nil
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -164,7 +306,7 @@ func (m *ValidatorHistoricalRewards) Reset()         { *m = ValidatorHistoricalR
 func (m *ValidatorHistoricalRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorHistoricalRewards) ProtoMessage()    {}
 func (*ValidatorHistoricalRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{2}
+	return fileDescriptor_cd78a31ea281a992, []int{4}
 }
 func (m *ValidatorHistoricalRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -219,7 +361,7 @@ func (m *ValidatorCurrentRewards) Reset()         { *m = ValidatorCurrentRewards
 func (m *ValidatorCurrentRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorCurrentRewards) ProtoMessage()    {}
 func (*ValidatorCurrentRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{3}
+	return fileDescriptor_cd78a31ea281a992, []int{5}
 }
 func (m *ValidatorCurrentRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,7 +414,7 @@ func (m *ValidatorAccumulatedCommission) Reset()         { *m = ValidatorAccumul
 func (m *ValidatorAccumulatedCommission) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAccumulatedCommission) ProtoMessage()    {}
 func (*ValidatorAccumulatedCommission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{4}
+	return fileDescriptor_cd78a31ea281a992, []int{6}
 }
 func (m *ValidatorAccumulatedCommission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -318,7 +460,7 @@ func (m *ValidatorOutstandingRewards) Reset()         { *m = ValidatorOutstandin
 func (m *ValidatorOutstandingRewards) String() string { return proto.CompactTextString(m) }
 func (*ValidatorOutstandingRewards) ProtoMessage()    {}
 func (*ValidatorOutstandingRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{5}
+	return fileDescriptor_cd78a31ea281a992, []int{7}
 }
 func (m *ValidatorOutstandingRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -367,7 +509,7 @@ func (m *ValidatorSlashEvent) Reset()         { *m = ValidatorSlashEvent{} }
 func (m *ValidatorSlashEvent) String() string { return proto.CompactTextString(m) }
 func (*ValidatorSlashEvent) ProtoMessage()    {}
 func (*ValidatorSlashEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{6}
+	return fileDescriptor_cd78a31ea281a992, []int{8}
 }
 func (m *ValidatorSlashEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -411,7 +553,7 @@ type ValidatorSlashEvents struct {
 func (m *ValidatorSlashEvents) Reset()      { *m = ValidatorSlashEvents{} }
 func (*ValidatorSlashEvents) ProtoMessage() {}
 func (*ValidatorSlashEvents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{7}
+	return fileDescriptor_cd78a31ea281a992, []int{9}
 }
 func (m *ValidatorSlashEvents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -456,7 +598,7 @@ func (m *FeePool) Reset()         { *m = FeePool{} }
 func (m *FeePool) String() string { return proto.CompactTextString(m) }
 func (*FeePool) ProtoMessage()    {}
 func (*FeePool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{8}
+	return fileDescriptor_cd78a31ea281a992, []int{10}
 }
 func (m *FeePool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -492,6 +634,144 @@ func (m *FeePool) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.DecCoins
 	return nil
 }
 
+// RewardsBurned defines the cumulative amount of rewards burned for the burn
+// validators.
+type RewardsBurned struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *RewardsBurned) Reset()         { *m = RewardsBurned{} }
+func (m *RewardsBurned) String() string { return proto.CompactTextString(m) }
+func (*RewardsBurned) ProtoMessage()    {}
+func (*RewardsBurned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{11}
+}
+func (m *RewardsBurned) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardsBurned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardsBurned.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardsBurned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardsBurned.Merge(m, src)
+}
+func (m *RewardsBurned) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardsBurned) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardsBurned.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardsBurned proto.InternalMessageInfo
+
+func (m *RewardsBurned) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// BurnDust defines the sub-unit remainders of burned rewards that are carried
+// over until they add up to whole units.
+type BurnDust struct {
+	Amount github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"amount"`
+}
+
+func (m *BurnDust) Reset()         { *m = BurnDust{} }
+func (m *BurnDust) String() string { return proto.CompactTextString(m) }
+func (*BurnDust) ProtoMessage()    {}
+func (*BurnDust) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{12}
+}
+func (m *BurnDust) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnDust) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnDust.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BurnDust) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnDust.Merge(m, src)
+}
+func (m *BurnDust) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnDust) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnDust.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnDust proto.InternalMessageInfo
+
+func (m *BurnDust) GetAmount() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// FeeCarry defines the fractional remainder of the miner fees that could not be
+// transferred in whole units and is carried over to the next allocation.
+type FeeCarry struct {
+	Amount github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"amount"`
+}
+
+func (m *FeeCarry) Reset()         { *m = FeeCarry{} }
+func (m *FeeCarry) String() string { return proto.CompactTextString(m) }
+func (*FeeCarry) ProtoMessage()    {}
+func (*FeeCarry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd78a31ea281a992, []int{13}
+}
+func (m *FeeCarry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeCarry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeCarry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeCarry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeCarry.Merge(m, src)
+}
+func (m *FeeCarry) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeCarry) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeCarry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeCarry proto.InternalMessageInfo
+
+func (m *FeeCarry) GetAmount() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 // CommunityPoolSpendProposal details a proposal for use of community funds,
 // together with how many coins are proposed to be spent, and to which
 // recipient account.
@@ -512,7 +792,7 @@ type CommunityPoolSpendProposal struct {
 func (m *CommunityPoolSpendProposal) Reset()      { *m = CommunityPoolSpendProposal{} }
 func (*CommunityPoolSpendProposal) ProtoMessage() {}
 func (*CommunityPoolSpendProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{9}
+	return fileDescriptor_cd78a31ea281a992, []int{14}
 }
 func (m *CommunityPoolSpendProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -557,7 +837,7 @@ func (m *DelegatorStartingInfo) Reset()         { *m = DelegatorStartingInfo{} }
 func (m *DelegatorStartingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorStartingInfo) ProtoMessage()    {}
 func (*DelegatorStartingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{10}
+	return fileDescriptor_cd78a31ea281a992, []int{15}
 }
 func (m *DelegatorStartingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,7 +891,7 @@ func (m *DelegationDelegatorReward) Reset()         { *m = DelegationDelegatorRe
 func (m *DelegationDelegatorReward) String() string { return proto.CompactTextString(m) }
 func (*DelegationDelegatorReward) ProtoMessage()    {}
 func (*DelegationDelegatorReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{11}
+	return fileDescriptor_cd78a31ea281a992, []int{16}
 }
 func (m *DelegationDelegatorReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -654,7 +934,7 @@ func (m *CommunityPoolSpendProposalWithDeposit) Reset()         { *m = Community
 func (m *CommunityPoolSpendProposalWithDeposit) String() string { return proto.CompactTextString(m) }
 func (*CommunityPoolSpendProposalWithDeposit) ProtoMessage()    {}
 func (*CommunityPoolSpendProposalWithDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd78a31ea281a992, []int{12}
+	return fileDescriptor_cd78a31ea281a992, []int{17}
 }
 func (m *CommunityPoolSpendProposalWithDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -686,6 +966,8 @@ var xxx_messageInfo_CommunityPoolSpendProposalWithDeposit proto.InternalMessageI
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.distribution.v1beta1.Params")
 	proto.RegisterType((*VoterRewards)(nil), "cosmos.distribution.v1beta1.VoterRewards")
+	proto.RegisterType((*ParticipationPenalty)(nil), "cosmos.distribution.v1beta1.ParticipationPenalty")
+	proto.RegisterType((*ValidatorParticipation)(nil), "cosmos.distribution.v1beta1.ValidatorParticipation")
 	proto.RegisterType((*ValidatorHistoricalRewards)(nil), "cosmos.distribution.v1beta1.ValidatorHistoricalRewards")
 	proto.RegisterType((*ValidatorCurrentRewards)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewards")
 	proto.RegisterType((*ValidatorAccumulatedCommission)(nil), "cosmos.distribution.v1beta1.ValidatorAccumulatedCommission")
@@ -693,6 +975,9 @@ func init() {
 	proto.RegisterType((*ValidatorSlashEvent)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvent")
 	proto.RegisterType((*ValidatorSlashEvents)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEvents")
 	proto.RegisterType((*FeePool)(nil), "cosmos.distribution.v1beta1.FeePool")
+	proto.RegisterType((*RewardsBurned)(nil), "cosmos.distribution.v1beta1.RewardsBurned")
+	proto.RegisterType((*BurnDust)(nil), "cosmos.distribution.v1beta1.BurnDust")
+	proto.RegisterType((*FeeCarry)(nil), "cosmos.distribution.v1beta1.FeeCarry")
 	proto.RegisterType((*CommunityPoolSpendProposal)(nil), "cosmos.distribution.v1beta1.CommunityPoolSpendProposal")
 	proto.RegisterType((*DelegatorStartingInfo)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfo")
 	proto.RegisterType((*DelegationDelegatorReward)(nil), "cosmos.distribution.v1beta1.DelegationDelegatorReward")
@@ -704,75 +989,92 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1082 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x4f, 0x1b, 0xc7,
-	0x17, 0xf7, 0x04, 0xdb, 0x81, 0xe1, 0xd7, 0x37, 0x83, 0x21, 0xc6, 0x89, 0x6c, 0x6b, 0xa5, 0xe4,
-	0x6b, 0x68, 0x30, 0x85, 0xaa, 0x52, 0x85, 0xaa, 0x4a, 0x18, 0x53, 0xa5, 0xa7, 0xa0, 0xa5, 0x4d,
-	0xab, 0x5e, 0xac, 0xf1, 0xee, 0x60, 0x8f, 0xb2, 0x9e, 0xd9, 0xce, 0x8c, 0x0d, 0x39, 0xf4, 0x9e,
-	0xe6, 0xd0, 0xf6, 0x18, 0xf5, 0x84, 0xda, 0x4b, 0xd4, 0x13, 0x07, 0xa4, 0xfe, 0x0b, 0x51, 0x4f,
-	0x51, 0x0e, 0x6d, 0x15, 0x55, 0xb4, 0x82, 0x03, 0x55, 0xff, 0x8a, 0x6a, 0x76, 0x66, 0xd7, 0x86,
-	0x50, 0x14, 0x29, 0xa0, 0x5e, 0xc0, 0xf3, 0xde, 0xee, 0xfb, 0xfc, 0x98, 0x37, 0xf3, 0x16, 0x56,
-	0x3d, 0x2e, 0x3b, 0x5c, 0x2e, 0xfa, 0x54, 0x2a, 0x41, 0x9b, 0x5d, 0x45, 0x39, 0x5b, 0xec, 0x2d,
-	0x35, 0x89, 0xc2, 0x4b, 0x27, 0x82, 0xd5, 0x50, 0x70, 0xc5, 0xd1, 0x0d, 0xf3, 0x7c, 0xf5, 0x44,
-	0xca, 0x3e, 0x5f, 0xc8, 0xb5, 0x78, 0x8b, 0x47, 0xcf, 0x2d, 0xea, 0x5f, 0xe6, 0x95, 0x42, 0xd1,
-	0x42, 0x34, 0xb1, 0x24, 0x49, 0x69, 0x8f, 0x53, 0x5b, 0xb2, 0x30, 0x6b, 0xf2, 0x0d, 0xf3, 0xa2,
-	0xad, 0x6f, 0x52, 0xd7, 0x70, 0x87, 0x32, 0xbe, 0x18, 0xfd, 0x35, 0x21, 0xe7, 0xa7, 0x34, 0xcc,
-	0x6e, 0x60, 0x81, 0x3b, 0x12, 0x61, 0x38, 0xee, 0xf1, 0x4e, 0xa7, 0xcb, 0xa8, 0x7a, 0xd8, 0x50,
-	0x78, 0x27, 0x0f, 0xca, 0xa0, 0x32, 0x52, 0x7b, 0xff, 0xd9, 0x41, 0x29, 0xf5, 0xf2, 0xa0, 0x74,
-	0xbb, 0x45, 0x55, 0xbb, 0xdb, 0xac, 0x7a, 0xbc, 0x63, 0xab, 0xda, 0x7f, 0x0b, 0xd2, 0x7f, 0xb0,
-	0xa8, 0x1e, 0x86, 0x44, 0x56, 0xeb, 0xc4, 0x7b, 0xb1, 0xbf, 0x00, 0x2d, 0x68, 0x9d, 0x78, 0xee,
-	0x58, 0x52, 0xf2, 0x63, 0xbc, 0x83, 0x42, 0x98, 0xd3, 0xb4, 0x35, 0xb7, 0x90, 0x4b, 0x22, 0x1a,
-	0x82, 0x6c, 0x63, 0xe1, 0xe7, 0xaf, 0x44, 0x48, 0x1f, 0xbc, 0x09, 0x52, 0x1e, 0xb8, 0x48, 0xd7,
-	0xde, 0xb0, 0xa5, 0xdd, 0xa8, 0x32, 0x12, 0x70, 0xba, 0xc9, 0x59, 0x57, 0xbe, 0x02, 0x39, 0x74,
-	0x21, 0x90, 0x53, 0x51, 0xf1, 0x53, 0x98, 0xcb, 0x70, 0x7a, 0x9b, 0xaa, 0xb6, 0x2f, 0xf0, 0x76,
-	0x03, 0xfb, 0xbe, 0x68, 0x10, 0x86, 0x9b, 0x01, 0xf1, 0xf3, 0xe9, 0x32, 0xa8, 0x0c, 0xbb, 0x53,
-	0x71, 0x72, 0xd5, 0xf7, 0xc5, 0xba, 0x49, 0xa1, 0x2a, 0x9c, 0x6c, 0x76, 0x05, 0x6b, 0xf4, 0x70,
-	0x40, 0x7d, 0xac, 0xb8, 0x90, 0xf9, 0x4c, 0x79, 0xa8, 0x32, 0x52, 0xcb, 0x3c, 0x3d, 0xde, 0x9b,
-	0x07, 0xee, 0x84, 0xce, 0xde, 0x4f, 0x92, 0xe8, 0x13, 0x38, 0xde, 0xe3, 0x2a, 0x91, 0x23, 0xf3,
-	0xd9, 0x32, 0xa8, 0x8c, 0x2e, 0xcf, 0x55, 0xcf, 0x69, 0xa8, 0xea, 0x7d, 0xfd, 0x86, 0x21, 0x29,
-	0xe3, 0xc2, 0x63, 0xbd, 0x81, 0xe0, 0xca, 0xdc, 0x93, 0xdd, 0x52, 0xea, 0xf1, 0xf1, 0xde, 0x7c,
-	0x79, 0x40, 0xfe, 0xce, 0xc9, 0x76, 0x36, 0xed, 0xe2, 0x7c, 0x05, 0xe0, 0xd8, 0x60, 0x41, 0xe4,
-	0xc2, 0x8c, 0xc0, 0x8a, 0xf2, 0x0b, 0xe9, 0x1b, 0x53, 0x0a, 0xdd, 0x82, 0x13, 0x92, 0x28, 0x15,
-	0x90, 0x46, 0x9b, 0xd0, 0x56, 0x5b, 0xc9, 0xa8, 0x55, 0x86, 0xdc, 0x71, 0x13, 0xbd, 0x6b, 0x82,
-	0xce, 0x2f, 0x00, 0x16, 0x12, 0x73, 0xee, 0x52, 0xa9, 0xb8, 0xa0, 0x1e, 0x0e, 0x62, 0x66, 0x5f,
-	0x03, 0x78, 0xdd, 0xeb, 0x76, 0xba, 0x01, 0x56, 0xb4, 0x47, 0xac, 0x65, 0x8d, 0x98, 0xec, 0x50,
-	0x65, 0x74, 0xf9, 0x66, 0xec, 0x9b, 0x6e, 0xa1, 0xc4, 0xaf, 0x3a, 0xf1, 0xd6, 0x38, 0x65, 0xb5,
-	0xf7, 0xb4, 0x94, 0x1f, 0xff, 0x28, 0xbd, 0xf5, 0x7a, 0x52, 0xf4, 0x3b, 0xd2, 0xb8, 0x3b, 0xdd,
-	0x87, 0x35, 0x64, 0xdc, 0x48, 0xd6, 0xff, 0xe1, 0xa4, 0x20, 0x5b, 0x44, 0x10, 0xe6, 0x91, 0x86,
-	0xc7, 0xbb, 0x4c, 0x45, 0xba, 0xc6, 0xdd, 0x89, 0x24, 0xbc, 0xa6, 0xa3, 0xce, 0x0f, 0x00, 0x5e,
-	0x4f, 0x84, 0xad, 0x75, 0x85, 0x20, 0x4c, 0xc5, 0xaa, 0x42, 0x78, 0x35, 0xde, 0xfc, 0xcb, 0x15,
-	0x11, 0xc3, 0xa0, 0x19, 0x98, 0x0d, 0x89, 0xa0, 0xdc, 0x1c, 0xd8, 0xb4, 0x6b, 0x57, 0xce, 0x13,
-	0x00, 0x8b, 0x09, 0xcb, 0x55, 0xcf, 0x6a, 0x26, 0xfe, 0x1a, 0xef, 0x74, 0xa8, 0x94, 0x94, 0x33,
-	0xd4, 0x83, 0xd0, 0x4b, 0x56, 0x97, 0xcc, 0x77, 0x00, 0xc9, 0xf9, 0x06, 0xc0, 0x1b, 0x09, 0xb5,
-	0x7b, 0x5d, 0x25, 0x15, 0x66, 0x3e, 0x65, 0xad, 0xff, 0xcc, 0x44, 0xe7, 0x3b, 0x00, 0xa7, 0x12,
-	0x46, 0x9b, 0x01, 0x96, 0xed, 0xf5, 0x1e, 0x61, 0x0a, 0xcd, 0xc1, 0xff, 0x25, 0x87, 0xbf, 0x61,
-	0x6d, 0x06, 0x91, 0xcd, 0x93, 0x49, 0x7c, 0x23, 0x0a, 0xa3, 0xcf, 0xe0, 0xf0, 0x96, 0xc0, 0x9e,
-	0x3e, 0x8d, 0xf6, 0xea, 0x7c, 0xb3, 0xc3, 0x96, 0x54, 0xd3, 0x76, 0xe5, 0xce, 0x20, 0x27, 0xd1,
-	0x17, 0x70, 0xa6, 0xcf, 0x4e, 0xea, 0x44, 0x83, 0x44, 0x19, 0x6b, 0xdb, 0xdb, 0xe7, 0x5f, 0x3c,
-	0xaf, 0x96, 0xac, 0x8d, 0x68, 0xca, 0xc6, 0x9b, 0x5c, 0xef, 0x0c, 0xc8, 0x95, 0xb4, 0xbe, 0x8b,
-	0x9c, 0x47, 0x00, 0x5e, 0xfd, 0x90, 0x90, 0x0d, 0xce, 0x03, 0xf4, 0x25, 0x9c, 0xe8, 0x4f, 0xa8,
-	0x90, 0xf3, 0xe0, 0x92, 0xf7, 0xac, 0x3f, 0x0f, 0x35, 0xbc, 0xf3, 0xf8, 0x0a, 0x2c, 0xac, 0x0d,
-	0x46, 0x36, 0x43, 0xc2, 0x7c, 0x73, 0xf9, 0xe3, 0x00, 0xe5, 0x60, 0x46, 0x51, 0x15, 0x10, 0x73,
-	0xff, 0xb9, 0x66, 0x81, 0xca, 0x70, 0xd4, 0x27, 0xd2, 0x13, 0x34, 0xec, 0x6f, 0x97, 0x3b, 0x18,
-	0x42, 0x37, 0xe1, 0x88, 0x20, 0x1e, 0x0d, 0x29, 0x61, 0xca, 0x8c, 0x25, 0xb7, 0x1f, 0x40, 0x6d,
-	0x98, 0xc5, 0x9d, 0xe8, 0x86, 0x48, 0x47, 0x5a, 0x67, 0xcf, 0xd4, 0x1a, 0x09, 0x7d, 0xd7, 0x0a,
-	0xad, 0xbc, 0x86, 0xd0, 0x01, 0x95, 0xb6, 0xfe, 0xca, 0x9d, 0x47, 0xbb, 0xa5, 0x94, 0xf6, 0xfc,
-	0xaf, 0xdd, 0x52, 0xea, 0xe7, 0xfd, 0x85, 0x82, 0x05, 0x6a, 0xf1, 0xde, 0x00, 0x0e, 0x53, 0x9a,
-	0x26, 0x70, 0x5e, 0x02, 0x38, 0x5d, 0x27, 0x01, 0x69, 0x45, 0xdb, 0xa6, 0xb0, 0x50, 0x94, 0xb5,
-	0x3e, 0x62, 0x5b, 0xd1, 0xe5, 0x16, 0x0a, 0xd2, 0xa3, 0x5c, 0x4f, 0xdd, 0xc1, 0x3e, 0x9e, 0x88,
-	0xc3, 0xb6, 0x8d, 0x5d, 0x98, 0x91, 0x0a, 0x3f, 0x20, 0x17, 0xd2, 0xc3, 0xa6, 0x14, 0xaa, 0xc3,
-	0xac, 0x99, 0x14, 0x91, 0x93, 0xe9, 0xda, 0x9d, 0xbf, 0x0f, 0x4a, 0x93, 0x9e, 0x20, 0xfa, 0xda,
-	0x65, 0x76, 0x88, 0x7c, 0x7f, 0xbc, 0x37, 0x7f, 0x3a, 0x66, 0xad, 0x30, 0x0b, 0xe7, 0x77, 0x00,
-	0x67, 0xad, 0x38, 0xca, 0x59, 0x22, 0xd3, 0xce, 0xf7, 0x75, 0x78, 0xad, 0x7f, 0x16, 0xf4, 0x80,
-	0x27, 0x52, 0xda, 0xa1, 0x97, 0x7f, 0xb1, 0xbf, 0x90, 0xb3, 0xac, 0x56, 0x4d, 0x66, 0x53, 0x09,
-	0x7d, 0xdf, 0xf4, 0x0f, 0xb7, 0x8d, 0x23, 0x06, 0xb3, 0xc9, 0xe7, 0xcf, 0x65, 0x76, 0xb1, 0x45,
-	0x59, 0x19, 0xb6, 0xfb, 0x0b, 0x9c, 0x5f, 0x01, 0xbc, 0xf5, 0xef, 0x8d, 0xfc, 0x29, 0x55, 0xed,
-	0x3a, 0x09, 0xb9, 0xa4, 0xea, 0x92, 0x7a, 0x7a, 0x66, 0xa0, 0xa7, 0x75, 0xca, 0xae, 0x50, 0x1e,
-	0x5e, 0xf5, 0x0d, 0x70, 0x3e, 0x13, 0x25, 0xe2, 0xe5, 0xca, 0xed, 0x98, 0xfb, 0xf9, 0x7d, 0x59,
-	0xbb, 0xf7, 0xf4, 0xb0, 0x08, 0x9e, 0x1d, 0x16, 0xc1, 0xf3, 0xc3, 0x22, 0xf8, 0xf3, 0xb0, 0x08,
-	0xbe, 0x3d, 0x2a, 0xa6, 0x9e, 0x1f, 0x15, 0x53, 0xbf, 0x1d, 0x15, 0x53, 0x9f, 0x2f, 0x9d, 0xeb,
-	0xdd, 0xa9, 0xcf, 0x9c, 0xc8, 0xca, 0x66, 0x36, 0xfa, 0x4c, 0x7e, 0xe7, 0x9f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x27, 0xf2, 0xa7, 0x33, 0xd9, 0x0b, 0x00, 0x00,
+	// 1359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4d, 0x6f, 0xdc, 0x44,
+	0x18, 0x5e, 0x37, 0xdf, 0x93, 0xaf, 0x66, 0xb2, 0x49, 0xdd, 0xb4, 0xda, 0x5d, 0x19, 0xb5, 0x6c,
+	0x4b, 0xb3, 0x21, 0xad, 0x40, 0x28, 0x02, 0xa4, 0x6c, 0xb6, 0x55, 0xe1, 0xd2, 0xc8, 0x85, 0x82,
+	0xb8, 0x58, 0xb3, 0xf6, 0x64, 0x77, 0x5a, 0xdb, 0x63, 0x66, 0xc6, 0x9b, 0x04, 0x89, 0x03, 0xb7,
+	0xd2, 0x03, 0x70, 0x42, 0x15, 0xa7, 0x0a, 0x2e, 0x15, 0xa7, 0x1e, 0xfa, 0x23, 0x2a, 0x4e, 0x55,
+	0x0f, 0x80, 0x2a, 0x54, 0x50, 0x7a, 0x08, 0xe2, 0xc6, 0x3f, 0x40, 0xe3, 0x19, 0x7b, 0x9d, 0x34,
+	0x84, 0xaa, 0xec, 0xc2, 0x25, 0xc9, 0xbc, 0xaf, 0xe7, 0x79, 0x9e, 0xf7, 0x63, 0x5e, 0x8f, 0x03,
+	0x6a, 0x2e, 0xe5, 0x01, 0xe5, 0x4b, 0x1e, 0xe1, 0x82, 0x91, 0x66, 0x2c, 0x08, 0x0d, 0x97, 0x3a,
+	0xcb, 0x4d, 0x2c, 0xd0, 0xf2, 0x1e, 0x63, 0x2d, 0x62, 0x54, 0x50, 0x78, 0x42, 0x3d, 0x5f, 0xdb,
+	0xe3, 0xd2, 0xcf, 0x2f, 0x14, 0x5b, 0xb4, 0x45, 0x93, 0xe7, 0x96, 0xe4, 0x5f, 0x6a, 0xcb, 0x42,
+	0x49, 0x53, 0x34, 0x11, 0xc7, 0x19, 0xb4, 0x4b, 0x89, 0x86, 0x5c, 0x38, 0xae, 0xfc, 0x8e, 0xda,
+	0xa8, 0xf1, 0x95, 0x6b, 0x06, 0x05, 0x24, 0xa4, 0x4b, 0xc9, 0x4f, 0x65, 0xb2, 0xbe, 0x1e, 0x03,
+	0xc3, 0xeb, 0x88, 0xa1, 0x80, 0x43, 0x04, 0x26, 0x5d, 0x1a, 0x04, 0x71, 0x48, 0xc4, 0xb6, 0x23,
+	0xd0, 0x96, 0x69, 0x54, 0x8c, 0xea, 0x58, 0xfd, 0xcd, 0x07, 0x4f, 0xca, 0x85, 0xc7, 0x4f, 0xca,
+	0xa7, 0x5b, 0x44, 0xb4, 0xe3, 0x66, 0xcd, 0xa5, 0x81, 0x46, 0xd5, 0xbf, 0x16, 0xb9, 0x77, 0x63,
+	0x49, 0x6c, 0x47, 0x98, 0xd7, 0x1a, 0xd8, 0x7d, 0x74, 0x7f, 0x11, 0x68, 0xd2, 0x06, 0x76, 0xed,
+	0x89, 0x0c, 0xf2, 0x3d, 0xb4, 0x05, 0x23, 0x50, 0x94, 0xb2, 0xa5, 0xb6, 0x88, 0x72, 0xcc, 0x1c,
+	0x86, 0x37, 0x11, 0xf3, 0xcc, 0x23, 0x09, 0xd3, 0xdb, 0xff, 0x86, 0xc9, 0x34, 0x6c, 0x28, 0xb1,
+	0xd7, 0x35, 0xb4, 0x9d, 0x20, 0x43, 0x06, 0xe6, 0x9a, 0x34, 0x8c, 0xf9, 0x33, 0x94, 0x03, 0x3d,
+	0xa1, 0x9c, 0x4d, 0xc0, 0xf7, 0x71, 0x9e, 0x07, 0x73, 0x9b, 0x44, 0xb4, 0x3d, 0x86, 0x36, 0x1d,
+	0xe4, 0x79, 0xcc, 0xc1, 0x21, 0x6a, 0xfa, 0xd8, 0x33, 0x07, 0x2b, 0x46, 0x75, 0xd4, 0x9e, 0x4d,
+	0x9d, 0xab, 0x9e, 0xc7, 0x2e, 0x2a, 0x17, 0xac, 0x81, 0xe9, 0x66, 0xcc, 0x42, 0xa7, 0x83, 0x7c,
+	0xe2, 0x21, 0x41, 0x19, 0x37, 0x87, 0x2a, 0x03, 0xd5, 0xb1, 0xfa, 0xd0, 0xdd, 0xdd, 0x7b, 0x67,
+	0x0d, 0x7b, 0x4a, 0x7a, 0xaf, 0x65, 0x4e, 0xf8, 0x3e, 0x98, 0xec, 0x50, 0x91, 0x85, 0xc3, 0xcd,
+	0xe1, 0x8a, 0x51, 0x1d, 0x3f, 0x7f, 0xa6, 0x76, 0x48, 0x43, 0xd5, 0xae, 0x51, 0x91, 0x8a, 0xe4,
+	0x29, 0xf0, 0x44, 0x27, 0x67, 0x84, 0x1b, 0x60, 0x2e, 0x42, 0x4c, 0x10, 0x97, 0x44, 0x48, 0x6e,
+	0x75, 0x22, 0x1c, 0x22, 0x5f, 0x6c, 0x9b, 0x23, 0x09, 0xfc, 0xf2, 0xa1, 0xf0, 0xeb, 0xf9, 0x9d,
+	0xeb, 0x6a, 0xa3, 0x5d, 0x8c, 0x0e, 0xb0, 0xc2, 0xcf, 0x0c, 0x30, 0x1b, 0xa0, 0x2d, 0x07, 0xb9,
+	0x2e, 0x8b, 0xb1, 0x97, 0x45, 0x31, 0x5a, 0x19, 0xa8, 0x8e, 0x9f, 0x3f, 0x99, 0xd2, 0xc8, 0x82,
+	0x66, 0xf0, 0x0d, 0xec, 0xae, 0x51, 0x12, 0xd6, 0x2f, 0xc8, 0x9a, 0x7d, 0xff, 0x6b, 0xf9, 0x95,
+	0xe7, 0xab, 0x99, 0xdc, 0xc3, 0xed, 0x99, 0x00, 0x6d, 0xad, 0x2a, 0xb2, 0x34, 0x56, 0x1f, 0xcc,
+	0x06, 0x24, 0x97, 0x71, 0x87, 0xb7, 0x11, 0xc3, 0xe6, 0x58, 0xd6, 0xf5, 0xc6, 0x0b, 0x77, 0xfd,
+	0x4c, 0x40, 0xba, 0xc5, 0xba, 0x2a, 0x61, 0xe1, 0x75, 0x00, 0x37, 0x30, 0x76, 0x3c, 0x86, 0x48,
+	0xe8, 0x6c, 0x30, 0xe4, 0xca, 0x74, 0x98, 0xa0, 0x07, 0x64, 0x47, 0x37, 0x30, 0x6e, 0x48, 0xd8,
+	0x4b, 0x1a, 0x15, 0x3a, 0x60, 0x42, 0x30, 0x8c, 0x78, 0xcc, 0xd4, 0x41, 0x1e, 0xef, 0x01, 0xcb,
+	0x78, 0x8a, 0x28, 0xcf, 0xf1, 0xeb, 0xe0, 0x98, 0x6c, 0xe2, 0x36, 0xf5, 0x3d, 0xe7, 0x3a, 0x22,
+	0x7e, 0xae, 0x82, 0x13, 0x49, 0x8f, 0xcf, 0xa5, 0xee, 0x77, 0x13, 0xaf, 0x4e, 0xf9, 0xca, 0x99,
+	0xdb, 0x77, 0xca, 0x85, 0x5b, 0xbb, 0xf7, 0xce, 0x56, 0x72, 0x8c, 0x5b, 0x7b, 0xa7, 0xa5, 0x9a,
+	0x46, 0xd6, 0xe7, 0x06, 0x98, 0xc8, 0xf7, 0x2b, 0xb4, 0xc1, 0x10, 0x93, 0x3d, 0xd4, 0x93, 0xb1,
+	0xa4, 0xa0, 0xe0, 0x29, 0x30, 0xc5, 0xb1, 0x10, 0x3e, 0x76, 0xda, 0x98, 0xb4, 0xda, 0x82, 0x27,
+	0x93, 0x68, 0xc0, 0x9e, 0x54, 0xd6, 0xcb, 0xca, 0x68, 0xfd, 0x69, 0x80, 0xe2, 0x41, 0xcd, 0x0d,
+	0xe7, 0xc1, 0xf0, 0x26, 0x09, 0x3d, 0xba, 0x99, 0x88, 0x1a, 0xb4, 0xf5, 0x0a, 0x12, 0x20, 0x3b,
+	0xc0, 0xd9, 0xd3, 0xfa, 0xe6, 0x91, 0x1e, 0xe8, 0x3e, 0x1a, 0x90, 0x70, 0x8f, 0x12, 0x78, 0x0d,
+	0x8c, 0xa4, 0x67, 0x74, 0xa0, 0x07, 0x04, 0x29, 0x98, 0xf5, 0x16, 0x98, 0xcf, 0x3a, 0x78, 0x2f,
+	0xe3, 0x4b, 0x60, 0x92, 0x93, 0x56, 0x88, 0x3d, 0xa7, 0xe9, 0x53, 0xf7, 0x06, 0x37, 0x8d, 0xca,
+	0x40, 0x75, 0xd4, 0x9e, 0x50, 0xc6, 0x7a, 0x62, 0xb3, 0x7e, 0x34, 0xc0, 0x42, 0xb6, 0xff, 0x32,
+	0xe1, 0x82, 0x32, 0xe2, 0x22, 0x3f, 0x2d, 0xe6, 0x17, 0x06, 0x38, 0xe6, 0xc6, 0x41, 0xec, 0x23,
+	0x41, 0x3a, 0x58, 0x37, 0x8f, 0x93, 0xd6, 0xf7, 0x9f, 0x67, 0xc0, 0x1b, 0x2f, 0x30, 0x03, 0xd4,
+	0xbc, 0x9b, 0xeb, 0xd2, 0x2a, 0x31, 0x76, 0xd2, 0x09, 0x2f, 0x83, 0x69, 0x86, 0x37, 0x30, 0xc3,
+	0xa1, 0x8b, 0x1d, 0x97, 0xc6, 0xa1, 0x48, 0xea, 0x35, 0x69, 0x4f, 0x65, 0xe6, 0x35, 0x69, 0xb5,
+	0xbe, 0x33, 0xc0, 0xb1, 0x2c, 0xb0, 0xb5, 0x98, 0x31, 0x1c, 0x8a, 0x34, 0xaa, 0x08, 0x8c, 0xa4,
+	0xc7, 0xa0, 0xbf, 0x41, 0xa4, 0x34, 0xb2, 0x01, 0x23, 0xcc, 0x08, 0x55, 0xaf, 0xd0, 0x41, 0x5b,
+	0xaf, 0xac, 0xdb, 0x06, 0x28, 0x65, 0x2a, 0x57, 0x5d, 0x1d, 0x33, 0xf6, 0xd6, 0x68, 0x10, 0x10,
+	0xce, 0x65, 0x19, 0x3b, 0x00, 0xb8, 0xd9, 0xaa, 0xcf, 0x7a, 0x73, 0x4c, 0xd6, 0x97, 0x06, 0x38,
+	0x91, 0x49, 0xbb, 0x12, 0x0b, 0x2e, 0x50, 0xe8, 0x91, 0xb0, 0xf5, 0xbf, 0x25, 0xd1, 0xfa, 0xc6,
+	0x00, 0xb3, 0xdd, 0x69, 0xed, 0x23, 0xde, 0xbe, 0xd8, 0xc1, 0xa1, 0x80, 0x67, 0xc0, 0xd1, 0xee,
+	0xcb, 0x41, 0xa7, 0x59, 0x9d, 0xf3, 0xe9, 0xcc, 0xbe, 0x9e, 0x98, 0xe1, 0x87, 0x60, 0x34, 0x9b,
+	0xe9, 0xbd, 0x38, 0xe7, 0x19, 0x9a, 0x4c, 0x57, 0xf1, 0x00, 0x71, 0x1c, 0x7e, 0x0c, 0xe6, 0xbb,
+	0xea, 0xb8, 0x74, 0x38, 0x38, 0xf1, 0xe8, 0xb4, 0xbd, 0x7a, 0xf8, 0x55, 0xe0, 0x59, 0xc8, 0xfa,
+	0x98, 0x94, 0xac, 0x72, 0x53, 0xec, 0x1c, 0x40, 0xb9, 0x32, 0x28, 0xc7, 0xb7, 0x75, 0xd3, 0x00,
+	0x23, 0x97, 0x30, 0x5e, 0xa7, 0xd4, 0x87, 0x9f, 0x82, 0xa9, 0xee, 0x9d, 0x31, 0xa2, 0xd4, 0xef,
+	0x73, 0xcd, 0xba, 0x37, 0x54, 0x49, 0x6f, 0x6d, 0x83, 0xc9, 0xf4, 0x3a, 0x13, 0xb3, 0x10, 0x7b,
+	0xb0, 0x0d, 0x86, 0x51, 0x90, 0x9c, 0x5e, 0xa5, 0xe3, 0xf8, 0x81, 0x3a, 0x12, 0x11, 0xaf, 0x69,
+	0x11, 0xd5, 0xe7, 0x10, 0x91, 0x53, 0xa0, 0xf1, 0xad, 0x4f, 0xc0, 0xa8, 0xe4, 0x6c, 0xc4, 0x5c,
+	0xc0, 0x70, 0x1f, 0x6b, 0xbf, 0xa2, 0xcf, 0x71, 0x5f, 0xc2, 0x78, 0x0d, 0x31, 0xb6, 0xfd, 0x9f,
+	0x73, 0xdf, 0x3a, 0x02, 0x16, 0xd6, 0xf2, 0x45, 0xb8, 0x1a, 0xe1, 0xd0, 0x53, 0x37, 0x60, 0xe4,
+	0xc3, 0x22, 0x18, 0x12, 0x44, 0xf8, 0x58, 0xbd, 0xa5, 0x6d, 0xb5, 0x80, 0x15, 0x30, 0xee, 0x61,
+	0xee, 0x32, 0x12, 0x75, 0x4f, 0x88, 0x9d, 0x37, 0xc1, 0x93, 0x60, 0x8c, 0x61, 0x97, 0x44, 0x04,
+	0x87, 0x42, 0xbd, 0xc8, 0xec, 0xae, 0x21, 0x57, 0xd6, 0xc1, 0xfe, 0x96, 0x75, 0xe5, 0xdc, 0xcd,
+	0x3b, 0xe5, 0x82, 0x6c, 0xf3, 0xdf, 0xef, 0x94, 0x0b, 0x3f, 0xdc, 0x5f, 0x5c, 0xd0, 0x44, 0x2d,
+	0xda, 0xc9, 0xf1, 0x84, 0x42, 0xca, 0x34, 0xac, 0xc7, 0x06, 0x98, 0x6b, 0x60, 0x1f, 0xb7, 0x92,
+	0x93, 0x22, 0xe4, 0x6b, 0x32, 0x6c, 0xbd, 0x13, 0x6e, 0x24, 0xef, 0x93, 0x88, 0xe1, 0x0e, 0xa1,
+	0xf2, 0xd3, 0x23, 0x3f, 0x3a, 0xa6, 0x52, 0xb3, 0x9e, 0x1c, 0x36, 0x18, 0xe2, 0x02, 0xdd, 0xc0,
+	0x3d, 0x19, 0x1b, 0x0a, 0x0a, 0x36, 0xc0, 0xb0, 0xba, 0xcf, 0x24, 0x99, 0x1c, 0xac, 0x9f, 0xfb,
+	0xe3, 0x49, 0x79, 0xda, 0x65, 0x58, 0x5d, 0xe9, 0x95, 0xeb, 0xdb, 0xdd, 0x7b, 0x67, 0xf7, 0xdb,
+	0x74, 0x2a, 0xd4, 0xc2, 0xfa, 0xc5, 0x00, 0xc7, 0x75, 0x70, 0x84, 0x86, 0x59, 0x98, 0xfa, 0x23,
+	0xe7, 0x22, 0x98, 0xe9, 0x8e, 0x1f, 0xf9, 0x95, 0x83, 0x39, 0xd7, 0x57, 0x33, 0xf3, 0xd1, 0xfd,
+	0xc5, 0xa2, 0x56, 0xb5, 0xaa, 0x3c, 0x57, 0x05, 0x93, 0x23, 0xbe, 0x3b, 0x4f, 0xb5, 0x5d, 0xb6,
+	0x6f, 0xf6, 0x0d, 0xd8, 0xd7, 0xf6, 0x55, 0x2c, 0x2b, 0xa3, 0xba, 0xbe, 0x86, 0xf5, 0x93, 0x01,
+	0x4e, 0xfd, 0x7d, 0x23, 0x7f, 0x40, 0x44, 0xbb, 0x81, 0x23, 0xca, 0x89, 0xe8, 0x53, 0x4f, 0xcf,
+	0xe7, 0x7a, 0x5a, 0xba, 0xf4, 0x0a, 0x9a, 0x60, 0xc4, 0x53, 0xc4, 0xe6, 0x50, 0xe2, 0x48, 0x97,
+	0x2b, 0xa7, 0x53, 0xed, 0x87, 0xf7, 0x65, 0xfd, 0xca, 0xdd, 0x9d, 0x92, 0xf1, 0x60, 0xa7, 0x64,
+	0x3c, 0xdc, 0x29, 0x19, 0xbf, 0xed, 0x94, 0x8c, 0xaf, 0x9e, 0x96, 0x0a, 0x0f, 0x9f, 0x96, 0x0a,
+	0x3f, 0x3f, 0x2d, 0x15, 0x3e, 0x5a, 0x3e, 0x34, 0x77, 0xfb, 0x2e, 0xe3, 0x49, 0x2a, 0x9b, 0xc3,
+	0xc9, 0xff, 0x0a, 0x2e, 0xfc, 0x35, 0x00, 0x6f, 0x1d, 0x1f, 0x1e, 0xde, 0x10, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -817,6 +1119,41 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.VoterRewards.Equal(that1.VoterRewards) {
 		return false
 	}
+	if !this.ParticipationPenalty.Equal(that1.ParticipationPenalty) {
+		return false
+	}
+	if len(this.MaxAccruedRewards) != len(that1.MaxAccruedRewards) {
+		return false
+	}
+	for i := range this.MaxAccruedRewards {
+		if !this.MaxAccruedRewards[i].Equal(&that1.MaxAccruedRewards[i]) {
+			return false
+		}
+	}
+	if that1.MinValidatorShare == nil {
+		if this.MinValidatorShare != nil {
+			return false
+		}
+	} else if !this.MinValidatorShare.Equal(*that1.MinValidatorShare) {
+		return false
+	}
+	if that1.FeeDrainFraction == nil {
+		if this.FeeDrainFraction != nil {
+			return false
+		}
+	} else if !this.FeeDrainFraction.Equal(*that1.FeeDrainFraction) {
+		return false
+	}
+	if that1.TreasuryTax == nil {
+		if this.TreasuryTax != nil {
+			return false
+		}
+	} else if !this.TreasuryTax.Equal(*that1.TreasuryTax) {
+		return false
+	}
+	if this.WithholdJailedRewards != that1.WithholdJailedRewards {
+		return false
+	}
 	return true
 }
 func (this *VoterRewards) Equal(that interface{}) bool {
@@ -846,6 +1183,65 @@ func (this *VoterRewards) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ParticipationPenalty) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ParticipationPenalty)
+	if !ok {
+		that2, ok := that.(ParticipationPenalty)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Window != that1.Window {
+		return false
+	}
+	if !this.MinParticipation.Equal(that1.MinParticipation) {
+		return false
+	}
+	if !this.Penalty.Equal(that1.Penalty) {
+		return false
+	}
+	return true
+}
+func (this *ValidatorParticipation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValidatorParticipation)
+	if !ok {
+		that2, ok := that.(ValidatorParticipation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.SignedBlocks) != len(that1.SignedBlocks) {
+		return false
+	}
+	for i := range this.SignedBlocks {
+		if this.SignedBlocks[i] != that1.SignedBlocks[i] {
+			return false
+		}
+	}
+	return true
+}
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -1053,14 +1449,14 @@ func (this *FeePool) Equal(that interface{}) bool {
 	}
 	return true
 }
-func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
+func (this *RewardsBurned) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DelegatorStartingInfo)
+	that1, ok := that.(*RewardsBurned)
 	if !ok {
-		that2, ok := that.(DelegatorStartingInfo)
+		that2, ok := that.(RewardsBurned)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1072,25 +1468,24 @@ func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.PreviousPeriod != that1.PreviousPeriod {
-		return false
-	}
-	if !this.Stake.Equal(that1.Stake) {
+	if len(this.Amount) != len(that1.Amount) {
 		return false
 	}
-	if this.Height != that1.Height {
-		return false
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
 	}
 	return true
 }
-func (this *DelegationDelegatorReward) Equal(that interface{}) bool {
+func (this *BurnDust) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*DelegationDelegatorReward)
+	that1, ok := that.(*BurnDust)
 	if !ok {
-		that2, ok := that.(DelegationDelegatorReward)
+		that2, ok := that.(BurnDust)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1102,27 +1497,115 @@ func (this *DelegationDelegatorReward) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.ValidatorAddress != that1.ValidatorAddress {
-		return false
-	}
-	if len(this.Reward) != len(that1.Reward) {
+	if len(this.Amount) != len(that1.Amount) {
 		return false
 	}
-	for i := range this.Reward {
-		if !this.Reward[i].Equal(&that1.Reward[i]) {
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
 			return false
 		}
 	}
 	return true
 }
-func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool {
+func (this *FeeCarry) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*CommunityPoolSpendProposalWithDeposit)
+	that1, ok := that.(*FeeCarry)
 	if !ok {
-		that2, ok := that.(CommunityPoolSpendProposalWithDeposit)
+		that2, ok := that.(FeeCarry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DelegatorStartingInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegatorStartingInfo)
+	if !ok {
+		that2, ok := that.(DelegatorStartingInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PreviousPeriod != that1.PreviousPeriod {
+		return false
+	}
+	if !this.Stake.Equal(that1.Stake) {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+func (this *DelegationDelegatorReward) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegationDelegatorReward)
+	if !ok {
+		that2, ok := that.(DelegationDelegatorReward)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if len(this.Reward) != len(that1.Reward) {
+		return false
+	}
+	for i := range this.Reward {
+		if !this.Reward[i].Equal(&that1.Reward[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *CommunityPoolSpendProposalWithDeposit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommunityPoolSpendProposalWithDeposit)
+	if !ok {
+		that2, ok := that.(CommunityPoolSpendProposalWithDeposit)
 		if ok {
 			that1 = &that2
 		} else {
@@ -1171,6 +1654,78 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WithholdJailedRewards {
+		i--
+		if m.WithholdJailedRewards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.TreasuryTax != nil {
+		{
+			size := m.TreasuryTax.Size()
+			i -= size
+			if _, err := m.TreasuryTax.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintDistribution(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.FeeDrainFraction != nil {
+		{
+			size := m.FeeDrainFraction.Size()
+			i -= size
+			if _, err := m.FeeDrainFraction.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintDistribution(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.MinValidatorShare != nil {
+		{
+			size := m.MinValidatorShare.Size()
+			i -= size
+			if _, err := m.MinValidatorShare.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintDistribution(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.MaxAccruedRewards) > 0 {
+		for iNdEx := len(m.MaxAccruedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxAccruedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.ParticipationPenalty != nil {
+		{
+			size, err := m.ParticipationPenalty.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDistribution(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.VoterRewards != nil {
 		{
 			size, err := m.VoterRewards.MarshalToSizedBuffer(dAtA[:i])
@@ -1273,6 +1828,90 @@ func (m *VoterRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ParticipationPenalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipationPenalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipationPenalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Penalty.Size()
+		i -= size
+		if _, err := m.Penalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinParticipation.Size()
+		i -= size
+		if _, err := m.MinParticipation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Window != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorParticipation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorParticipation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorParticipation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignedBlocks) > 0 {
+		for iNdEx := len(m.SignedBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.SignedBlocks[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.SignedBlocks)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ValidatorHistoricalRewards) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1543,7 +2182,7 @@ func (m *FeePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *CommunityPoolSpendProposal) Marshal() (dAtA []byte, err error) {
+func (m *RewardsBurned) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1553,12 +2192,12 @@ func (m *CommunityPoolSpendProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CommunityPoolSpendProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *RewardsBurned) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CommunityPoolSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RewardsBurned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1574,34 +2213,13 @@ func (m *CommunityPoolSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, err
 				i = encodeVarintDistribution(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
+func (m *BurnDust) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1611,30 +2229,162 @@ func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegatorStartingInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *BurnDust) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegatorStartingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BurnDust) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size := m.Stake.Size()
-		i -= size
-		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i = encodeVarintDistribution(dAtA, i, uint64(size))
 	}
-	i--
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeCarry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeCarry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeCarry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolSpendProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolSpendProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolSpendProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDistribution(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorStartingInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorStartingInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorStartingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Stake.Size()
+		i -= size
+		if _, err := m.Stake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDistribution(dAtA, i, uint64(size))
+	}
+	i--
 	dAtA[i] = 0x12
 	if m.PreviousPeriod != 0 {
 		i = encodeVarintDistribution(dAtA, i, uint64(m.PreviousPeriod))
@@ -1782,6 +2532,31 @@ func (m *Params) Size() (n int) {
 		l = m.VoterRewards.Size()
 		n += 1 + l + sovDistribution(uint64(l))
 	}
+	if m.ParticipationPenalty != nil {
+		l = m.ParticipationPenalty.Size()
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if len(m.MaxAccruedRewards) > 0 {
+		for _, e := range m.MaxAccruedRewards {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	if m.MinValidatorShare != nil {
+		l = m.MinValidatorShare.Size()
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.FeeDrainFraction != nil {
+		l = m.FeeDrainFraction.Size()
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.TreasuryTax != nil {
+		l = m.TreasuryTax.Size()
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.WithholdJailedRewards {
+		n += 2
+	}
 	return n
 }
 
@@ -1799,6 +2574,34 @@ func (m *VoterRewards) Size() (n int) {
 	return n
 }
 
+func (m *ParticipationPenalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovDistribution(uint64(m.Window))
+	}
+	l = m.MinParticipation.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	l = m.Penalty.Size()
+	n += 1 + l + sovDistribution(uint64(l))
+	return n
+}
+
+func (m *ValidatorParticipation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SignedBlocks) > 0 {
+		n += 1 + sovDistribution(uint64(len(m.SignedBlocks))) + len(m.SignedBlocks)*1
+	}
+	return n
+}
+
 func (m *ValidatorHistoricalRewards) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1909,6 +2712,51 @@ func (m *FeePool) Size() (n int) {
 	return n
 }
 
+func (m *RewardsBurned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BurnDust) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeCarry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovDistribution(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *CommunityPoolSpendProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2219,13 +3067,674 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VoterRewards == nil {
-				m.VoterRewards = &VoterRewards{}
-			}
-			if err := m.VoterRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.VoterRewards == nil {
+				m.VoterRewards = &VoterRewards{}
+			}
+			if err := m.VoterRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationPenalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParticipationPenalty == nil {
+				m.ParticipationPenalty = &ParticipationPenalty{}
+			}
+			if err := m.ParticipationPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAccruedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAccruedRewards = append(m.MaxAccruedRewards, types.DecCoin{})
+			if err := m.MaxAccruedRewards[len(m.MaxAccruedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValidatorShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MinValidatorShare = &v
+			if err := m.MinValidatorShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDrainFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.FeeDrainFraction = &v
+			if err := m.FeeDrainFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryTax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.TreasuryTax = &v
+			if err := m.TreasuryTax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithholdJailedRewards", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithholdJailedRewards = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoterRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoterRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoterRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettleHeights", wireType)
+			}
+			m.SettleHeights = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettleHeights |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParticipationPenalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipationPenalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipationPenalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinParticipation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinParticipation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Penalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorParticipation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorParticipation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDistribution
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SignedBlocks = append(m.SignedBlocks, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDistribution
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthDistribution
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthDistribution
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.SignedBlocks) == 0 {
+					m.SignedBlocks = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDistribution
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SignedBlocks = append(m.SignedBlocks, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocks", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorHistoricalRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorHistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatio", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CumulativeRewardRatio = append(m.CumulativeRewardRatio, types.DecCoin{})
+			if err := m.CumulativeRewardRatio[len(m.CumulativeRewardRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
+			}
+			m.ReferenceCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReferenceCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2247,7 +3756,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VoterRewards) Unmarshal(dAtA []byte) error {
+func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2270,17 +3779,17 @@ func (m *VoterRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VoterRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorCurrentRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VoterRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorCurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2290,31 +3799,31 @@ func (m *VoterRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettleHeights", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
-			m.SettleHeights = 0
+			m.Period = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2324,7 +3833,7 @@ func (m *VoterRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SettleHeights |= int64(b&0x7F) << shift
+				m.Period |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2350,7 +3859,7 @@ func (m *VoterRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
+func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2373,15 +3882,15 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorHistoricalRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorAccumulatedCommission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorHistoricalRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorAccumulatedCommission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRewardRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2408,30 +3917,11 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CumulativeRewardRatio = append(m.CumulativeRewardRatio, types.DecCoin{})
-			if err := m.CumulativeRewardRatio[len(m.CumulativeRewardRatio)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commission = append(m.Commission, types.DecCoin{})
+			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceCount", wireType)
-			}
-			m.ReferenceCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDistribution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ReferenceCount |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2453,7 +3943,7 @@ func (m *ValidatorHistoricalRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
+func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2476,10 +3966,10 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorCurrentRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorOutstandingRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorCurrentRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorOutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2516,11 +4006,61 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDistribution(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDistribution
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSlashEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPeriod", wireType)
 			}
-			m.Period = 0
+			m.ValidatorPeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2530,11 +4070,45 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Period |= uint64(b&0x7F) << shift
+				m.ValidatorPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -2556,7 +4130,7 @@ func (m *ValidatorCurrentRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
+func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2579,15 +4153,15 @@ func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorAccumulatedCommission: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorSlashEvents: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorAccumulatedCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorSlashEvents: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2614,8 +4188,8 @@ func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commission = append(m.Commission, types.DecCoin{})
-			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ValidatorSlashEvents = append(m.ValidatorSlashEvents, ValidatorSlashEvent{})
+			if err := m.ValidatorSlashEvents[len(m.ValidatorSlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2640,7 +4214,7 @@ func (m *ValidatorAccumulatedCommission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
+func (m *FeePool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2663,15 +4237,15 @@ func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorOutstandingRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeePool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorOutstandingRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeePool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2698,8 +4272,8 @@ func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types.DecCoin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CommunityPool = append(m.CommunityPool, types.DecCoin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2724,7 +4298,7 @@ func (m *ValidatorOutstandingRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
+func (m *RewardsBurned) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2747,36 +4321,17 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSlashEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: RewardsBurned: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSlashEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RewardsBurned: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPeriod", wireType)
-			}
-			m.ValidatorPeriod = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDistribution
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidatorPeriod |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDistribution
@@ -2786,23 +4341,23 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDistribution
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDistribution
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2827,7 +4382,7 @@ func (m *ValidatorSlashEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
+func (m *BurnDust) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2850,15 +4405,15 @@ func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSlashEvents: wiretype end group for non-group")
+			return fmt.Errorf("proto: BurnDust: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSlashEvents: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BurnDust: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSlashEvents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2885,8 +4440,8 @@ func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSlashEvents = append(m.ValidatorSlashEvents, ValidatorSlashEvent{})
-			if err := m.ValidatorSlashEvents[len(m.ValidatorSlashEvents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.DecCoin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2911,7 +4466,7 @@ func (m *ValidatorSlashEvents) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeePool) Unmarshal(dAtA []byte) error {
+func (m *FeeCarry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2934,15 +4489,15 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeCarry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeCarry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2969,8 +4524,8 @@ func (m *FeePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CommunityPool = append(m.CommunityPool, types.DecCoin{})
-			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.DecCoin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
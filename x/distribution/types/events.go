@@ -2,14 +2,39 @@ package types
 
 // distribution module event types
 const (
-	EventTypeSetWithdrawAddress = "set_withdraw_address"
-	EventTypeRewards            = "rewards"
-	EventTypeCommission         = "commission"
-	EventTypeWithdrawRewards    = "withdraw_rewards"
-	EventTypeWithdrawCommission = "withdraw_commission"
-	EventTypeProposerReward     = "proposer_reward"
+	EventTypeSetWithdrawAddress  = "set_withdraw_address"
+	EventTypeRewards             = "rewards"
+	EventTypeCommission          = "commission"
+	EventTypeWithdrawRewards     = "withdraw_rewards"
+	EventTypeWithdrawCommission  = "withdraw_commission"
+	EventTypeProposerReward      = "proposer_reward"
+	EventTypeCommunityPoolFunded = "community_pool_funded"
+	EventTypeFeeSplit            = "fee_split"
+	EventTypeBurnRewards         = "burn_rewards"
+	EventTypeAllocationSummary   = "allocation_summary"
+	EventTypeTreasuryCut         = "treasury_cut"
+	EventTypeClawbackRewards     = "clawback_rewards"
 
 	AttributeKeyWithdrawAddress = "withdraw_address"
 	AttributeKeyValidator       = "validator"
 	AttributeKeyDelegator       = "delegator"
+	AttributeKeyMinerAmount     = "miner_amount"
+	AttributeKeyVoterAmount     = "voter_amount"
+	AttributeKeyCommunityPool   = "community_pool"
+	AttributeKeyValidators      = "validators"
+	AttributeKeyBurned          = "burned"
+	AttributeKeyOverflow        = "overflow"
+	AttributeKeyTreasury        = "treasury"
+)
+
+// EventVerbosity defines the events emitted by the reward allocation.
+type EventVerbosity int32
+
+const (
+	// EventVerbosityFull emits the fee split and an event per rewarded validator.
+	EventVerbosityFull EventVerbosity = iota
+	// EventVerbositySummary emits a single allocation summary event per block.
+	EventVerbositySummary
+	// EventVerbosityOff emits no allocation events.
+	EventVerbosityOff
 )
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -50,6 +52,9 @@ type StakingKeeper interface {
 	GetAllSDKDelegations(ctx sdk.Context) []stakingtypes.Delegation
 	GetAllValidators(ctx sdk.Context) (validators []stakingtypes.Validator)
 	GetAllDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress) []stakingtypes.Delegation
+
+	// PowerReduction returns the amount of tokens per unit of consensus power.
+	PowerReduction(ctx sdk.Context) math.Int
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -57,3 +62,9 @@ type StakingHooks interface {
 	AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) // Must be called when a validator is created
 	AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress)
 }
+
+// DistributionHooks event hooks for reward allocation, letting other modules
+// observe or augment the rewards of the validators (noalias)
+type DistributionHooks interface {
+	AfterValidatorRewardAllocated(ctx sdk.Context, valAddr sdk.ValAddress, reward sdk.DecCoins) error // Must be called after rewards are allocated to a validator
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ DistributionHooks = MultiDistributionHooks{}
+
+// combine multiple distribution hooks, all hook functions are run in array sequence
+type MultiDistributionHooks []DistributionHooks
+
+func NewMultiDistributionHooks(hooks ...DistributionHooks) MultiDistributionHooks {
+	return hooks
+}
+
+func (h MultiDistributionHooks) AfterValidatorRewardAllocated(ctx sdk.Context, valAddr sdk.ValAddress, reward sdk.DecCoins) error {
+	for i := range h {
+		if err := h[i].AfterValidatorRewardAllocated(ctx, valAddr, reward); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -41,6 +41,20 @@ const (
 // - 0x08<valAddrLen (1 Byte)><valAddr_Bytes><height>: ValidatorSlashEvent
 //
 // - 0x09: Params
+//
+// - 0x0a<valAddrLen (1 Byte)><valAddr_Bytes>: sdk.AccAddress
+//
+// - 0x0b: RewardsBurned
+//
+// - 0x0c: BurnDust
+//
+// - 0x0d: FeeCarry
+//
+// - 0x0e<consAddrLen (1 Byte)><consAddr_Bytes>: ValidatorParticipation
+//
+// - 0x0f<consAddrLen (1 Byte)><consAddr_Bytes>: uint64
+//
+// - 0x10: uint64
 var (
 	FeePoolKey                        = []byte{0x00} // key for global distribution state
 	ProposerKey                       = []byte{0x01} // key for the proposer operator address
@@ -54,6 +68,14 @@ var (
 	ValidatorSlashEventPrefix            = []byte{0x08} // key for validator slash fraction
 
 	ParamsKey = []byte{0x09} // key for distribution module params
+
+	ValidatorRewardWithdrawAddrPrefix = []byte{0x0a} // key for validator reward withdraw address
+	TotalRewardsBurnedKey             = []byte{0x0b} // key for the cumulative rewards burned
+	BurnDustKey                       = []byte{0x0c} // key for the burned rewards remainders
+	FeeCarryKey                       = []byte{0x0d} // key for the miner fees remainders
+	ValidatorParticipationPrefix      = []byte{0x0e} // key for validator vote participation
+	ValidatorMissedAllocationsPrefix  = []byte{0x0f} // key for validator missed allocations counter
+	DustRecipientIndexKey             = []byte{0x10} // key for the rotating index of the truncation dust recipient
 )
 
 // GetValidatorOutstandingRewardsAddress creates an address from a validator's outstanding rewards key.
@@ -163,6 +185,21 @@ func GetDelegatorWithdrawAddrKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegatorWithdrawAddrPrefix, address.MustLengthPrefix(delAddr.Bytes())...)
 }
 
+// GetValidatorRewardWithdrawAddrKey creates the key for a validator's reward withdraw addr.
+func GetValidatorRewardWithdrawAddrKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorRewardWithdrawAddrPrefix, address.MustLengthPrefix(valAddr.Bytes())...)
+}
+
+// GetValidatorParticipationKey creates the key for a validator's vote participation.
+func GetValidatorParticipationKey(consAddr sdk.ConsAddress) []byte {
+	return append(ValidatorParticipationPrefix, address.MustLengthPrefix(consAddr.Bytes())...)
+}
+
+// GetValidatorMissedAllocationsKey creates the key for a validator's missed allocations counter.
+func GetValidatorMissedAllocationsKey(consAddr sdk.ConsAddress) []byte {
+	return append(ValidatorMissedAllocationsPrefix, address.MustLengthPrefix(consAddr.Bytes())...)
+}
+
 // GetDelegatorStartingInfoKey creates the key for a delegator's starting info.
 func GetDelegatorStartingInfoKey(v sdk.ValAddress, d sdk.AccAddress) []byte {
 	return append(append(DelegatorStartingInfoPrefix, address.MustLengthPrefix(v.Bytes())...), address.MustLengthPrefix(d.Bytes())...)
@@ -36,9 +36,85 @@ func (p Params) ValidateBasic() error {
 		)
 	}
 
+	if pp := p.ParticipationPenalty; pp != nil && pp.Window > 0 {
+		if pp.MinParticipation.IsNil() || pp.MinParticipation.IsNegative() || pp.MinParticipation.GT(math.LegacyOneDec()) {
+			return fmt.Errorf(
+				"min participation should be non-negative and less than one: %s", pp.MinParticipation,
+			)
+		}
+		if pp.Penalty.IsNil() || pp.Penalty.IsNegative() || pp.Penalty.GT(math.LegacyOneDec()) {
+			return fmt.Errorf(
+				"participation penalty should be non-negative and less than one: %s", pp.Penalty,
+			)
+		}
+	}
+
+	if err := p.MaxAccruedRewards.Validate(); err != nil {
+		return fmt.Errorf("invalid max accrued rewards: %w", err)
+	}
+
+	if minShare := p.MinValidatorShare; minShare != nil && !minShare.IsNil() {
+		if minShare.IsNegative() || minShare.GT(math.LegacyOneDec()) {
+			return fmt.Errorf(
+				"min validator share should be non-negative and less than one: %s", minShare,
+			)
+		}
+		if share := p.ValidatorShare(); share.LT(*minShare) {
+			return fmt.Errorf(
+				"validator share %s left by community tax %s and voter rewards ratio is below the minimum %s",
+				share, p.CommunityTax, minShare,
+			)
+		}
+	}
+
+	if tax := p.TreasuryTax; tax != nil && !tax.IsNil() {
+		if tax.IsNegative() || tax.GT(math.LegacyOneDec()) {
+			return fmt.Errorf(
+				"treasury tax should be non-negative and less than one: %s", tax,
+			)
+		}
+	}
+
+	if fraction := p.FeeDrainFraction; fraction != nil && !fraction.IsNil() {
+		if !fraction.IsPositive() || fraction.GT(math.LegacyOneDec()) {
+			return fmt.Errorf(
+				"fee drain fraction should be positive and not greater than one: %s", fraction,
+			)
+		}
+	}
+
 	return nil
 }
 
+// ValidatorShare returns the share of the block rewards left to validators
+// once the community tax, the treasury tax and the voter rewards ratio are
+// applied.
+func (p Params) ValidatorShare() sdk.Dec {
+	share := math.LegacyOneDec().Sub(p.CommunityTax).Mul(math.LegacyOneDec().Sub(p.TreasuryTaxRate()))
+	if p.VoterRewards != nil && !p.VoterRewards.Ratio.IsNil() {
+		share = share.Mul(math.LegacyOneDec().Sub(p.VoterRewards.Ratio))
+	}
+	return share
+}
+
+// TreasuryTaxRate returns the share of the block rewards sent to the treasury
+// module, zero unless TreasuryTax is set.
+func (p Params) TreasuryTaxRate() sdk.Dec {
+	if p.TreasuryTax == nil || p.TreasuryTax.IsNil() {
+		return math.LegacyZeroDec()
+	}
+	return *p.TreasuryTax
+}
+
+// DrainFraction returns the fraction of the fee collector balance drained for
+// allocation each block, the whole balance unless FeeDrainFraction is set.
+func (p Params) DrainFraction() sdk.Dec {
+	if p.FeeDrainFraction == nil || p.FeeDrainFraction.IsNil() {
+		return math.LegacyOneDec()
+	}
+	return *p.FeeDrainFraction
+}
+
 func validateCommunityTax(i interface{}) error {
 	v, ok := i.(sdk.Dec)
 	if !ok {
@@ -43,6 +43,77 @@ func TestParams_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestParams_ValidateBasicMinValidatorShare(t *testing.T) {
+	toDec := sdk.MustNewDecFromStr
+
+	p := types.DefaultParams()
+	p.CommunityTax = toDec("0.5")
+	p.VoterRewards.Ratio = toDec("0.5")
+	require.True(t, p.ValidatorShare().Equal(toDec("0.25")))
+
+	// disabled by default
+	require.NoError(t, p.ValidateBasic())
+
+	minShare := toDec("0.25")
+	p.MinValidatorShare = &minShare
+	require.NoError(t, p.ValidateBasic())
+
+	minShare = toDec("0.3")
+	p.MinValidatorShare = &minShare
+	require.ErrorContains(t, p.ValidateBasic(), "below the minimum")
+
+	minShare = toDec("1.1")
+	p.MinValidatorShare = &minShare
+	require.Error(t, p.ValidateBasic())
+}
+
+func TestParams_ValidateBasicFeeDrainFraction(t *testing.T) {
+	toDec := sdk.MustNewDecFromStr
+
+	p := types.DefaultParams()
+	require.True(t, p.DrainFraction().Equal(sdk.OneDec()))
+
+	for _, tc := range []struct {
+		fraction string
+		expErr   bool
+	}{
+		{"0.5", false},
+		{"1", false},
+		{"0", true},
+		{"-0.1", true},
+		{"1.1", true},
+	} {
+		fraction := toDec(tc.fraction)
+		p.FeeDrainFraction = &fraction
+		if tc.expErr {
+			require.Error(t, p.ValidateBasic(), tc.fraction)
+			continue
+		}
+		require.NoError(t, p.ValidateBasic(), tc.fraction)
+		require.True(t, p.DrainFraction().Equal(fraction))
+	}
+}
+
+func TestParams_ValidateBasicTreasuryTax(t *testing.T) {
+	toDec := sdk.MustNewDecFromStr
+
+	p := types.DefaultParams()
+	p.CommunityTax = toDec("0.5")
+	p.VoterRewards.Ratio = toDec("0.5")
+	require.True(t, p.TreasuryTaxRate().IsZero())
+
+	tax := toDec("0.2")
+	p.TreasuryTax = &tax
+	require.NoError(t, p.ValidateBasic())
+	require.True(t, p.ValidatorShare().Equal(toDec("0.2")))
+
+	tax = toDec("-0.1")
+	require.Error(t, p.ValidateBasic())
+
+	tax = toDec("1.1")
+	require.Error(t, p.ValidateBasic())
+}
+
 func TestDefaultParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().ValidateBasic())
 }
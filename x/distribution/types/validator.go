@@ -46,3 +46,11 @@ func (vs ValidatorSlashEvents) String() string {
 	}
 	return strings.TrimSpace(out)
 }
+
+// ValidatorAllocation is the projected reward of a bonded validator for an
+// allocation. The reward of a validator in the burn list is burned.
+type ValidatorAllocation struct {
+	ValidatorAddress sdk.ValAddress
+	Reward           sdk.DecCoins
+	Burned           bool
+}
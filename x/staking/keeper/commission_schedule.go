@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetValidatorCommissionSchedule returns the scheduled commission rate changes
+// of a validator.
+func (k Keeper) GetValidatorCommissionSchedule(ctx sdk.Context, addr sdk.ValAddress) types.CommissionSchedule {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCommissionScheduleKey(addr))
+	if bz == nil {
+		return types.CommissionSchedule{}
+	}
+
+	var schedule types.CommissionSchedule
+	k.cdc.MustUnmarshal(bz, &schedule)
+
+	return schedule
+}
+
+// SetValidatorCommissionSchedule validates and sets the scheduled commission
+// rate changes of a validator. Entries must take effect after the current block
+// time, in increasing order, and each rate must be a valid change from the
+// previous one, at least CommissionChangeInterval after it. An empty schedule
+// removes the scheduled changes.
+func (k Keeper) SetValidatorCommissionSchedule(ctx sdk.Context, addr sdk.ValAddress, schedule types.CommissionSchedule) error {
+	validator, found := k.GetValidator(ctx, addr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if len(schedule.Entries) == 0 {
+		store.Delete(types.GetCommissionScheduleKey(addr))
+		return nil
+	}
+
+	minRate, maxRate := k.MinCommissionRate(ctx), k.MaxCommissionRate(ctx)
+	changeInterval := k.CommissionChangeInterval(ctx)
+	// each entry is validated as a change of the commission set by the
+	// previous one, the first one as a change of the current commission
+	commission := validator.Commission
+	after := ctx.BlockTime()
+	for i, entry := range schedule.Entries {
+		switch {
+		case !entry.EffectiveTime.After(after):
+			return types.ErrInvalidCommissionSchedule.Wrapf("entry %d must take effect after %s", i, after)
+
+		case entry.Rate.IsNil() || entry.Rate.IsNegative():
+			return types.ErrCommissionNegative
+
+		case entry.Rate.LT(minRate):
+			return types.ErrInvalidCommissionSchedule.Wrapf("entry %d rate is less than the minimum rate of %s", i, minRate)
+
+		case entry.Rate.GT(maxRate):
+			return types.ErrInvalidCommissionSchedule.Wrapf("entry %d rate is more than the maximum rate of %s", i, maxRate)
+		}
+
+		if err := commission.ValidateNewRate(entry.Rate, entry.EffectiveTime, changeInterval); err != nil {
+			return sdkerrors.Wrapf(err, "entry %d", i)
+		}
+
+		commission.Rate = entry.Rate
+		commission.UpdateTime = entry.EffectiveTime
+		after = entry.EffectiveTime
+	}
+
+	store.Set(types.GetCommissionScheduleKey(addr), k.cdc.MustMarshal(&schedule))
+
+	return nil
+}
+
+// GetValidatorEffectiveCommissionRate returns the commission rate of a
+// validator in effect at the current block time, taking its scheduled changes
+// into account. It returns zero if the validator does not exist.
+func (k Keeper) GetValidatorEffectiveCommissionRate(ctx sdk.Context, addr sdk.ValAddress) sdk.Dec {
+	validator, found := k.GetValidator(ctx, addr)
+	if !found {
+		return sdk.ZeroDec()
+	}
+
+	rate := validator.Commission.Rate
+	for _, entry := range k.GetValidatorCommissionSchedule(ctx, addr).Entries {
+		if entry.EffectiveTime.After(ctx.BlockTime()) {
+			break
+		}
+		rate = entry.Rate
+	}
+
+	return rate
+}
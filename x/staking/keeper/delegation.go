@@ -69,6 +69,85 @@ func (k Keeper) GetValidatorDelegations(ctx sdk.Context, valAddr sdk.ValAddress)
 	return delegations
 }
 
+// ReconcileValidatorShares sums the shares of the delegations to the given
+// validator, converts them to tokens at the validator exchange rate and
+// compares the result to the validator tokens. ok is false if the amounts
+// differ or the validator does not exist.
+func (k Keeper) ReconcileValidatorShares(ctx sdk.Context, valAddr sdk.ValAddress) (expectedTokens, actualTokens math.Int, ok bool) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return math.ZeroInt(), math.ZeroInt(), false
+	}
+
+	shares := math.LegacyZeroDec()
+	for _, delegation := range k.GetValidatorDelegations(ctx, valAddr) {
+		shares = shares.Add(delegation.Shares)
+	}
+
+	expectedTokens = math.ZeroInt()
+	if !validator.DelegatorShares.IsZero() {
+		expectedTokens = validator.TokensFromShares(shares).RoundInt()
+	}
+
+	return expectedTokens, validator.Tokens, expectedTokens.Equal(validator.Tokens)
+}
+
+// GetValidatorRewardShare returns the fraction of the future rewards of the
+// given validator, net of commission, that a new delegation of amount tokens
+// would earn given the current validator shares. The state is not modified.
+func (k Keeper) GetValidatorRewardShare(ctx sdk.Context, valAddr sdk.ValAddress, amount math.Int) (math.LegacyDec, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return math.LegacyZeroDec(), types.ErrNoValidatorFound
+	}
+
+	if !amount.IsPositive() {
+		return math.LegacyZeroDec(), nil
+	}
+
+	// delegations are rejected once all the tokens have been slashed
+	if validator.InvalidExRate() {
+		return math.LegacyZeroDec(), types.ErrDelegatorShareExRateInvalid
+	}
+
+	validator, issuedShares := validator.AddTokensFromDel(amount)
+	share := issuedShares.Quo(validator.DelegatorShares)
+
+	return share.Mul(math.LegacyOneDec().Sub(validator.Commission.Rate)), nil
+}
+
+// validateMinDelegation returns ErrDelegationBelowMinimum if the amount is
+// below the MinDelegation param, unless it is a self delegation exempted by
+// the MinDelegationExemptSelfDelegation param.
+func (k Keeper) validateMinDelegation(ctx sdk.Context, delAddr sdk.AccAddress, validator types.Validator, amount math.Int) error {
+	params := k.GetParams(ctx)
+	if params.MinDelegation.IsNil() || !params.MinDelegation.IsPositive() || amount.GTE(params.MinDelegation) {
+		return nil
+	}
+
+	if params.MinDelegationExemptSelfDelegation && delAddr.Equals(sdk.AccAddress(validator.GetOperator())) {
+		return nil
+	}
+
+	return sdkerrors.Wrapf(types.ErrDelegationBelowMinimum, "got %s, minimum is %s", amount, params.MinDelegation)
+}
+
+// GetValidatorTokenShareExchangeRate returns the tokens backing each share of
+// the given validator. Slashing lowers the rate below one. A validator without
+// shares issues them one to one to its first delegation, so its rate is one.
+func (k Keeper) GetValidatorTokenShareExchangeRate(ctx sdk.Context, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return math.LegacyZeroDec(), types.ErrNoValidatorFound
+	}
+
+	if validator.DelegatorShares.IsZero() {
+		return math.LegacyOneDec(), nil
+	}
+
+	return sdk.NewDecFromInt(validator.Tokens).Quo(validator.DelegatorShares), nil
+}
+
 // GetDelegatorDelegations returns a given amount of all the delegations from a
 // delegator.
 func (k Keeper) GetDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress, maxRetrieve uint16) (delegations []types.Delegation) {
@@ -103,7 +182,7 @@ func (k Keeper) RemoveDelegation(ctx sdk.Context, delegation types.Delegation) e
 	delegatorAddress := sdk.MustAccAddressFromBech32(delegation.DelegatorAddress)
 
 	// TODO: Consider calling hooks outside of the store wrapper functions, it's unobvious.
-	if err := k.Hooks().BeforeDelegationRemoved(ctx, delegatorAddress, delegation.GetValidatorAddr()); err != nil {
+	if err := k.handleHookError(ctx, "before delegation removed", k.Hooks().BeforeDelegationRemoved(ctx, delegatorAddress, delegation.GetValidatorAddr())); err != nil {
 		return err
 	}
 
@@ -317,8 +396,8 @@ func (k Keeper) SetUnbondingDelegationEntry(
 	// Add to the UBDByUnbondingOp index to look up the UBD by the UBDE ID
 	k.SetUnbondingDelegationByUnbondingID(ctx, ubd, id)
 
-	if err := k.Hooks().AfterUnbondingInitiated(ctx, id); err != nil {
-		k.Logger(ctx).Error("failed to call after unbonding initiated hook", "error", err)
+	if err := k.handleLoggedHookError(ctx, "after unbonding initiated", k.Hooks().AfterUnbondingInitiated(ctx, id)); err != nil {
+		panic(err)
 	}
 
 	return ubd
@@ -509,8 +588,8 @@ func (k Keeper) SetRedelegationEntry(ctx sdk.Context,
 	// Add to the UBDByEntry index to look up the UBD by the UBDE ID
 	k.SetRedelegationByUnbondingID(ctx, red, id)
 
-	if err := k.Hooks().AfterUnbondingInitiated(ctx, id); err != nil {
-		k.Logger(ctx).Error("failed to call after unbonding initiated hook", "error", err)
+	if err := k.handleLoggedHookError(ctx, "after unbonding initiated", k.Hooks().AfterUnbondingInitiated(ctx, id)); err != nil {
+		panic(err)
 	}
 
 	return red
@@ -638,6 +717,17 @@ func (k Keeper) Delegate(
 		return math.LegacyZeroDec(), types.ErrDelegatorShareExRateInvalid
 	}
 
+	// redelegations move existing tokens and are not subject to the minimum
+	if subtractAccount {
+		if err := k.validateMinDelegation(ctx, delAddr, validator, bondAmt); err != nil {
+			return math.LegacyZeroDec(), err
+		}
+	}
+
+	if err := k.recordTokenMovement(ctx, bondAmt); err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
 	// Get or create the delegation object
 	delegation, found := k.GetDelegation(ctx, delAddr, validator.GetOperator())
 	if !found {
@@ -651,7 +741,7 @@ func (k Keeper) Delegate(
 		err = k.Hooks().BeforeDelegationCreated(ctx, delAddr, validator.GetOperator())
 	}
 
-	if err != nil {
+	if err := k.handleHookError(ctx, "before delegation modified", err); err != nil {
 		return math.LegacyZeroDec(), err
 	}
 
@@ -705,7 +795,7 @@ func (k Keeper) Delegate(
 	k.SetDelegation(ctx, delegation)
 
 	// Call the after-modification hook
-	if err := k.Hooks().AfterDelegationModified(ctx, delegatorAddress, delegation.GetValidatorAddr()); err != nil {
+	if err := k.handleHookError(ctx, "after delegation modified", k.Hooks().AfterDelegationModified(ctx, delegatorAddress, delegation.GetValidatorAddr())); err != nil {
 		return newShares, err
 	}
 
@@ -713,8 +803,23 @@ func (k Keeper) Delegate(
 }
 
 // Unbond unbonds a particular delegation and perform associated store operations.
+// The unbonded tokens count against the MaxTokenMovementPerBlock cap.
 func (k Keeper) Unbond(
 	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec,
+) (amount math.Int, err error) {
+	amount, err = k.unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return amount, err
+	}
+
+	return amount, k.recordTokenMovement(ctx, amount)
+}
+
+// unbond unbonds a particular delegation without accounting for the token
+// movement cap. Slashing unbonds through it so that the cap cannot halt the
+// chain from BeginBlock.
+func (k Keeper) unbond(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec,
 ) (amount math.Int, err error) {
 	// check if a delegation object exists in the store
 	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
@@ -723,7 +828,7 @@ func (k Keeper) Unbond(
 	}
 
 	// call the before-delegation-modified hook
-	if err := k.Hooks().BeforeDelegationSharesModified(ctx, delAddr, valAddr); err != nil {
+	if err := k.handleHookError(ctx, "before delegation shares modified", k.Hooks().BeforeDelegationSharesModified(ctx, delAddr, valAddr)); err != nil {
 		return amount, err
 	}
 
@@ -752,7 +857,7 @@ func (k Keeper) Unbond(
 	// self-delegation below their minimum, we jail the validator.
 	if isValidatorOperator && !validator.Jailed &&
 		validator.TokensFromShares(delegation.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
-		k.jailValidator(ctx, validator)
+		k.jailValidator(ctx, validator, types.JailReasonMinSelfDelegation)
 		validator = k.mustGetValidator(ctx, validator.GetOperator())
 	}
 
@@ -761,7 +866,7 @@ func (k Keeper) Unbond(
 	} else {
 		k.SetDelegation(ctx, delegation)
 		// call the after delegation modification hook
-		err = k.Hooks().AfterDelegationModified(ctx, delegatorAddress, delegation.GetValidatorAddr())
+		err = k.handleHookError(ctx, "after delegation modified", k.Hooks().AfterDelegationModified(ctx, delegatorAddress, delegation.GetValidatorAddr()))
 	}
 
 	if err != nil {
@@ -773,7 +878,9 @@ func (k Keeper) Unbond(
 	validator, amount = k.RemoveValidatorTokensAndShares(ctx, validator, shares)
 	if validator.DelegatorShares.IsZero() && validator.IsUnbonded() {
 		// if not unbonded, we must instead remove validator in EndBlocker once it finishes its unbonding period
-		k.RemoveValidator(ctx, validator.GetOperator())
+		if err := k.RemoveValidator(ctx, validator.GetOperator()); err != nil {
+			return amount, err
+		}
 	}
 
 	return amount, nil
@@ -949,6 +1056,19 @@ func (k Keeper) BeginRedelegation(
 	return completionTime, nil
 }
 
+// RedelegateAll redelegates the entire delegation of a delegator from the
+// source validator to the destination validator.
+func (k Keeper) RedelegateAll(
+	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress,
+) (completionTime time.Time, err error) {
+	delegation, found := k.GetDelegation(ctx, delAddr, valSrcAddr)
+	if !found {
+		return time.Time{}, types.ErrNoDelegation
+	}
+
+	return k.BeginRedelegation(ctx, delAddr, valSrcAddr, valDstAddr, delegation.Shares)
+}
+
 // CompleteRedelegation completes the redelegations of all mature entries in the
 // retrieved redelegation object and returns the total redelegation (initial)
 // balance or an error upon failure.
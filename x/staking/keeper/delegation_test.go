@@ -846,3 +846,140 @@ func (s *KeeperTestSuite) TestRedelegateFromUnbondedValidator() {
 	red, found := keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(found, "%v", red)
 }
+
+func (s *KeeperTestSuite) TestRedelegateAll() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrDels, addrVals := createValAddrs(2)
+
+	// create two bonded validators
+	valTokens := keeper.TokensFromConsensusPower(ctx, 10)
+	for i := range addrVals {
+		validator := testutil.NewValidator(s.T(), addrVals[i], PKs[i])
+		validator, _ = validator.AddTokensFromDel(valTokens)
+		s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+		validator = stakingkeeper.TestingUpdateValidator(keeper, ctx, validator, true)
+		require.Equal(stakingtypes.Bonded, validator.Status)
+	}
+
+	// delegate to the first validator
+	validator, found := keeper.GetValidator(ctx, addrVals[0])
+	require.True(found)
+	delTokens := keeper.TokensFromConsensusPower(ctx, 5)
+	validator, issuedShares := validator.AddTokensFromDel(delTokens)
+	keeper.SetValidator(ctx, validator)
+	keeper.SetDelegation(ctx, stakingtypes.NewDelegation(addrDels[0], addrVals[0], issuedShares))
+
+	_, err := keeper.RedelegateAll(ctx, addrDels[1], addrVals[0], addrVals[1])
+	require.ErrorIs(err, stakingtypes.ErrNoDelegation)
+
+	completionTime, err := keeper.RedelegateAll(ctx, addrDels[0], addrVals[0], addrVals[1])
+	require.NoError(err)
+
+	// the source delegation is fully removed
+	_, found = keeper.GetDelegation(ctx, addrDels[0], addrVals[0])
+	require.False(found)
+
+	dstDelegation, found := keeper.GetDelegation(ctx, addrDels[0], addrVals[1])
+	require.True(found)
+	require.Equal(issuedShares, dstDelegation.Shares)
+
+	// a queued redelegation entry exists
+	red, found := keeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
+	require.True(found)
+	require.Len(red.Entries, 1)
+	require.Equal(delTokens, red.Entries[0].InitialBalance)
+	require.Equal(completionTime, red.Entries[0].CompletionTime)
+	require.Len(keeper.GetRedelegationQueueTimeSlice(ctx, completionTime), 1)
+}
+
+func (s *KeeperTestSuite) TestReconcileValidatorShares() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrDels, valAddrs := createValAddrs(2)
+
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	for i, amount := range []int64{10, 25} {
+		var issuedShares math.LegacyDec
+		validator, issuedShares = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, amount))
+		keeper.SetDelegation(ctx, stakingtypes.NewDelegation(addrDels[i], valAddrs[0], issuedShares))
+	}
+	keeper.SetValidator(ctx, validator)
+
+	expected, actual, ok := keeper.ReconcileValidatorShares(ctx, valAddrs[0])
+	require.True(ok)
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 35), expected)
+	require.Equal(expected, actual)
+	_, broken := stakingkeeper.ValidatorTokensInvariant(keeper)(ctx)
+	require.False(broken)
+
+	// shares issued without a matching delegation
+	validator.DelegatorShares = validator.DelegatorShares.MulInt64(2)
+	keeper.SetValidator(ctx, validator)
+
+	expected, actual, ok = keeper.ReconcileValidatorShares(ctx, valAddrs[0])
+	require.False(ok)
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 35).QuoRaw(2), expected)
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 35), actual)
+	_, broken = stakingkeeper.ValidatorTokensInvariant(keeper)(ctx)
+	require.True(broken)
+
+	_, _, ok = keeper.ReconcileValidatorShares(ctx, valAddrs[1])
+	require.False(ok)
+}
+
+func (s *KeeperTestSuite) TestDelegateMinDelegation() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	addrDels, valAddrs := createValAddrs(2)
+
+	params := keeper.GetParams(ctx)
+	params.MinDelegation = keeper.TokensFromConsensusPower(ctx, 1)
+	require.NoError(keeper.SetParams(ctx, params))
+
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	keeper.SetValidator(ctx, validator)
+
+	// below the minimum is rejected
+	_, err := keeper.Delegate(ctx, addrDels[1], params.MinDelegation.SubRaw(1), stakingtypes.Unbonded, validator, true)
+	require.ErrorIs(err, stakingtypes.ErrDelegationBelowMinimum)
+	_, found := keeper.GetDelegation(ctx, addrDels[1], valAddrs[0])
+	require.False(found)
+
+	// exactly the minimum is accepted
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), addrDels[1], stakingtypes.NotBondedPoolName, gomock.Any())
+	shares, err := keeper.Delegate(ctx, addrDels[1], params.MinDelegation, stakingtypes.Unbonded, validator, true)
+	require.NoError(err)
+	require.Equal(params.MinDelegation, shares.RoundInt())
+	_, found = keeper.GetDelegation(ctx, addrDels[1], valAddrs[0])
+	require.True(found)
+}
+
+func (s *KeeperTestSuite) TestDelegateMinDelegationExemptSelfDelegation() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	_, valAddrs := createValAddrs(1)
+	selfDelAddr := sdk.AccAddress(valAddrs[0])
+
+	params := keeper.GetParams(ctx)
+	params.MinDelegation = keeper.TokensFromConsensusPower(ctx, 1)
+	require.NoError(keeper.SetParams(ctx, params))
+
+	validator := testutil.NewValidator(s.T(), valAddrs[0], PKs[0])
+	keeper.SetValidator(ctx, validator)
+
+	// self delegations are held to the minimum unless exempted
+	_, err := keeper.Delegate(ctx, selfDelAddr, sdk.NewInt(1), stakingtypes.Unbonded, validator, true)
+	require.ErrorIs(err, stakingtypes.ErrDelegationBelowMinimum)
+
+	params.MinDelegationExemptSelfDelegation = true
+	require.NoError(keeper.SetParams(ctx, params))
+
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), selfDelAddr, stakingtypes.NotBondedPoolName, gomock.Any())
+	_, err = keeper.Delegate(ctx, selfDelAddr, sdk.NewInt(1), stakingtypes.Unbonded, validator, true)
+	require.NoError(err)
+}
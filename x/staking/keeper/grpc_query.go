@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"sort"
 	"strings"
 
 	"google.golang.org/grpc/codes"
@@ -32,12 +33,45 @@ func (k Querier) Validators(c context.Context, req *types.QueryValidatorsRequest
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
+	vals, pageRes, err := k.paginateValidators(ctx, req.Pagination, func(val *types.Validator) bool {
+		return req.Status == "" || strings.EqualFold(val.GetStatus().String(), req.Status)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryValidatorsResponse{Validators: vals, Pagination: pageRes}, nil
+}
+
+// ValidatorsByStatus queries the validators with the given bond status
+func (k Querier) ValidatorsByStatus(c context.Context, req *types.QueryValidatorsByStatusRequest) (*types.QueryValidatorsByStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Status != types.Bonded && req.Status != types.Unbonding && req.Status != types.Unbonded {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validator status %s", req.Status)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	vals, pageRes, err := k.paginateValidators(ctx, req.Pagination, func(val *types.Validator) bool {
+		return val.GetStatus() == req.Status
+	})
+	if err != nil {
+		return nil, err
+	}
 
+	return &types.QueryValidatorsByStatusResponse{Validators: vals, Pagination: pageRes}, nil
+}
+
+// paginateValidators paginates over the validators store, only returning and
+// counting the validators matching the filter.
+func (k Querier) paginateValidators(ctx sdk.Context, pagination *query.PageRequest, filter func(val *types.Validator) bool) (types.Validators, *query.PageResponse, error) {
 	store := ctx.KVStore(k.storeKey)
 	valStore := prefix.NewStore(store, types.ValidatorsKey)
 
-	validators, pageRes, err := query.GenericFilteredPaginate(k.cdc, valStore, req.Pagination, func(key []byte, val *types.Validator) (*types.Validator, error) {
-		if req.Status != "" && !strings.EqualFold(val.GetStatus().String(), req.Status) {
+	validators, pageRes, err := query.GenericFilteredPaginate(k.cdc, valStore, pagination, func(key []byte, val *types.Validator) (*types.Validator, error) {
+		if !filter(val) {
 			return nil, nil
 		}
 
@@ -46,7 +80,7 @@ func (k Querier) Validators(c context.Context, req *types.QueryValidatorsRequest
 		return &types.Validator{}
 	})
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, nil, status.Error(codes.Internal, err.Error())
 	}
 
 	vals := types.Validators{}
@@ -54,7 +88,7 @@ func (k Querier) Validators(c context.Context, req *types.QueryValidatorsRequest
 		vals = append(vals, *val)
 	}
 
-	return &types.QueryValidatorsResponse{Validators: vals, Pagination: pageRes}, nil
+	return vals, pageRes, nil
 }
 
 // Validator queries validator info for given validator address
@@ -464,6 +498,250 @@ func (k Querier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// ValidatorPowerDelta queries the current and last consensus power of a validator
+func (k Querier) ValidatorPowerDelta(c context.Context, req *types.QueryValidatorPowerDeltaRequest) (*types.QueryValidatorPowerDeltaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	currentPower := validator.ConsensusPower(k.PowerReduction(ctx))
+	lastPower := k.GetLastValidatorPower(ctx, valAddr)
+
+	return &types.QueryValidatorPowerDeltaResponse{
+		CurrentPower: currentPower,
+		LastPower:    lastPower,
+		Delta:        currentPower - lastPower,
+	}, nil
+}
+
+// ValidatorCommissionDistribution queries the number of validators per commission rate bucket
+func (k Querier) ValidatorCommissionDistribution(c context.Context, req *types.QueryValidatorCommissionDistributionRequest) (*types.QueryValidatorCommissionDistributionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.BucketSize.IsNil() || !req.BucketSize.IsPositive() || req.BucketSize.GT(sdk.OneDec()) {
+		return nil, status.Error(codes.InvalidArgument, "bucket size must be positive and not greater than one")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// the last bucket also holds validators charging exactly 100% commission
+	lastBucket := sdk.OneDec().Quo(req.BucketSize).Ceil().TruncateInt64() - 1
+	counts := make(map[int64]uint64)
+	for _, validator := range k.GetAllValidators(ctx) {
+		bucket := validator.Commission.Rate.Quo(req.BucketSize).TruncateInt64()
+		if bucket > lastBucket {
+			bucket = lastBucket
+		}
+		counts[bucket]++
+	}
+
+	indexes := make([]int64, 0, len(counts))
+	for index := range counts {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+
+	buckets := make([]types.CommissionBucket, 0, len(indexes))
+	for _, index := range indexes {
+		lowerBound := req.BucketSize.MulInt64(index)
+		buckets = append(buckets, types.CommissionBucket{
+			LowerBound: lowerBound,
+			UpperBound: sdk.MinDec(lowerBound.Add(req.BucketSize), sdk.OneDec()),
+			Count:      counts[index],
+		})
+	}
+
+	return &types.QueryValidatorCommissionDistributionResponse{Buckets: buckets}, nil
+}
+
+// EstimateSlash queries the impact a slash of the given fraction would have on a validator
+func (k Querier) EstimateSlash(c context.Context, req *types.QueryEstimateSlashRequest) (*types.QueryEstimateSlashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	if req.SlashFraction.IsNil() || req.SlashFraction.IsNegative() || req.SlashFraction.GT(sdk.OneDec()) {
+		return nil, status.Error(codes.InvalidArgument, "slash fraction must be between zero and one")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	burnedTokens, slashed := k.EstimateSlashImpact(validator, req.SlashFraction)
+
+	return &types.QueryEstimateSlashResponse{
+		BurnedTokens:    burnedTokens,
+		ValidatorTokens: slashed.Tokens,
+		DelegatorShares: slashed.DelegatorShares,
+	}, nil
+}
+
+// SlashScenarioJails queries whether a slash of the given fraction would jail a validator
+func (k Querier) SlashScenarioJails(c context.Context, req *types.QuerySlashScenarioJailsRequest) (*types.QuerySlashScenarioJailsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "consensus address cannot be empty")
+	}
+
+	if req.SlashFraction.IsNil() || req.SlashFraction.IsNegative() || req.SlashFraction.GT(sdk.OneDec()) {
+		return nil, status.Error(codes.InvalidArgument, "slash fraction must be between zero and one")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validator, found := k.GetValidatorByConsAddr(ctx, consAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ConsAddr)
+	}
+
+	selfDelegation, jailed := k.EstimateSlashJail(ctx, validator, req.SlashFraction)
+
+	return &types.QuerySlashScenarioJailsResponse{
+		Jailed:            jailed,
+		SelfDelegation:    selfDelegation,
+		MinSelfDelegation: validator.MinSelfDelegation,
+	}, nil
+}
+
+// ValidatorsByMoniker queries the validators whose moniker contains the given
+// substring, ignoring case
+func (k Querier) ValidatorsByMoniker(c context.Context, req *types.QueryValidatorsByMonikerRequest) (*types.QueryValidatorsByMonikerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Moniker == "" {
+		return nil, status.Error(codes.InvalidArgument, "moniker cannot be empty")
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	substr := strings.ToLower(req.Moniker)
+
+	validators := []types.Validator{}
+	for _, validator := range k.GetAllValidators(ctx) {
+		if len(validators) >= limit {
+			break
+		}
+
+		if strings.Contains(strings.ToLower(validator.Description.Moniker), substr) {
+			validators = append(validators, validator)
+		}
+	}
+
+	return &types.QueryValidatorsByMonikerResponse{Validators: validators}, nil
+}
+
+// ActiveSetHeadroom queries how many more validators can join the active set
+func (k Querier) ActiveSetHeadroom(c context.Context, req *types.QueryActiveSetHeadroomRequest) (*types.QueryActiveSetHeadroomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	headroom, thresholdPower := k.GetMaxValidatorsHeadroom(ctx)
+
+	return &types.QueryActiveSetHeadroomResponse{
+		Headroom:       headroom,
+		ThresholdPower: thresholdPower,
+	}, nil
+}
+
+// TotalStakedBreakdown queries the total validator tokens per bond status
+func (k Querier) TotalStakedBreakdown(c context.Context, req *types.QueryTotalStakedBreakdownRequest) (*types.QueryTotalStakedBreakdownResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	bonded, unbonding, unbonded := k.GetTotalStakedByStatus(ctx)
+
+	return &types.QueryTotalStakedBreakdownResponse{
+		Bonded:    bonded,
+		Unbonding: unbonding,
+		Unbonded:  unbonded,
+	}, nil
+}
+
+// ValidatorCreationHeight queries the block height at which a validator was created
+func (k Querier) ValidatorCreationHeight(c context.Context, req *types.QueryValidatorCreationHeightRequest) (*types.QueryValidatorCreationHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	height, found := k.GetValidatorCreationHeight(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "creation height of validator %s not found", req.ValidatorAddr)
+	}
+
+	return &types.QueryValidatorCreationHeightResponse{Height: height}, nil
+}
+
+// NakamotoCoefficient queries the number of validators needed to exceed one third of the bonded power
+func (k Querier) NakamotoCoefficient(c context.Context, req *types.QueryNakamotoCoefficientRequest) (*types.QueryNakamotoCoefficientResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	coefficient, totalPower := k.GetNakamotoCoefficient(ctx)
+
+	return &types.QueryNakamotoCoefficientResponse{
+		Coefficient: uint32(coefficient),
+		TotalPower:  totalPower,
+	}, nil
+}
+
 func queryRedelegation(ctx sdk.Context, k Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
 	if err != nil {
@@ -611,3 +889,328 @@ func RedelegationsToRedelegationResponses(ctx sdk.Context, k *Keeper, redels typ
 
 	return resp, nil
 }
+
+// ValidatorPowerHistory queries the latest consensus power changes of a validator
+func (k Querier) ValidatorPowerHistory(c context.Context, req *types.QueryValidatorPowerHistoryRequest) (*types.QueryValidatorPowerHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	entries := k.GetValidatorPowerHistory(ctx, valAddr).Entries
+	if req.Limit > 0 && uint32(len(entries)) > req.Limit {
+		entries = entries[uint32(len(entries))-req.Limit:]
+	}
+
+	return &types.QueryValidatorPowerHistoryResponse{Entries: entries}, nil
+}
+
+// ZeroPowerBondedValidators queries the bonded validators without consensus power
+func (k Querier) ZeroPowerBondedValidators(c context.Context, req *types.QueryZeroPowerBondedValidatorsRequest) (*types.QueryZeroPowerBondedValidatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryZeroPowerBondedValidatorsResponse{Validators: k.GetBondedValidatorsWithZeroPower(ctx)}, nil
+}
+
+// ValidatorEvmOrigin queries whether a validator was created through the EVM
+func (k Querier) ValidatorEvmOrigin(c context.Context, req *types.QueryValidatorEvmOriginRequest) (*types.QueryValidatorEvmOriginResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := k.GetValidator(ctx, valAddr); !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	return &types.QueryValidatorEvmOriginResponse{IsEvm: k.IsEvmValidator(ctx, valAddr)}, nil
+}
+
+// UnbondingValidatorQueueDepth queries the depth of the unbonding validator queue
+func (k Querier) UnbondingValidatorQueueDepth(c context.Context, req *types.QueryUnbondingValidatorQueueDepthRequest) (*types.QueryUnbondingValidatorQueueDepthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	slices, total := k.GetValidatorQueueDepth(ctx)
+
+	return &types.QueryUnbondingValidatorQueueDepthResponse{Slices: slices, Total: total}, nil
+}
+
+// ProspectiveRewardShare queries the share of the validator rewards a new delegation would earn
+func (k Querier) ProspectiveRewardShare(c context.Context, req *types.QueryProspectiveRewardShareRequest) (*types.QueryProspectiveRewardShareResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	if req.Amount.IsNil() || !req.Amount.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if _, found := k.GetValidator(ctx, valAddr); !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	share, err := k.GetValidatorRewardShare(ctx, valAddr, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryProspectiveRewardShareResponse{Share: share}, nil
+}
+
+// ValidatorConsPubKey queries the consensus public key of a validator
+func (k Querier) ValidatorConsPubKey(c context.Context, req *types.QueryValidatorConsPubKeyRequest) (*types.QueryValidatorConsPubKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	bech32PubKey, err := k.GetValidatorConsPubKeyBech32(ctx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryValidatorConsPubKeyResponse{Bech32: bech32PubKey, Pubkey: validator.ConsensusPubkey}, nil
+}
+
+// ValidatorUnbonding queries the unbonding state of a validator
+func (k Querier) ValidatorUnbonding(c context.Context, req *types.QueryValidatorUnbondingRequest) (*types.QueryValidatorUnbondingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	return &types.QueryValidatorUnbondingResponse{
+		UnbondingTime:           validator.UnbondingTime,
+		UnbondingHeight:         validator.UnbondingHeight,
+		UnbondingIds:            validator.UnbondingIds,
+		UnbondingOnHoldRefCount: validator.UnbondingOnHoldRefCount,
+	}, nil
+}
+
+// PendingEvmValidators queries the EVM validator registrations whose validator
+// has not been created yet
+func (k Querier) PendingEvmValidators(c context.Context, req *types.QueryPendingEvmValidatorsRequest) (*types.QueryPendingEvmValidatorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	msgs := k.GetValidatorsPendingEvmCreation(ctx)
+
+	validators := make([]types.PendingEvmValidator, 0, len(msgs))
+	for _, msg := range msgs {
+		validators = append(validators, types.PendingEvmValidator{
+			ValidatorAddress: msg.ValidatorAddress,
+			DelegatorAddress: msg.DelegatorAddress,
+			Value:            msg.Value,
+		})
+	}
+
+	return &types.QueryPendingEvmValidatorsResponse{Validators: validators}, nil
+}
+
+// ValidatorExchangeRate queries the tokens backing each delegator share of a
+// validator
+func (k Querier) ValidatorExchangeRate(c context.Context, req *types.QueryValidatorExchangeRateRequest) (*types.QueryValidatorExchangeRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	rate, err := k.GetValidatorTokenShareExchangeRate(ctx, valAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	return &types.QueryValidatorExchangeRateResponse{Rate: rate}, nil
+}
+
+// ValidatorsWithCommissionBelow queries the bonded validators whose commission
+// rate is below the given rate
+func (k Querier) ValidatorsWithCommissionBelow(c context.Context, req *types.QueryValidatorsWithCommissionBelowRequest) (*types.QueryValidatorsWithCommissionBelowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Rate.IsNil() || req.Rate.IsNegative() {
+		return nil, status.Error(codes.InvalidArgument, "rate must be non-negative")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	validators := k.GetValidatorsByCommissionBelow(ctx, req.Rate, req.Limit)
+
+	return &types.QueryValidatorsWithCommissionBelowResponse{Validators: validators}, nil
+}
+
+// ValidatorsStream streams the validators matching the given status one at a
+// time in store order
+func (k Querier) ValidatorsStream(req *types.QueryValidatorsStreamRequest, stream types.Query_ValidatorsStreamServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// validate the provided status, stream all the validators if the status is empty
+	if req.Status != "" && !(req.Status == types.Bonded.String() || req.Status == types.Unbonded.String() || req.Status == types.Unbonding.String()) {
+		return status.Errorf(codes.InvalidArgument, "invalid validator status %s", req.Status)
+	}
+
+	ctx := sdk.UnwrapSDKContext(stream.Context())
+
+	var err error
+	k.IterateValidators(ctx, func(_ int64, val types.ValidatorI) bool {
+		validator := val.(types.Validator)
+		if req.Status != "" && !strings.EqualFold(validator.GetStatus().String(), req.Status) {
+			return false
+		}
+
+		err = stream.Send(&types.QueryValidatorsStreamResponse{Validator: validator})
+		return err != nil
+	})
+
+	return err
+}
+
+// ValidatorJailHistory queries the latest jailings of a validator
+func (k Querier) ValidatorJailHistory(c context.Context, req *types.QueryValidatorJailHistoryRequest) (*types.QueryValidatorJailHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryValidatorJailHistoryResponse{Entries: k.GetValidatorJailHistory(ctx, valAddr).Entries}, nil
+}
+
+// TotalDelegatorShares queries the sum of the delegator shares issued by all
+// the validators
+func (k Querier) TotalDelegatorShares(c context.Context, req *types.QueryTotalDelegatorSharesRequest) (*types.QueryTotalDelegatorSharesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTotalDelegatorSharesResponse{Shares: k.GetValidatorsTotalShares(ctx)}, nil
+}
+
+// ValidatorsUnbondingSoon queries the unbonding validators whose unbonding
+// completes within the given duration from the current block time
+func (k Querier) ValidatorsUnbondingSoon(c context.Context, req *types.QueryValidatorsUnbondingSoonRequest) (*types.QueryValidatorsUnbondingSoonResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Within < 0 {
+		return nil, status.Error(codes.InvalidArgument, "duration cannot be negative")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryValidatorsUnbondingSoonResponse{Validators: k.GetValidatorsPendingUnbond(ctx, req.Within)}, nil
+}
+
+// ValidatorVotingPowerPercentage queries the fraction of the total bonded power
+// held by a validator
+func (k Querier) ValidatorVotingPowerPercentage(c context.Context, req *types.QueryValidatorVotingPowerPercentageRequest) (*types.QueryValidatorVotingPowerPercentageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	percentage, found := k.GetValidatorVotingPowerPercentage(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	return &types.QueryValidatorVotingPowerPercentageResponse{Percentage: percentage}, nil
+}
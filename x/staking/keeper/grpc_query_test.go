@@ -3,8 +3,16 @@ package keeper_test
 import (
 	gocontext "context"
 	"fmt"
+	"time"
 
+	"cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32/legacybech32"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -51,3 +59,811 @@ func (s *KeeperTestSuite) TestGRPCQueryValidator() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGRPCQueryValidatorPowerDelta() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+	validator = validator.UpdateStatus(types.Bonded)
+	keeper.SetValidator(ctx, validator)
+	keeper.SetLastValidatorPower(ctx, valAddr, 10)
+
+	_, err := queryClient.ValidatorPowerDelta(gocontext.Background(), &types.QueryValidatorPowerDeltaRequest{})
+	require.Error(err)
+
+	res, err := queryClient.ValidatorPowerDelta(gocontext.Background(), &types.QueryValidatorPowerDeltaRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(err)
+	require.Equal(int64(10), res.CurrentPower)
+	require.Equal(int64(10), res.LastPower)
+	require.Zero(res.Delta)
+
+	// add tokens mid-block, the last power stays until the end blocker runs
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 5))
+	keeper.SetValidator(ctx, validator)
+
+	res, err = queryClient.ValidatorPowerDelta(gocontext.Background(), &types.QueryValidatorPowerDeltaRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(err)
+	require.Equal(int64(15), res.CurrentPower)
+	require.Equal(int64(10), res.LastPower)
+	require.Equal(int64(5), res.Delta)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryValidatorCommissionDistribution() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	for i, rate := range []int64{3, 7, 12} {
+		validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		validator.Commission = types.NewCommission(sdk.NewDecWithPrec(rate, 2), sdk.OneDec(), sdk.ZeroDec())
+		keeper.SetValidator(ctx, validator)
+	}
+
+	_, err := queryClient.ValidatorCommissionDistribution(gocontext.Background(), &types.QueryValidatorCommissionDistributionRequest{BucketSize: sdk.ZeroDec()})
+	require.Error(err)
+
+	res, err := queryClient.ValidatorCommissionDistribution(gocontext.Background(), &types.QueryValidatorCommissionDistributionRequest{BucketSize: sdk.NewDecWithPrec(5, 2)})
+	require.NoError(err)
+	require.Equal([]types.CommissionBucket{
+		{LowerBound: sdk.ZeroDec(), UpperBound: sdk.NewDecWithPrec(5, 2), Count: 1},
+		{LowerBound: sdk.NewDecWithPrec(5, 2), UpperBound: sdk.NewDecWithPrec(10, 2), Count: 1},
+		{LowerBound: sdk.NewDecWithPrec(10, 2), UpperBound: sdk.NewDecWithPrec(15, 2), Count: 1},
+	}, res.Buckets)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryValidatorsByMoniker() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	for i, moniker := range []string{"Alpha Staking", "beta", "ALPHANODE"} {
+		validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		validator.Description = types.NewDescription(moniker, "", "", "", "")
+		keeper.SetValidator(ctx, validator)
+	}
+
+	_, err := queryClient.ValidatorsByMoniker(gocontext.Background(), &types.QueryValidatorsByMonikerRequest{})
+	require.Error(err)
+
+	res, err := queryClient.ValidatorsByMoniker(gocontext.Background(), &types.QueryValidatorsByMonikerRequest{Moniker: "alpha"})
+	require.NoError(err)
+	monikers := make([]string, len(res.Validators))
+	for i, validator := range res.Validators {
+		monikers[i] = validator.Description.Moniker
+	}
+	require.ElementsMatch([]string{"Alpha Staking", "ALPHANODE"}, monikers)
+
+	res, err = queryClient.ValidatorsByMoniker(gocontext.Background(), &types.QueryValidatorsByMonikerRequest{Moniker: "alpha", Limit: 1})
+	require.NoError(err)
+	require.Len(res.Validators, 1)
+
+	res, err = queryClient.ValidatorsByMoniker(gocontext.Background(), &types.QueryValidatorsByMonikerRequest{Moniker: "gamma"})
+	require.NoError(err)
+	require.Empty(res.Validators)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryActiveSetHeadroom() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	params := keeper.GetParams(ctx)
+	params.MaxValidators = 3
+	require.NoError(keeper.SetParams(ctx, params))
+
+	res, err := queryClient.ActiveSetHeadroom(gocontext.Background(), &types.QueryActiveSetHeadroomRequest{})
+	require.NoError(err)
+	require.Equal(uint32(3), res.Headroom)
+	require.Zero(res.ThresholdPower)
+
+	// fill the active set with validators of power 30, 10 and 20
+	for i, power := range []int64{30, 10, 20} {
+		validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, power))
+		validator = validator.UpdateStatus(types.Bonded)
+		keeper.SetValidator(ctx, validator)
+		keeper.SetValidatorByPowerIndex(ctx, validator)
+	}
+
+	res, err = queryClient.ActiveSetHeadroom(gocontext.Background(), &types.QueryActiveSetHeadroomRequest{})
+	require.NoError(err)
+	require.Zero(res.Headroom)
+	require.Equal(int64(10), res.ThresholdPower)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryTotalStakedBreakdown() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	statuses := []types.BondStatus{types.Bonded, types.Bonded, types.Unbonding, types.Unbonded}
+	for i, bondStatus := range statuses {
+		validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, int64(i+1)))
+		validator = validator.UpdateStatus(bondStatus)
+		keeper.SetValidator(ctx, validator)
+	}
+
+	bonded, unbonding, unbonded := keeper.GetTotalStakedByStatus(ctx)
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 3), bonded)
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 3), unbonding)
+	require.Equal(keeper.TokensFromConsensusPower(ctx, 4), unbonded)
+
+	res, err := queryClient.TotalStakedBreakdown(gocontext.Background(), &types.QueryTotalStakedBreakdownRequest{})
+	require.NoError(err)
+	require.Equal(bonded, res.Bonded)
+	require.Equal(unbonding, res.Unbonding)
+	require.Equal(unbonded, res.Unbonded)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryValidatorCreationHeight() {
+	keeper, queryClient := s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	ctx := s.ctx.WithBlockHeight(42)
+	params := keeper.GetParams(ctx)
+	params.EnableEvm = false
+	require.NoError(keeper.SetParams(ctx, params))
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	bondCoin := sdk.NewCoin(sdk.DefaultBondDenom, params.MinBondAmount.TruncateInt())
+	msg, err := types.NewMsgCreateValidator(
+		valAddr, PKs[0], bondCoin, types.Description{Moniker: "aged"},
+		types.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()), math.OneInt(),
+	)
+	require.NoError(err)
+
+	_, err = queryClient.ValidatorCreationHeight(gocontext.Background(), &types.QueryValidatorCreationHeightRequest{ValidatorAddr: valAddr.String()})
+	require.Error(err)
+
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(valAddr), types.NotBondedPoolName, sdk.NewCoins(bondCoin)).Return(nil)
+	_, err = s.msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	height, found := keeper.GetValidatorCreationHeight(ctx, valAddr)
+	require.True(found)
+	require.Equal(int64(42), height)
+
+	res, err := queryClient.ValidatorCreationHeight(gocontext.Background(), &types.QueryValidatorCreationHeightRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(err)
+	require.Equal(int64(42), res.Height)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryValidatorPowerHistory() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	params := keeper.GetParams(ctx)
+	params.PowerHistoryEntries = 3
+	require.NoError(keeper.SetParams(ctx, params))
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByPowerIndex(ctx.WithBlockHeight(1), validator)
+
+	// re-indexing without a power change is not recorded
+	keeper.SetValidatorByPowerIndex(ctx.WithBlockHeight(2), validator)
+	require.Equal([]types.PowerHistoryEntry{{Height: 1, Power: 10}}, keeper.GetValidatorPowerHistory(ctx, valAddr).Entries)
+
+	// the oldest entry is dropped once the history is full
+	for height := int64(3); height <= 5; height++ {
+		validator, _ = keeper.AddValidatorTokensAndShares(ctx.WithBlockHeight(height), validator, keeper.TokensFromConsensusPower(ctx, 10))
+	}
+	expected := []types.PowerHistoryEntry{{Height: 3, Power: 20}, {Height: 4, Power: 30}, {Height: 5, Power: 40}}
+	require.Equal(expected, keeper.GetValidatorPowerHistory(ctx, valAddr).Entries)
+
+	res, err := queryClient.ValidatorPowerHistory(gocontext.Background(), &types.QueryValidatorPowerHistoryRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(err)
+	require.Equal(expected, res.Entries)
+
+	res, err = queryClient.ValidatorPowerHistory(gocontext.Background(), &types.QueryValidatorPowerHistoryRequest{ValidatorAddr: valAddr.String(), Limit: 2})
+	require.NoError(err)
+	require.Equal(expected[1:], res.Entries)
+
+	_, err = queryClient.ValidatorPowerHistory(gocontext.Background(), &types.QueryValidatorPowerHistoryRequest{})
+	require.Error(err)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryValidatorsByStatus() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	statuses := []types.BondStatus{types.Bonded, types.Unbonding, types.Unbonded}
+	var unbonding []string
+	for i := 0; i < 9; i++ {
+		valAddr := sdk.ValAddress(PKs[i].Address().Bytes())
+		validator := testutil.NewValidator(s.T(), valAddr, PKs[i])
+		validator.Status = statuses[i%3]
+		keeper.SetValidator(ctx, validator)
+		if validator.IsUnbonding() {
+			unbonding = append(unbonding, validator.OperatorAddress)
+		}
+	}
+
+	res, err := queryClient.ValidatorsByStatus(gocontext.Background(), &types.QueryValidatorsByStatusRequest{
+		Status:     types.Unbonding,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(err)
+	require.Len(res.Validators, 2)
+	require.NotNil(res.Pagination.NextKey)
+	require.Equal(uint64(3), res.Pagination.Total)
+	page := res.Validators
+
+	res, err = queryClient.ValidatorsByStatus(gocontext.Background(), &types.QueryValidatorsByStatusRequest{
+		Status:     types.Unbonding,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(err)
+	require.Len(res.Validators, 1)
+	require.Nil(res.Pagination.NextKey)
+	page = append(page, res.Validators...)
+
+	var addrs []string
+	for _, val := range page {
+		require.Equal(types.Unbonding, val.Status)
+		addrs = append(addrs, val.OperatorAddress)
+	}
+	require.ElementsMatch(unbonding, addrs)
+
+	_, err = queryClient.ValidatorsByStatus(gocontext.Background(), &types.QueryValidatorsByStatusRequest{Status: types.Unspecified})
+	require.Error(err)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryNakamotoCoefficient() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	res, err := queryClient.NakamotoCoefficient(gocontext.Background(), &types.QueryNakamotoCoefficientRequest{})
+	require.NoError(err)
+	require.Zero(res.Coefficient)
+
+	// total power 100: the two largest validators hold 25 + 20 = 45 > 33.3
+	for i, power := range []int64{10, 25, 20, 15, 15, 15} {
+		validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, power))
+		validator = validator.UpdateStatus(types.Bonded)
+		keeper.SetValidator(ctx, validator)
+		keeper.SetValidatorByPowerIndex(ctx, validator)
+	}
+
+	coefficient, totalPower := keeper.GetNakamotoCoefficient(ctx)
+	require.Equal(2, coefficient)
+	require.Equal(int64(100), totalPower)
+
+	res, err = queryClient.NakamotoCoefficient(gocontext.Background(), &types.QueryNakamotoCoefficientRequest{})
+	require.NoError(err)
+	require.Equal(uint32(2), res.Coefficient)
+	require.Equal(int64(100), res.TotalPower)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryEstimateSlash() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByPowerIndex(ctx, validator)
+
+	_, err := queryClient.EstimateSlash(gocontext.Background(), &types.QueryEstimateSlashRequest{
+		ValidatorAddr: valAddr.String(),
+		SlashFraction: sdk.NewDec(2),
+	})
+	require.Error(err)
+
+	fraction := sdk.NewDecWithPrec(333, 3)
+	res, err := queryClient.EstimateSlash(gocontext.Background(), &types.QueryEstimateSlashRequest{
+		ValidatorAddr: valAddr.String(),
+		SlashFraction: fraction,
+	})
+	require.NoError(err)
+
+	// the estimate must not have touched the store
+	stored, found := keeper.GetValidator(ctx, valAddr)
+	require.True(found)
+	require.Equal(validator.Tokens, stored.Tokens)
+
+	// removing the same amount for real yields the estimated validator
+	slashed := keeper.RemoveValidatorTokens(ctx, stored, res.BurnedTokens)
+	require.Equal(fraction.MulInt(validator.Tokens).TruncateInt(), res.BurnedTokens)
+	require.Equal(slashed.Tokens, res.ValidatorTokens)
+	require.Equal(slashed.DelegatorShares, res.DelegatorShares)
+}
+
+func (s *KeeperTestSuite) TestGRPCQuerySlashScenarioJails() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	tokens := keeper.TokensFromConsensusPower(ctx, 10)
+	validator, shares := validator.AddTokensFromDel(tokens)
+	validator.MinSelfDelegation = tokens.MulRaw(8).QuoRaw(10)
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByConsAddr(ctx, validator)
+	keeper.SetDelegation(ctx, types.NewDelegation(sdk.AccAddress(valAddr), valAddr, shares))
+	consAddr := sdk.ConsAddress(PKs[0].Address()).String()
+
+	// the self-delegation stays above the minimum
+	res, err := queryClient.SlashScenarioJails(gocontext.Background(), &types.QuerySlashScenarioJailsRequest{
+		ConsAddr:      consAddr,
+		SlashFraction: sdk.NewDecWithPrec(1, 1),
+	})
+	require.NoError(err)
+	require.False(res.Jailed)
+	require.Equal(tokens.MulRaw(9).QuoRaw(10), res.SelfDelegation)
+	require.Equal(validator.MinSelfDelegation, res.MinSelfDelegation)
+
+	// the self-delegation drops below the minimum
+	res, err = queryClient.SlashScenarioJails(gocontext.Background(), &types.QuerySlashScenarioJailsRequest{
+		ConsAddr:      consAddr,
+		SlashFraction: sdk.NewDecWithPrec(3, 1),
+	})
+	require.NoError(err)
+	require.True(res.Jailed)
+	require.Equal(tokens.MulRaw(7).QuoRaw(10), res.SelfDelegation)
+
+	// the prediction does not touch the store
+	stored, found := keeper.GetValidator(ctx, valAddr)
+	require.True(found)
+	require.Equal(tokens, stored.Tokens)
+	require.False(stored.Jailed)
+
+	_, err = queryClient.SlashScenarioJails(gocontext.Background(), &types.QuerySlashScenarioJailsRequest{
+		ConsAddr:      sdk.ConsAddress(PKs[1].Address()).String(),
+		SlashFraction: sdk.NewDecWithPrec(1, 1),
+	})
+	require.Error(err)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryZeroPowerBondedValidators() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	for i, tokens := range []int64{10, 0} {
+		validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, tokens))
+		validator.Status = types.Bonded
+		keeper.SetValidator(ctx, validator)
+	}
+	// unbonded validators without power are expected
+	keeper.SetValidator(ctx, testutil.NewValidator(s.T(), sdk.ValAddress(PKs[2].Address().Bytes()), PKs[2]))
+
+	res, err := queryClient.ZeroPowerBondedValidators(gocontext.Background(), &types.QueryZeroPowerBondedValidatorsRequest{})
+	require.NoError(err)
+	require.Len(res.Validators, 1)
+	require.Equal(sdk.ValAddress(PKs[1].Address().Bytes()).String(), res.Validators[0].OperatorAddress)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryValidatorEvmOrigin() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	params := keeper.GetParams(ctx)
+	params.EnableEvm = false
+	require.NoError(keeper.SetParams(ctx, params))
+
+	bondCoin := sdk.NewCoin(sdk.DefaultBondDenom, params.MinBondAmount.TruncateInt())
+	var valAddrs []sdk.ValAddress
+	var msgs []*types.MsgCreateValidator
+	for i := 0; i < 2; i++ {
+		valAddr := sdk.ValAddress(PKs[i].Address().Bytes())
+		msg, err := types.NewMsgCreateValidator(
+			valAddr, PKs[i], bondCoin, types.Description{Moniker: "validator"},
+			types.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()), math.OneInt(),
+		)
+		require.NoError(err)
+		valAddrs = append(valAddrs, valAddr)
+		msgs = append(msgs, msg)
+	}
+
+	// the first validator is created natively
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(valAddrs[0]), types.NotBondedPoolName, sdk.NewCoins(bondCoin)).Return(nil)
+	_, err := s.msgServer.CreateValidator(ctx, msgs[0])
+	require.NoError(err)
+
+	// the second one through the EVM
+	keeper.SetCreateValidatorMsgByValAddr(ctx, valAddrs[1], msgs[1])
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), types.NotBondedPoolName, sdk.AccAddress(valAddrs[1]), sdk.NewCoins(bondCoin)).Return(nil)
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(valAddrs[1]), types.NotBondedPoolName, sdk.NewCoins(bondCoin)).Return(nil)
+	_, err = keeper.CreateEvmValidator(ctx, valAddrs[1])
+	require.NoError(err)
+
+	for i, expected := range []bool{false, true} {
+		require.Equal(expected, keeper.IsEvmValidator(ctx, valAddrs[i]))
+		res, err := queryClient.ValidatorEvmOrigin(gocontext.Background(), &types.QueryValidatorEvmOriginRequest{ValidatorAddr: valAddrs[i].String()})
+		require.NoError(err)
+		require.Equal(expected, res.IsEvm)
+	}
+
+	_, err = queryClient.ValidatorEvmOrigin(gocontext.Background(), &types.QueryValidatorEvmOriginRequest{ValidatorAddr: sdk.ValAddress(PKs[2].Address().Bytes()).String()})
+	require.Error(err)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryUnbondingValidatorQueueDepth() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	res, err := queryClient.UnbondingValidatorQueueDepth(gocontext.Background(), &types.QueryUnbondingValidatorQueueDepthRequest{})
+	require.NoError(err)
+	require.Zero(res.Slices)
+	require.Zero(res.Total)
+
+	// two validators share the first time slice
+	endTime := time.Unix(1000, 0).UTC()
+	for i, unbondingTime := range []time.Time{endTime, endTime, endTime.Add(time.Hour)} {
+		validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		validator.UnbondingTime = unbondingTime
+		validator.UnbondingHeight = 10
+		keeper.InsertUnbondingValidatorQueue(ctx, validator)
+	}
+
+	res, err = queryClient.UnbondingValidatorQueueDepth(gocontext.Background(), &types.QueryUnbondingValidatorQueueDepthRequest{})
+	require.NoError(err)
+	require.Equal(uint64(2), res.Slices)
+	require.Equal(uint64(3), res.Total)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryProspectiveRewardShare() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+	validator.Commission = types.NewCommission(math.LegacyNewDecWithPrec(1, 1), math.LegacyNewDecWithPrec(2, 1), math.LegacyZeroDec())
+	keeper.SetValidator(ctx, validator)
+
+	// a delegation matching the current shares earns half of the rewards left
+	// after the 10% commission
+	res, err := queryClient.ProspectiveRewardShare(gocontext.Background(), &types.QueryProspectiveRewardShareRequest{
+		ValidatorAddr: valAddr.String(),
+		Amount:        validator.DelegatorShares.TruncateInt(),
+	})
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecWithPrec(45, 2), res.Share)
+
+	// the validator is not modified
+	stored, found := keeper.GetValidator(ctx, valAddr)
+	require.True(found)
+	require.Equal(validator, stored)
+
+	_, err = queryClient.ProspectiveRewardShare(gocontext.Background(), &types.QueryProspectiveRewardShareRequest{
+		ValidatorAddr: valAddr.String(),
+		Amount:        math.ZeroInt(),
+	})
+	require.Error(err)
+
+	_, err = queryClient.ProspectiveRewardShare(gocontext.Background(), &types.QueryProspectiveRewardShareRequest{
+		ValidatorAddr: sdk.ValAddress(PKs[1].Address().Bytes()).String(),
+		Amount:        math.OneInt(),
+	})
+	require.Error(err)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryValidatorConsPubKey() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	keeper.SetValidator(ctx, testutil.NewValidator(s.T(), valAddr, PKs[0]))
+
+	_, err := queryClient.ValidatorConsPubKey(gocontext.Background(), &types.QueryValidatorConsPubKeyRequest{})
+	require.Error(err)
+
+	_, err = queryClient.ValidatorConsPubKey(gocontext.Background(), &types.QueryValidatorConsPubKeyRequest{ValidatorAddr: sdk.ValAddress(PKs[1].Address().Bytes()).String()})
+	require.Error(err)
+
+	res, err := queryClient.ValidatorConsPubKey(gocontext.Background(), &types.QueryValidatorConsPubKeyRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(err)
+
+	pk, err := legacybech32.UnmarshalPubKey(legacybech32.ConsPK, res.Bech32) //nolint:staticcheck // the query returns a legacy bech32 key
+	require.NoError(err)
+	require.True(PKs[0].Equals(pk))
+
+	anyPk, ok := res.Pubkey.GetCachedValue().(cryptotypes.PubKey)
+	require.True(ok)
+	require.True(PKs[0].Equals(anyPk))
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryValidatorUnbonding() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	unbondingTime := time.Unix(1000, 0).UTC()
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator.Status = types.Unbonding
+	validator.UnbondingTime = unbondingTime
+	validator.UnbondingHeight = 10
+	validator.UnbondingIds = []uint64{1}
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByUnbondingID(ctx, validator, 1)
+	require.NoError(keeper.PutUnbondingOnHold(ctx, 1))
+
+	_, err := queryClient.ValidatorUnbonding(gocontext.Background(), &types.QueryValidatorUnbondingRequest{})
+	require.Error(err)
+
+	_, err = queryClient.ValidatorUnbonding(gocontext.Background(), &types.QueryValidatorUnbondingRequest{ValidatorAddr: sdk.ValAddress(PKs[1].Address().Bytes()).String()})
+	require.Error(err)
+
+	res, err := queryClient.ValidatorUnbonding(gocontext.Background(), &types.QueryValidatorUnbondingRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(err)
+	require.Equal(unbondingTime, res.UnbondingTime)
+	require.Equal(int64(10), res.UnbondingHeight)
+	require.Equal([]uint64{1}, res.UnbondingIds)
+	require.Equal(int64(1), res.UnbondingOnHoldRefCount)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryPendingEvmValidators() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	res, err := queryClient.PendingEvmValidators(gocontext.Background(), &types.QueryPendingEvmValidatorsRequest{})
+	require.NoError(err)
+	require.Empty(res.Validators)
+
+	bondCoin := sdk.NewCoin(sdk.DefaultBondDenom, keeper.TokensFromConsensusPower(ctx, 10))
+	var expected []types.PendingEvmValidator
+	for i := 0; i < 2; i++ {
+		valAddr := sdk.ValAddress(PKs[i].Address().Bytes())
+		msg, err := types.NewMsgCreateValidator(
+			valAddr, PKs[i], bondCoin, types.Description{Moniker: "evm"},
+			types.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()), math.OneInt(),
+		)
+		require.NoError(err)
+		keeper.SetCreateValidatorMsgByValAddr(ctx, valAddr, msg)
+		expected = append(expected, types.PendingEvmValidator{
+			ValidatorAddress: msg.ValidatorAddress,
+			DelegatorAddress: msg.DelegatorAddress,
+			Value:            bondCoin,
+		})
+	}
+
+	res, err = queryClient.PendingEvmValidators(gocontext.Background(), &types.QueryPendingEvmValidatorsRequest{})
+	require.NoError(err)
+	require.ElementsMatch(expected, res.Validators)
+
+	// created validators are no longer listed
+	keeper.DeleteCreateValidatorMsgByValAddr(ctx, sdk.ValAddress(PKs[0].Address().Bytes()))
+	res, err = queryClient.PendingEvmValidators(gocontext.Background(), &types.QueryPendingEvmValidatorsRequest{})
+	require.NoError(err)
+	require.Equal(expected[1:], res.Validators)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryValidatorExchangeRate() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	keeper.SetValidator(ctx, validator)
+
+	_, err := queryClient.ValidatorExchangeRate(gocontext.Background(), &types.QueryValidatorExchangeRateRequest{})
+	require.Error(err)
+
+	_, err = queryClient.ValidatorExchangeRate(gocontext.Background(), &types.QueryValidatorExchangeRateRequest{ValidatorAddr: sdk.ValAddress(PKs[1].Address().Bytes()).String()})
+	require.Error(err)
+
+	// validators without shares issue them one to one
+	res, err := queryClient.ValidatorExchangeRate(gocontext.Background(), &types.QueryValidatorExchangeRateRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(err)
+	require.Equal(math.LegacyOneDec(), res.Rate)
+
+	// slashing a quarter of the tokens dilutes each share
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 100))
+	keeper.SetValidator(ctx, validator)
+	keeper.RemoveValidatorTokens(ctx, validator, keeper.TokensFromConsensusPower(ctx, 25))
+
+	res, err = queryClient.ValidatorExchangeRate(gocontext.Background(), &types.QueryValidatorExchangeRateRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(err)
+	require.True(res.Rate.LT(math.LegacyOneDec()))
+	require.Equal(math.LegacyNewDecWithPrec(75, 2), res.Rate)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryValidatorsWithCommissionBelow() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	// inserted out of order to exercise the sort
+	for i, rate := range []int64{10, 2, 5} {
+		validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		validator.Commission = types.NewCommission(math.LegacyNewDecWithPrec(rate, 2), math.LegacyOneDec(), math.LegacyZeroDec())
+		validator.Status = types.Bonded
+		keeper.SetValidator(ctx, validator)
+	}
+	// unbonded validators are not returned
+	unbonded := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[3].Address().Bytes()), PKs[3])
+	unbonded.Commission = types.NewCommission(math.LegacyZeroDec(), math.LegacyOneDec(), math.LegacyZeroDec())
+	keeper.SetValidator(ctx, unbonded)
+
+	_, err := queryClient.ValidatorsWithCommissionBelow(gocontext.Background(), &types.QueryValidatorsWithCommissionBelowRequest{Rate: math.LegacyNewDec(-1)})
+	require.Error(err)
+
+	res, err := queryClient.ValidatorsWithCommissionBelow(gocontext.Background(), &types.QueryValidatorsWithCommissionBelowRequest{Rate: math.LegacyNewDecWithPrec(6, 2)})
+	require.NoError(err)
+	require.Len(res.Validators, 2)
+	require.Equal(sdk.ValAddress(PKs[1].Address().Bytes()).String(), res.Validators[0].OperatorAddress)
+	require.Equal(sdk.ValAddress(PKs[2].Address().Bytes()).String(), res.Validators[1].OperatorAddress)
+
+	res, err = queryClient.ValidatorsWithCommissionBelow(gocontext.Background(), &types.QueryValidatorsWithCommissionBelowRequest{Rate: math.LegacyNewDecWithPrec(6, 2), Limit: 1})
+	require.NoError(err)
+	require.Len(res.Validators, 1)
+	require.Equal(sdk.ValAddress(PKs[1].Address().Bytes()).String(), res.Validators[0].OperatorAddress)
+}
+
+// validatorsStream is an in-process Query_ValidatorsStreamServer recording the
+// streamed validators.
+type validatorsStream struct {
+	types.Query_ValidatorsStreamServer
+	ctx        gocontext.Context
+	validators []types.Validator
+}
+
+func (s *validatorsStream) Context() gocontext.Context { return s.ctx }
+
+func (s *validatorsStream) Send(res *types.QueryValidatorsStreamResponse) error {
+	s.validators = append(s.validators, res.Validator)
+	return nil
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryValidatorsStream() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+	querier := stakingkeeper.Querier{Keeper: keeper}
+
+	for i := 0; i < 3; i++ {
+		validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		if i == 1 {
+			validator.Status = types.Bonded
+		}
+		keeper.SetValidator(ctx, validator)
+	}
+	expected := keeper.GetAllValidators(ctx)
+
+	stream := &validatorsStream{ctx: ctx}
+	require.Error(querier.ValidatorsStream(nil, stream))
+	require.Error(querier.ValidatorsStream(&types.QueryValidatorsStreamRequest{Status: "invalid"}, stream))
+
+	// all validators are received in store order
+	require.NoError(querier.ValidatorsStream(&types.QueryValidatorsStreamRequest{}, stream))
+	require.Equal(expected, stream.validators)
+
+	stream = &validatorsStream{ctx: ctx}
+	require.NoError(querier.ValidatorsStream(&types.QueryValidatorsStreamRequest{Status: types.Bonded.String()}, stream))
+	require.Len(stream.validators, 1)
+	require.Equal(sdk.ValAddress(PKs[1].Address().Bytes()).String(), stream.validators[0].OperatorAddress)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryValidatorJailHistory() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	params := keeper.GetParams(ctx)
+	params.JailHistoryEntries = 5
+	require.NoError(keeper.SetParams(ctx, params))
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByConsAddr(ctx, validator)
+	keeper.SetValidatorByPowerIndex(ctx, validator)
+
+	_, err := queryClient.ValidatorJailHistory(gocontext.Background(), &types.QueryValidatorJailHistoryRequest{})
+	require.Error(err)
+
+	// jail and unjail the validator twice
+	ctx = ctx.WithBlockHeight(3)
+	require.NoError(keeper.JailValidator(ctx, valAddr))
+	require.NoError(keeper.UnjailValidator(ctx, valAddr))
+	ctx = ctx.WithBlockHeight(7)
+	keeper.Jail(ctx, sdk.GetConsAddress(PKs[0]))
+	keeper.Unjail(ctx, sdk.GetConsAddress(PKs[0]))
+
+	res, err := queryClient.ValidatorJailHistory(gocontext.Background(), &types.QueryValidatorJailHistoryRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(err)
+	require.Equal([]types.JailHistoryEntry{
+		{Height: 3, Reason: types.JailReasonJailValidator},
+		{Height: 7, Reason: types.JailReasonInfraction},
+	}, res.Entries)
+
+	// the history is bounded by the param
+	params.JailHistoryEntries = 1
+	require.NoError(keeper.SetParams(ctx, params))
+	ctx = ctx.WithBlockHeight(9)
+	require.NoError(keeper.JailValidator(ctx, valAddr))
+	require.Equal([]types.JailHistoryEntry{{Height: 9, Reason: types.JailReasonJailValidator}}, keeper.GetValidatorJailHistory(ctx, valAddr).Entries)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryTotalDelegatorShares() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	res, err := queryClient.TotalDelegatorShares(gocontext.Background(), &types.QueryTotalDelegatorSharesRequest{})
+	require.NoError(err)
+	require.True(res.Shares.IsZero())
+
+	for i, tokens := range []int64{10, 25} {
+		validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, tokens))
+		keeper.SetValidator(ctx, validator)
+	}
+
+	res, err = queryClient.TotalDelegatorShares(gocontext.Background(), &types.QueryTotalDelegatorSharesRequest{})
+	require.NoError(err)
+	require.Equal(math.LegacyNewDecFromInt(keeper.TokensFromConsensusPower(ctx, 35)), res.Shares)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryValidatorsUnbondingSoon() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	// the validators complete their unbonding one, two and three hours from now
+	var valAddrs []sdk.ValAddress
+	for i := 0; i < 3; i++ {
+		valAddr := sdk.ValAddress(PKs[i].Address().Bytes())
+		validator := testutil.NewValidator(s.T(), valAddr, PKs[i])
+		validator.Status = types.Unbonding
+		validator.UnbondingHeight = ctx.BlockHeight() + int64(i+1)*10
+		validator.UnbondingTime = ctx.BlockTime().Add(time.Duration(i+1) * time.Hour)
+		keeper.SetValidator(ctx, validator)
+		keeper.InsertUnbondingValidatorQueue(ctx, validator)
+		valAddrs = append(valAddrs, valAddr)
+	}
+
+	_, err := queryClient.ValidatorsUnbondingSoon(gocontext.Background(), &types.QueryValidatorsUnbondingSoonRequest{Within: -time.Hour})
+	require.Error(err)
+
+	res, err := queryClient.ValidatorsUnbondingSoon(gocontext.Background(), &types.QueryValidatorsUnbondingSoonRequest{Within: 30 * time.Minute})
+	require.NoError(err)
+	require.Empty(res.Validators)
+
+	// the window bound is inclusive
+	res, err = queryClient.ValidatorsUnbondingSoon(gocontext.Background(), &types.QueryValidatorsUnbondingSoonRequest{Within: 2 * time.Hour})
+	require.NoError(err)
+	require.Len(res.Validators, 2)
+	for i, pending := range res.Validators {
+		require.Equal(valAddrs[i].String(), pending.Validator.OperatorAddress)
+		require.True(ctx.BlockTime().Add(time.Duration(i+1) * time.Hour).Equal(pending.CompletionTime))
+		require.Equal(ctx.BlockHeight()+int64(i+1)*10, pending.CompletionHeight)
+	}
+
+	res, err = queryClient.ValidatorsUnbondingSoon(gocontext.Background(), &types.QueryValidatorsUnbondingSoonRequest{Within: 24 * time.Hour})
+	require.NoError(err)
+	require.Len(res.Validators, 3)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryValidatorVotingPowerPercentage() {
+	ctx, keeper, queryClient := s.ctx, s.stakingKeeper, s.queryClient
+	require := s.Require()
+
+	// the unbonded validator does not count towards the total bonded power
+	var valAddrs []sdk.ValAddress
+	for i, power := range []int64{10, 30, 60, 50} {
+		valAddr := sdk.ValAddress(PKs[i].Address().Bytes())
+		validator := testutil.NewValidator(s.T(), valAddr, PKs[i])
+		validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, power))
+		if i < 3 {
+			validator = validator.UpdateStatus(types.Bonded)
+		}
+		keeper.SetValidator(ctx, validator)
+		valAddrs = append(valAddrs, valAddr)
+	}
+	require.Equal(int64(100), keeper.TotalBondedPower(ctx))
+
+	for i, expected := range []string{"0.1", "0.3", "0.6", "0"} {
+		res, err := queryClient.ValidatorVotingPowerPercentage(gocontext.Background(), &types.QueryValidatorVotingPowerPercentageRequest{ValidatorAddr: valAddrs[i].String()})
+		require.NoError(err)
+		require.Equal(math.LegacyMustNewDecFromStr(expected), res.Percentage)
+	}
+
+	_, err := queryClient.ValidatorVotingPowerPercentage(gocontext.Background(), &types.QueryValidatorVotingPowerPercentageRequest{})
+	require.Error(err)
+
+	_, err = queryClient.ValidatorVotingPowerPercentage(gocontext.Background(), &types.QueryValidatorVotingPowerPercentageRequest{ValidatorAddr: sdk.ValAddress(PKs[4].Address()).String()})
+	require.Error(err)
+}
@@ -19,6 +19,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
 		PositiveDelegationInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "delegator-shares",
 		DelegatorSharesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "validator-tokens",
+		ValidatorTokensInvariant(k))
 }
 
 // AllInvariants runs all invariants of the staking module.
@@ -39,7 +41,12 @@ func AllInvariants(k *Keeper) sdk.Invariant {
 			return res, stop
 		}
 
-		return DelegatorSharesInvariant(k)(ctx)
+		res, stop = DelegatorSharesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return ValidatorTokensInvariant(k)(ctx)
 	}
 }
 
@@ -195,3 +202,27 @@ func DelegatorSharesInvariant(k *Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "delegator shares", msg), broken
 	}
 }
+
+// ValidatorTokensInvariant checks that the delegation shares of each validator,
+// converted to tokens at its exchange rate, add up to the validator tokens.
+func ValidatorTokensInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, validator := range k.GetAllValidators(ctx) {
+			expectedTokens, actualTokens, ok := k.ReconcileValidatorShares(ctx, validator.GetOperator())
+			if !ok {
+				broken = true
+				msg += fmt.Sprintf("broken validator tokens invariance:\n"+
+					"\tvalidator: %s\n"+
+					"\tvalidator.Tokens: %v\n"+
+					"\ttokens of Delegator.Shares: %v\n", validator.GetOperator(), actualTokens, expectedTokens)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "validator tokens", msg), broken
+	}
+}
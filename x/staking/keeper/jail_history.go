@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetValidatorJailHistory returns the latest jailings of a validator, oldest
+// first.
+func (k Keeper) GetValidatorJailHistory(ctx sdk.Context, addr sdk.ValAddress) types.ValidatorJailHistory {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorJailHistoryKey(addr))
+	if bz == nil {
+		return types.ValidatorJailHistory{}
+	}
+
+	var history types.ValidatorJailHistory
+	k.cdc.MustUnmarshal(bz, &history)
+
+	return history
+}
+
+// SetValidatorJailHistory sets the jail history of a validator
+func (k Keeper) SetValidatorJailHistory(ctx sdk.Context, addr sdk.ValAddress, history types.ValidatorJailHistory) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorJailHistoryKey(addr), k.cdc.MustMarshal(&history))
+}
+
+// DeleteValidatorJailHistory deletes the jail history of a validator
+func (k Keeper) DeleteValidatorJailHistory(ctx sdk.Context, addr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorJailHistoryKey(addr))
+}
+
+// recordValidatorJail appends a jailing of the validator to its jail history,
+// dropping the oldest entries beyond the JailHistoryEntries param.
+func (k Keeper) recordValidatorJail(ctx sdk.Context, addr sdk.ValAddress, reason string) {
+	maxEntries := k.GetParams(ctx).JailHistoryEntries
+	if maxEntries == 0 {
+		return
+	}
+
+	history := k.GetValidatorJailHistory(ctx, addr)
+	history.Entries = append(history.Entries, types.JailHistoryEntry{Height: ctx.BlockHeight(), Reason: reason})
+	if n := uint32(len(history.Entries)); n > maxEntries {
+		history.Entries = history.Entries[n-maxEntries:]
+	}
+
+	k.SetValidatorJailHistory(ctx, addr, history)
+}
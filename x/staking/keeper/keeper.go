@@ -23,18 +23,29 @@ var _ types.DelegationSet = Keeper{}
 // Keeper of the x/staking store
 type Keeper struct {
 	storeKey    storetypes.StoreKey
+	tStoreKey   storetypes.StoreKey
 	cdc         codec.BinaryCodec
 	authKeeper  types.AccountKeeper
 	bankKeeper  types.BankKeeper
 	hooks       types.StakingHooks
 	authority   string
 	govCallback sdk.GovEventCallback
+
+	hookErrorPolicy types.HookErrorPolicy
+	pubKeyTypes     map[types.ValidatorCreationPath][]string
+	instantBond     map[types.ValidatorCreationPath]bool
+
+	strictMode            bool
+	lenientValidatorQueue bool
+	allowBondDenomChange  bool
+	powerIndexEvents      bool
 }
 
 // NewKeeper creates a new staking Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	key storetypes.StoreKey,
+	tkey storetypes.StoreKey,
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
 	authority string,
@@ -54,12 +65,16 @@ func NewKeeper(
 	}
 
 	return &Keeper{
-		storeKey:   key,
-		cdc:        cdc,
-		authKeeper: ak,
-		bankKeeper: bk,
-		hooks:      nil,
-		authority:  authority,
+		storeKey:    key,
+		tStoreKey:   tkey,
+		cdc:         cdc,
+		authKeeper:  ak,
+		bankKeeper:  bk,
+		hooks:       nil,
+		authority:   authority,
+		pubKeyTypes: make(map[types.ValidatorCreationPath][]string),
+		instantBond: make(map[types.ValidatorCreationPath]bool),
+		strictMode:  true,
 	}
 }
 
@@ -88,6 +103,157 @@ func (k *Keeper) SetHooks(sh types.StakingHooks) {
 	k.hooks = sh
 }
 
+// SetHookErrorPolicy sets how errors returned by the staking hooks are handled.
+// The keeper uses HookErrorPolicyDefault unless configured otherwise.
+func (k *Keeper) SetHookErrorPolicy(policy types.HookErrorPolicy) {
+	k.hookErrorPolicy = policy
+}
+
+// HookErrorPolicy returns how errors returned by the staking hooks are handled.
+func (k Keeper) HookErrorPolicy() types.HookErrorPolicy {
+	return k.hookErrorPolicy
+}
+
+// handleHookError applies the hook error policy to an error returned by the
+// named hook. It returns the error if it must be propagated, nil otherwise.
+func (k Keeper) handleHookError(ctx sdk.Context, hook string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if k.hookErrorPolicy == types.HookErrorPolicyLogAndContinue {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to call %s hook", hook), "error", err)
+		return nil
+	}
+
+	return err
+}
+
+// handleLoggedHookError applies the hook error policy to an error returned by
+// a hook whose errors were historically only logged. The error is returned
+// only if the policy is HookErrorPolicyPropagate, nil otherwise.
+func (k Keeper) handleLoggedHookError(ctx sdk.Context, hook string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if k.hookErrorPolicy != types.HookErrorPolicyPropagate {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to call %s hook", hook), "error", err)
+		return nil
+	}
+
+	return err
+}
+
+// SetStrictMode sets whether inconsistencies found in the validator read and
+// maintenance paths, such as missing validators in the validator indexes or in
+// the unbonding queue, cause a panic. When disabled they are logged and the
+// affected entries skipped instead, so that chains preferring to halt
+// gracefully keep producing blocks. The keeper is strict by default.
+func (k *Keeper) SetStrictMode(strict bool) {
+	k.strictMode = strict
+}
+
+// StrictMode returns whether inconsistencies found in the validator read and
+// maintenance paths cause a panic.
+func (k Keeper) StrictMode() bool {
+	return k.strictMode
+}
+
+// handleRecoverableError panics with err in strict mode and logs it otherwise.
+func (k Keeper) handleRecoverableError(ctx sdk.Context, msg string, err error) {
+	if k.strictMode {
+		panic(err)
+	}
+
+	k.Logger(ctx).Error(msg, "error", err)
+}
+
+// SetLenientValidatorQueue sets whether malformed addresses and addresses of
+// missing validators found in the validator queue are logged and dropped
+// instead of causing a panic. The keeper is strict by default.
+func (k *Keeper) SetLenientValidatorQueue(lenient bool) {
+	k.lenientValidatorQueue = lenient
+}
+
+// LenientValidatorQueue returns whether malformed addresses and addresses of
+// missing validators found in the validator queue are logged and dropped
+// instead of causing a panic.
+func (k Keeper) LenientValidatorQueue() bool {
+	return k.lenientValidatorQueue
+}
+
+// lenientQueue returns whether the validator queue inconsistencies are logged
+// and dropped, either because the queue is lenient or the keeper is not strict.
+func (k Keeper) lenientQueue() bool {
+	return k.lenientValidatorQueue || !k.strictMode
+}
+
+// SetAllowBondDenomChange sets whether the bond denom can be changed while
+// validators exist. It is meant for migrations that also move the validator
+// tokens to the new denom, changes are rejected by default.
+func (k *Keeper) SetAllowBondDenomChange(allow bool) {
+	k.allowBondDenomChange = allow
+}
+
+// AllowBondDenomChange returns whether the bond denom can be changed while
+// validators exist.
+func (k Keeper) AllowBondDenomChange() bool {
+	return k.allowBondDenomChange
+}
+
+// SetPowerIndexEvents sets whether an event is emitted for every insertion
+// into and deletion from the validators power index. The events are meant for
+// debugging power index churn and are disabled by default.
+func (k *Keeper) SetPowerIndexEvents(enabled bool) {
+	k.powerIndexEvents = enabled
+}
+
+// PowerIndexEvents returns whether the power index insertions and deletions
+// emit events.
+func (k Keeper) PowerIndexEvents() bool {
+	return k.powerIndexEvents
+}
+
+// SetAllowedPubKeyTypes restricts the consensus pubkey types accepted for
+// validators created through the given path. Key types must still be allowed
+// by the consensus params. Passing no key types removes the restriction.
+func (k *Keeper) SetAllowedPubKeyTypes(path types.ValidatorCreationPath, keyTypes ...string) {
+	if len(keyTypes) == 0 {
+		delete(k.pubKeyTypes, path)
+		return
+	}
+
+	k.pubKeyTypes[path] = keyTypes
+}
+
+// AllowedPubKeyTypes returns the consensus pubkey types accepted for validators
+// created through the given path, or nil if the path is not restricted.
+func (k Keeper) AllowedPubKeyTypes(path types.ValidatorCreationPath) []string {
+	return k.pubKeyTypes[path]
+}
+
+// SetInstantBond sets whether validators created through the given path are
+// bonded right away, their self-delegation going straight to the bonded pool,
+// instead of being bonded by the next validator set update. Validators that
+// do not fit in the active set, or whose self-delegation carries no consensus
+// power, are still created unbonded. Instantly bonded validators pushed out of
+// the active set later in the block are unbonded by the validator set update.
+func (k *Keeper) SetInstantBond(path types.ValidatorCreationPath, enabled bool) {
+	if !enabled {
+		delete(k.instantBond, path)
+		return
+	}
+
+	k.instantBond[path] = true
+}
+
+// InstantBond returns whether validators created through the given path are
+// bonded right away.
+func (k Keeper) InstantBond(path types.ValidatorCreationPath) bool {
+	return k.instantBond[path]
+}
+
 // GetLastTotalPower Load the last total validator power.
 func (k Keeper) GetLastTotalPower(ctx sdk.Context) math.Int {
 	store := ctx.KVStore(k.storeKey)
@@ -41,7 +41,8 @@ type KeeperTestSuite struct {
 
 func (s *KeeperTestSuite) SetupTest() {
 	key := sdk.NewKVStoreKey(stakingtypes.StoreKey)
-	testCtx := testutil.DefaultContextWithDB(s.T(), key, sdk.NewTransientStoreKey("transient_test"))
+	tkey := sdk.NewTransientStoreKey(stakingtypes.TStoreKey)
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, tkey)
 	ctx := testCtx.Ctx.WithBlockHeader(tmproto.Header{Time: tmtime.Now()})
 	encCfg := moduletestutil.MakeTestEncodingConfig()
 
@@ -54,6 +55,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	keeper := stakingkeeper.NewKeeper(
 		encCfg.Codec,
 		key,
+		tkey,
 		accountKeeper,
 		bankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -82,6 +84,17 @@ func (s *KeeperTestSuite) TestParams() {
 	keeper.SetParams(ctx, expParams)
 	resParams := keeper.GetParams(ctx)
 	require.True(expParams.Equal(resParams))
+
+	// an unset maximum commission rate is kept unset through the store
+	require.Nil(resParams.MaxCommissionRate)
+	require.True(keeper.MaxCommissionRate(ctx).Equal(math.LegacyOneDec()))
+
+	maxRate := math.LegacyNewDecWithPrec(2, 1)
+	expParams.MaxCommissionRate = &maxRate
+	require.NoError(keeper.SetParams(ctx, expParams))
+	resParams = keeper.GetParams(ctx)
+	require.True(expParams.Equal(resParams))
+	require.True(keeper.MaxCommissionRate(ctx).Equal(maxRate))
 }
 
 func (s *KeeperTestSuite) TestLastTotalPower() {
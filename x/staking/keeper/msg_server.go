@@ -48,7 +48,7 @@ func (k msgServer) CreateValidator(goCtx context.Context, msg *types.MsgCreateVa
 	if params.EnableEvm && ctx.BlockHeight() > 0 {
 		return k.CreateEvmStaking(ctx, msg)
 	}
-	return k.createNativeValidator(ctx, msg)
+	return k.createNativeValidator(ctx, msg, types.ValidatorCreationPathNative)
 }
 
 // EditValidator defines a method for editing an existing validator
@@ -84,7 +84,7 @@ func (k msgServer) EditValidator(goCtx context.Context, msg *types.MsgEditValida
 		}
 
 		// call the before-modification hook since we're about to update the commission
-		if err := k.Hooks().BeforeValidatorModified(ctx, valAddr); err != nil {
+		if err := k.handleHookError(ctx, "before validator modified", k.Hooks().BeforeValidatorModified(ctx, valAddr)); err != nil {
 			return nil, err
 		}
 
@@ -168,6 +168,7 @@ func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*typ
 			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
 			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
 			sdk.NewAttribute(types.AttributeKeyNewShares, newShares.String()),
+			sdk.NewAttribute(types.AttributeKeyValidatorPower, strconv.FormatInt(k.validatorConsensusPower(ctx, valAddr), 10)),
 		),
 	})
 
@@ -292,6 +293,7 @@ func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (
 			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
 			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
 			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+			sdk.NewAttribute(types.AttributeKeyValidatorPower, strconv.FormatInt(k.validatorConsensusPower(ctx, addr), 10)),
 		),
 	})
 
@@ -420,6 +422,10 @@ func (ms msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdatePara
 		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
 	}
 
+	if err := ms.ValidateBondDenomChange(ctx, msg.Params.BondDenom); err != nil {
+		return nil, err
+	}
+
 	// store params
 	if err := ms.SetParams(ctx, msg.Params); err != nil {
 		return nil, err
@@ -4,6 +4,10 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	"github.com/golang/mock/gomock"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -129,3 +133,74 @@ func (s *KeeperTestSuite) TestMsgUpdateParams() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgDelegateEmitsValidatorPower() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	params := keeper.GetParams(ctx)
+	params.EnableEvm = false
+	require.NoError(keeper.SetParams(ctx, params))
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+	validator.Status = stakingtypes.Bonded
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByPowerIndex(ctx, validator)
+
+	delAddr := sdk.AccAddress(PKs[1].Address())
+	amount := sdk.NewCoin(sdk.DefaultBondDenom, keeper.TokensFromConsensusPower(ctx, 5))
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), delAddr, stakingtypes.BondedPoolName, sdk.NewCoins(amount)).Return(nil)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err := s.msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(delAddr, valAddr, amount))
+	require.NoError(err)
+
+	var power []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != stakingtypes.EventTypeDelegate {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == stakingtypes.AttributeKeyValidatorPower {
+				power = append(power, attr.Value)
+			}
+		}
+	}
+	require.Equal([]string{"15"}, power)
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateParamsBondDenomChange() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	params := keeper.GetParams(ctx)
+	params.EnableEvm = false
+	require.NoError(keeper.SetParams(ctx, params))
+
+	params.BondDenom = "newdenom"
+	msg := &stakingtypes.MsgUpdateParams{Authority: keeper.GetAuthority(), Params: params}
+
+	// without validators the denom can change
+	_, err := s.msgServer.UpdateParams(ctx, msg)
+	require.NoError(err)
+	require.Equal("newdenom", keeper.BondDenom(ctx))
+
+	keeper.SetValidator(ctx, testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0]))
+
+	// validators hold tokens of the current denom
+	params.BondDenom = sdk.DefaultBondDenom
+	msg.Params = params
+	_, err = s.msgServer.UpdateParams(ctx, msg)
+	require.ErrorIs(err, stakingtypes.ErrBondDenomChange)
+	require.Equal("newdenom", keeper.BondDenom(ctx))
+
+	// migrations can allow the change
+	keeper.SetAllowBondDenomChange(true)
+	require.True(keeper.AllowBondDenomChange())
+	_, err = s.msgServer.UpdateParams(ctx, msg)
+	require.NoError(err)
+	require.Equal(sdk.DefaultBondDenom, keeper.BondDenom(ctx))
+	keeper.SetAllowBondDenomChange(false)
+}
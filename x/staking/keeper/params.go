@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -49,6 +50,43 @@ func (k Keeper) MinCommissionRate(ctx sdk.Context) math.LegacyDec {
 	return k.GetParams(ctx).MinCommissionRate
 }
 
+// MaxCommissionRate - Maximum validator commission rate, 100% when unset
+func (k Keeper) MaxCommissionRate(ctx sdk.Context) math.LegacyDec {
+	maxRate := k.GetParams(ctx).MaxCommissionRate
+	if maxRate == nil || maxRate.IsNil() {
+		return math.LegacyOneDec()
+	}
+
+	return *maxRate
+}
+
+// CommissionChangeInterval - Minimum time between two commission rate changes
+func (k Keeper) CommissionChangeInterval(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).CommissionChangeInterval
+}
+
+// UnbondingMaturityMode - Whether unbonding validators mature on their
+// unbonding height and time, or on only one of them
+func (k Keeper) UnbondingMaturityMode(ctx sdk.Context) types.UnbondingMaturityMode {
+	return k.GetParams(ctx).UnbondingMaturityMode
+}
+
+// ValidateBondDenomChange returns an error if the bond denom would change while
+// validators exist, as they hold tokens of the current denom. The change is
+// accepted when allowed through SetAllowBondDenomChange.
+func (k Keeper) ValidateBondDenomChange(ctx sdk.Context, bondDenom string) error {
+	current := k.BondDenom(ctx)
+	if current == "" || current == bondDenom || k.allowBondDenomChange {
+		return nil
+	}
+
+	if _, found := k.GetValidatorByIndex(ctx, 0); found {
+		return sdkerrors.Wrapf(types.ErrBondDenomChange, "cannot change bond denom from %s to %s while validators exist", current, bondDenom)
+	}
+
+	return nil
+}
+
 // SetParams sets the x/staking module parameters.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	if err := params.Validate(); err != nil {
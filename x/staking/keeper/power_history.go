@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetValidatorPowerHistory returns the latest consensus power changes of a
+// validator, oldest first.
+func (k Keeper) GetValidatorPowerHistory(ctx sdk.Context, addr sdk.ValAddress) types.ValidatorPowerHistory {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorPowerHistoryKey(addr))
+	if bz == nil {
+		return types.ValidatorPowerHistory{}
+	}
+
+	var history types.ValidatorPowerHistory
+	k.cdc.MustUnmarshal(bz, &history)
+
+	return history
+}
+
+// SetValidatorPowerHistory sets the power history of a validator
+func (k Keeper) SetValidatorPowerHistory(ctx sdk.Context, addr sdk.ValAddress, history types.ValidatorPowerHistory) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorPowerHistoryKey(addr), k.cdc.MustMarshal(&history))
+}
+
+// DeleteValidatorPowerHistory deletes the power history of a validator
+func (k Keeper) DeleteValidatorPowerHistory(ctx sdk.Context, addr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorPowerHistoryKey(addr))
+}
+
+// recordValidatorPower appends the consensus power of the validator to its
+// power history if it changed, dropping the oldest entries beyond the
+// PowerHistoryEntries param.
+func (k Keeper) recordValidatorPower(ctx sdk.Context, validator types.Validator) {
+	maxEntries := k.GetParams(ctx).PowerHistoryEntries
+	if maxEntries == 0 {
+		return
+	}
+
+	power := validator.PotentialConsensusPower(k.PowerReduction(ctx))
+	history := k.GetValidatorPowerHistory(ctx, validator.GetOperator())
+	if n := len(history.Entries); n > 0 && history.Entries[n-1].Power == power {
+		return
+	}
+
+	history.Entries = append(history.Entries, types.PowerHistoryEntry{Height: ctx.BlockHeight(), Power: power})
+	if n := uint32(len(history.Entries)); n > maxEntries {
+		history.Entries = history.Entries[n-maxEntries:]
+	}
+
+	k.SetValidatorPowerHistory(ctx, validator.GetOperator(), history)
+}
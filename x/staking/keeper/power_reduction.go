@@ -3,6 +3,7 @@ package keeper
 import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // TokensToConsensusPower - convert input tokens to potential consensus-engine power
@@ -14,3 +15,14 @@ func (k Keeper) TokensToConsensusPower(ctx sdk.Context, tokens math.Int) int64 {
 func (k Keeper) TokensFromConsensusPower(ctx sdk.Context, power int64) math.Int {
 	return sdk.TokensFromConsensusPower(power, k.PowerReduction(ctx))
 }
+
+// TokensMissingForConsensusPower - tokens the validator is missing to reach
+// the given potential consensus power, zero if it already has enough
+func (k Keeper) TokensMissingForConsensusPower(ctx sdk.Context, validator types.ValidatorI, power int64) math.Int {
+	missing := k.TokensFromConsensusPower(ctx, power).Sub(validator.GetTokens())
+	if missing.IsNegative() {
+		return math.ZeroInt()
+	}
+
+	return missing
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
 )
 
 func (s *KeeperTestSuite) TestTokensToConsensusPower() {
@@ -13,3 +14,28 @@ func (s *KeeperTestSuite) TestTokensFromConsensusPower() {
 	s.Require().Equal(sdk.NewInt(0), s.stakingKeeper.TokensFromConsensusPower(s.ctx, 0))
 	s.Require().Equal(sdk.DefaultPowerReduction, s.stakingKeeper.TokensFromConsensusPower(s.ctx, 1))
 }
+
+func (s *KeeperTestSuite) TestTokensFromConsensusPowerRoundTrip() {
+	for _, power := range []int64{0, 1, 7, 100, 1_000_000} {
+		tokens := s.stakingKeeper.TokensFromConsensusPower(s.ctx, power)
+		s.Require().Equal(power, s.stakingKeeper.TokensToConsensusPower(s.ctx, tokens))
+		s.Require().Equal(power, sdk.TokensToConsensusPower(tokens, s.stakingKeeper.PowerReduction(s.ctx)))
+	}
+}
+
+func (s *KeeperTestSuite) TestTokensMissingForConsensusPower() {
+	keeper := s.stakingKeeper
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(s.ctx, 10).AddRaw(1))
+
+	// the validator is a single token short of the next power unit
+	missing := keeper.TokensMissingForConsensusPower(s.ctx, validator, 11)
+	s.Require().Equal(keeper.PowerReduction(s.ctx).SubRaw(1), missing)
+
+	validator, _ = validator.AddTokensFromDel(missing)
+	s.Require().Equal(int64(11), validator.PotentialConsensusPower(keeper.PowerReduction(s.ctx)))
+
+	// no tokens are missing for a power already reached
+	s.Require().True(keeper.TokensMissingForConsensusPower(s.ctx, validator, 5).IsZero())
+}
@@ -65,8 +65,8 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 	operatorAddress := validator.GetOperator()
 
 	// call the before-modification hook
-	if err := k.Hooks().BeforeValidatorModified(ctx, operatorAddress); err != nil {
-		k.Logger(ctx).Error("failed to call before validator modified hook", "error", err)
+	if err := k.handleLoggedHookError(ctx, "before validator modified", k.Hooks().BeforeValidatorModified(ctx, operatorAddress)); err != nil {
+		panic(err)
 	}
 
 	// Track remaining slash amount for the validator
@@ -125,8 +125,8 @@ func (k Keeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeigh
 			effectiveFraction = math.LegacyOneDec()
 		}
 		// call the before-slashed hook
-		if err := k.Hooks().BeforeValidatorSlashed(ctx, operatorAddress, effectiveFraction); err != nil {
-			k.Logger(ctx).Error("failed to call before validator slashed hook", "error", err)
+		if err := k.handleLoggedHookError(ctx, "before validator slashed", k.Hooks().BeforeValidatorSlashed(ctx, operatorAddress, effectiveFraction)); err != nil {
+			panic(err)
 		}
 	}
 
@@ -163,20 +163,126 @@ func (k Keeper) SlashWithInfractionReason(ctx sdk.Context, consAddr sdk.ConsAddr
 
 // jail a validator
 func (k Keeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
-	validator := k.mustGetValidatorByConsAddr(ctx, consAddr)
-	k.jailValidator(ctx, validator)
+	validator, err := k.GetValidatorByConsAddrOrError(ctx, consAddr)
+	if err != nil {
+		k.handleRecoverableError(ctx, "cannot jail missing validator", err)
+		return
+	}
+
+	k.jailValidator(ctx, validator, types.JailReasonInfraction)
 	logger := k.Logger(ctx)
 	logger.Info("validator jailed", "validator", consAddr)
 }
 
 // unjail a validator
 func (k Keeper) Unjail(ctx sdk.Context, consAddr sdk.ConsAddress) {
-	validator := k.mustGetValidatorByConsAddr(ctx, consAddr)
+	validator, err := k.GetValidatorByConsAddrOrError(ctx, consAddr)
+	if err != nil {
+		k.handleRecoverableError(ctx, "cannot unjail missing validator", err)
+		return
+	}
+
 	k.unjailValidator(ctx, validator)
 	logger := k.Logger(ctx)
 	logger.Info("validator un-jailed", "validator", consAddr)
 }
 
+// EstimateSlashImpact returns the tokens a slash of the given fraction would
+// burn from the validator, along with the validator as it would look after
+// the tokens are removed. The store is not modified.
+func (k Keeper) EstimateSlashImpact(validator types.Validator, slashFactor sdk.Dec) (burnedTokens math.Int, slashed types.Validator) {
+	burnedTokens = sdk.MinInt(slashFactor.MulInt(validator.Tokens).TruncateInt(), validator.Tokens)
+	burnedTokens = sdk.MaxInt(burnedTokens, math.ZeroInt()) // defensive.
+
+	return burnedTokens, validator.RemoveTokens(burnedTokens)
+}
+
+// EstimateSlashJail returns the self-delegated tokens the validator would keep
+// after a slash of the given fraction and whether they would fall below its
+// minimum self-delegation, jailing it. The store is not modified.
+func (k Keeper) EstimateSlashJail(ctx sdk.Context, validator types.Validator, slashFactor sdk.Dec) (selfDelegation math.Int, jailed bool) {
+	_, slashed := k.EstimateSlashImpact(validator, slashFactor)
+
+	selfDelegation = math.ZeroInt()
+	delegation, found := k.GetDelegation(ctx, sdk.AccAddress(validator.GetOperator()), validator.GetOperator())
+	if found && !slashed.DelegatorShares.IsZero() {
+		selfDelegation = slashed.TokensFromShares(delegation.Shares).TruncateInt()
+	}
+
+	return selfDelegation, selfDelegation.LT(validator.MinSelfDelegation)
+}
+
+// JailValidator jails a validator by operator address and removes it from the
+// power index. An error is returned if the validator does not exist or is
+// already jailed.
+func (k Keeper) JailValidator(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	if validator.Jailed {
+		return types.ErrValidatorJailed
+	}
+
+	if err := k.handleHookError(ctx, "before validator modified", k.Hooks().BeforeValidatorModified(ctx, valAddr)); err != nil {
+		return err
+	}
+
+	// the power index key does not depend on the jailed flag, but jailed
+	// validators must never be part of the index
+	k.DeleteValidatorByPowerIndex(ctx, validator)
+	validator.Jailed = true
+	k.SetValidator(ctx, validator)
+	k.recordValidatorJail(ctx, valAddr, types.JailReasonJailValidator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeJailValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+		),
+	)
+
+	k.Logger(ctx).Info("validator jailed", "validator", validator.OperatorAddress)
+	return nil
+}
+
+// UnjailValidator removes a validator from jail by operator address. The
+// validator is only added back to the power index if it is bonded and its
+// tokens are not below its minimum self delegation. An error is returned if
+// the validator does not exist or is not jailed.
+func (k Keeper) UnjailValidator(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return types.ErrNoValidatorFound
+	}
+
+	if !validator.Jailed {
+		return types.ErrValidatorNotJailed
+	}
+
+	if err := k.handleHookError(ctx, "before validator modified", k.Hooks().BeforeValidatorModified(ctx, valAddr)); err != nil {
+		return err
+	}
+
+	validator.Jailed = false
+	k.SetValidator(ctx, validator)
+
+	if validator.IsBonded() && validator.Tokens.GTE(validator.MinSelfDelegation) {
+		k.SetValidatorByPowerIndex(ctx, validator)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnjailValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+		),
+	)
+
+	k.Logger(ctx).Info("validator un-jailed", "validator", validator.OperatorAddress)
+	return nil
+}
+
 // slash an unbonding delegation and update the pool
 // return the amount that would have been slashed assuming
 // the unbonding delegation had enough stake to slash
@@ -283,7 +389,7 @@ func (k Keeper) SlashRedelegation(ctx sdk.Context, srcValidator types.Validator,
 			sharesToUnbond = delegation.Shares
 		}
 
-		tokensToBurn, err := k.Unbond(ctx, delegatorAddress, valDstAddr, sharesToUnbond)
+		tokensToBurn, err := k.unbond(ctx, delegatorAddress, valDstAddr, sharesToUnbond)
 		if err != nil {
 			panic(fmt.Errorf("error unbonding delegator: %v", err))
 		}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // tests Jail, Unjail
@@ -34,6 +35,68 @@ func (s *KeeperTestSuite) TestRevocation() {
 	require.False(val.IsJailed())
 }
 
+func (s *KeeperTestSuite) inPowerIndex(ctx sdk.Context, valAddr sdk.ValAddress) bool {
+	iterator := s.stakingKeeper.ValidatorsPowerStoreIterator(ctx)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if valAddr.Equals(sdk.ValAddress(iterator.Value())) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// tests JailValidator, UnjailValidator
+func (s *KeeperTestSuite) TestJailValidatorPowerIndex() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+	validator = validator.UpdateStatus(stakingtypes.Bonded)
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByPowerIndex(ctx, validator)
+	require.True(s.inPowerIndex(ctx, valAddr))
+
+	require.ErrorIs(keeper.JailValidator(ctx, sdk.ValAddress(PKs[1].Address().Bytes())), stakingtypes.ErrNoValidatorFound)
+	require.ErrorIs(keeper.UnjailValidator(ctx, valAddr), stakingtypes.ErrValidatorNotJailed)
+
+	// jailing removes the validator from the power index
+	require.NoError(keeper.JailValidator(ctx, valAddr))
+	val, found := keeper.GetValidator(ctx, valAddr)
+	require.True(found)
+	require.True(val.IsJailed())
+	require.False(s.inPowerIndex(ctx, valAddr))
+	require.ErrorIs(keeper.JailValidator(ctx, valAddr), stakingtypes.ErrValidatorJailed)
+
+	// unjailing a bonded validator adds it back
+	require.NoError(keeper.UnjailValidator(ctx, valAddr))
+	val, found = keeper.GetValidator(ctx, valAddr)
+	require.True(found)
+	require.False(val.IsJailed())
+	require.True(s.inPowerIndex(ctx, valAddr))
+
+	// a validator below its minimum self delegation stays out of the index
+	require.NoError(keeper.JailValidator(ctx, valAddr))
+	val, _ = keeper.GetValidator(ctx, valAddr)
+	val.MinSelfDelegation = val.Tokens.AddRaw(1)
+	keeper.SetValidator(ctx, val)
+	require.NoError(keeper.UnjailValidator(ctx, valAddr))
+	require.False(s.inPowerIndex(ctx, valAddr))
+
+	// an unbonded validator stays out of the index as well
+	require.NoError(keeper.JailValidator(ctx, valAddr))
+	val, _ = keeper.GetValidator(ctx, valAddr)
+	val.MinSelfDelegation = sdk.OneInt()
+	val = val.UpdateStatus(stakingtypes.Unbonded)
+	keeper.SetValidator(ctx, val)
+	require.NoError(keeper.UnjailValidator(ctx, valAddr))
+	require.False(s.inPowerIndex(ctx, valAddr))
+}
+
 // tests Slash at a future height (must panic)
 func (s *KeeperTestSuite) TestSlashAtFutureHeight() {
 	ctx, keeper := s.ctx, s.stakingKeeper
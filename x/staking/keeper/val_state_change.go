@@ -109,7 +109,6 @@ func (k Keeper) BlockValidatorUpdates(ctx sdk.Context) []abci.ValidatorUpdate {
 // are returned to Tendermint.
 func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []abci.ValidatorUpdate, err error) {
 	params := k.GetParams(ctx)
-	maxValidators := params.MaxValidators
 	powerReduction := k.PowerReduction(ctx)
 	totalPower := math.ZeroInt()
 	amtFromBondedToNotBonded, amtFromNotBondedToBonded := math.ZeroInt(), math.ZeroInt()
@@ -122,6 +121,8 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		return nil, err
 	}
 
+	maxValidators := activeSetSize(params, uint32(len(last)))
+
 	// Iterate over validators, highest power to lowest.
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
 	defer iterator.Close()
@@ -200,6 +201,22 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 		updates = append(updates, validator.ABCIValidatorUpdateZero())
 	}
 
+	// Validators instantly bonded in this block that did not make it into the
+	// new set were never part of the last set, so they are not caught above.
+	// They are unbonded here without an update as CometBFT never knew them.
+	for _, valAddr := range k.getInstantBondedThisBlock(ctx) {
+		validator, found := k.GetValidator(ctx, valAddr)
+		if !found || !validator.IsBonded() || k.GetLastValidatorPower(ctx, valAddr) > 0 {
+			continue
+		}
+
+		validator, err = k.bondedToUnbonding(ctx, validator)
+		if err != nil {
+			return
+		}
+		amtFromBondedToNotBonded = amtFromBondedToNotBonded.Add(validator.GetTokens())
+	}
+
 	// Update the pools based on the recent updates in the validator set:
 	// - The tokens from the non-bonded candidates that enter the new validator set need to be transferred
 	// to the Bonded pool.
@@ -226,6 +243,18 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx sdk.Context) (updates []ab
 	return updates, err
 }
 
+// activeSetSize returns the number of validators allowed in the active set
+// this block. After MaxValidators is lowered the set shrinks from its last size
+// by at most MaxValidatorsTransitionStep validators per block.
+func activeSetSize(params types.Params, lastSize uint32) uint32 {
+	step := params.MaxValidatorsTransitionStep
+	if step == 0 || lastSize <= params.MaxValidators || lastSize-params.MaxValidators <= step {
+		return params.MaxValidators
+	}
+
+	return lastSize - step
+}
+
 // Validator state transitions
 
 func (k Keeper) bondedToUnbonding(ctx sdk.Context, validator types.Validator) (types.Validator, error) {
@@ -258,11 +287,20 @@ func (k Keeper) UnbondingToUnbonded(ctx sdk.Context, validator types.Validator)
 		panic(fmt.Sprintf("bad state transition unbondingToUnbonded, validator: %v\n", validator))
 	}
 
-	return k.completeUnbondingValidator(ctx, validator)
+	validator = k.completeUnbondingValidator(ctx, validator)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeValidatorUnbonded,
+			sdk.NewAttribute(types.AttributeKeyValidator, validator.OperatorAddress),
+		),
+	)
+
+	return validator
 }
 
-// send a validator to jail
-func (k Keeper) jailValidator(ctx sdk.Context, validator types.Validator) {
+// send a validator to jail, recording the reason in its jail history
+func (k Keeper) jailValidator(ctx sdk.Context, validator types.Validator, reason string) {
 	if validator.Jailed {
 		panic(fmt.Sprintf("cannot jail already jailed validator, validator: %v\n", validator))
 	}
@@ -270,6 +308,7 @@ func (k Keeper) jailValidator(ctx sdk.Context, validator types.Validator) {
 	validator.Jailed = true
 	k.SetValidator(ctx, validator)
 	k.DeleteValidatorByPowerIndex(ctx, validator)
+	k.recordValidatorJail(ctx, validator.GetOperator(), reason)
 }
 
 // remove a validator from jail
@@ -303,7 +342,7 @@ func (k Keeper) bondValidator(ctx sdk.Context, validator types.Validator) (types
 		return validator, err
 	}
 
-	if err := k.Hooks().AfterValidatorBonded(ctx, consAddr, validator.GetOperator()); err != nil {
+	if err := k.handleHookError(ctx, "after validator bonded", k.Hooks().AfterValidatorBonded(ctx, consAddr, validator.GetOperator())); err != nil {
 		return validator, err
 	}
 
@@ -345,13 +384,13 @@ func (k Keeper) BeginUnbondingValidator(ctx sdk.Context, validator types.Validat
 		return validator, err
 	}
 
-	if err := k.Hooks().AfterValidatorBeginUnbonding(ctx, consAddr, validator.GetOperator()); err != nil {
+	if err := k.handleHookError(ctx, "after validator begin unbonding", k.Hooks().AfterValidatorBeginUnbonding(ctx, consAddr, validator.GetOperator())); err != nil {
 		return validator, err
 	}
 
 	k.SetValidatorByUnbondingID(ctx, validator, id)
 
-	if err := k.Hooks().AfterUnbondingInitiated(ctx, id); err != nil {
+	if err := k.handleHookError(ctx, "after unbonding initiated", k.Hooks().AfterUnbondingInitiated(ctx, id)); err != nil {
 		return validator, err
 	}
 
@@ -1,15 +1,20 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
+	gomath "math"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"sort"
+	"strconv"
 	"time"
 
 	gogotypes "github.com/cosmos/gogoproto/types"
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32/legacybech32"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -26,15 +31,39 @@ func (k Keeper) GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator ty
 	return validator, true
 }
 
-func (k Keeper) mustGetValidator(ctx sdk.Context, addr sdk.ValAddress) types.Validator {
+// GetValidatorOrError returns the validator with the given operator address,
+// or ErrNoValidatorFound if it does not exist.
+func (k Keeper) GetValidatorOrError(ctx sdk.Context, addr sdk.ValAddress) (types.Validator, error) {
 	validator, found := k.GetValidator(ctx, addr)
 	if !found {
-		panic(fmt.Sprintf("validator record not found for address: %X\n", addr))
+		return validator, sdkerrors.Wrapf(types.ErrNoValidatorFound, "validator record not found for address: %X", addr)
+	}
+
+	return validator, nil
+}
+
+func (k Keeper) mustGetValidator(ctx sdk.Context, addr sdk.ValAddress) types.Validator {
+	validator, err := k.GetValidatorOrError(ctx, addr)
+	if err != nil {
+		panic(err)
 	}
 
 	return validator
 }
 
+// lookupValidator returns the validator indexed under the given operator
+// address. A missing validator panics in strict mode, otherwise it is logged
+// and reported as not found so the caller can skip it.
+func (k Keeper) lookupValidator(ctx sdk.Context, addr sdk.ValAddress) (types.Validator, bool) {
+	validator, err := k.GetValidatorOrError(ctx, addr)
+	if err != nil {
+		k.handleRecoverableError(ctx, "skipping missing validator", err)
+		return validator, false
+	}
+
+	return validator, true
+}
+
 // get a single validator by consensus address
 func (k Keeper) GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) (validator types.Validator, found bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -47,13 +76,87 @@ func (k Keeper) GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress
 	return k.GetValidator(ctx, opAddr)
 }
 
-func (k Keeper) mustGetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) types.Validator {
+// GetValidatorByConsAddrAsOf returns the validator with the given consensus
+// address if it was part of the validator set at the end of the given height.
+// The set left by the prior block is backed by the last validator powers, as
+// those are only updated at the end of the current block; older heights are
+// resolved from the historical info, which records that set at the following
+// height. Heights in the future are never found.
+func (k Keeper) GetValidatorByConsAddrAsOf(ctx sdk.Context, consAddr sdk.ConsAddress, height int64) (validator types.Validator, found bool) {
+	switch {
+	case height > ctx.BlockHeight():
+		return validator, false
+
+	case height == ctx.BlockHeight():
+		validator, found = k.GetValidatorByConsAddr(ctx, consAddr)
+		return validator, found && validator.IsBonded()
+
+	case height == ctx.BlockHeight()-1:
+		validator, found = k.GetValidatorByConsAddr(ctx, consAddr)
+		return validator, found && k.GetLastValidatorPower(ctx, validator.GetOperator()) > 0
+	}
+
+	hi, found := k.GetHistoricalInfo(ctx, height+1)
+	if !found {
+		return validator, false
+	}
+
+	for _, val := range hi.Valset {
+		valConsAddr, err := val.GetConsAddr()
+		if err == nil && valConsAddr.Equals(consAddr) {
+			return val, true
+		}
+	}
+
+	return validator, false
+}
+
+// ResolveValidator returns the validator whose consensus address or, failing
+// that, operator address matches the given address bytes.
+func (k Keeper) ResolveValidator(ctx sdk.Context, addr []byte) (validator types.Validator, found bool) {
+	if validator, found = k.GetValidatorByConsAddr(ctx, sdk.ConsAddress(addr)); found {
+		return validator, true
+	}
+
+	return k.GetValidator(ctx, sdk.ValAddress(addr))
+}
+
+// GetValidatorOperatorFromPubKey returns the operator address of the validator
+// using the given consensus public key.
+func (k Keeper) GetValidatorOperatorFromPubKey(ctx sdk.Context, pk cryptotypes.PubKey) (sdk.ValAddress, bool) {
+	validator, found := k.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(pk))
+	if !found {
+		return nil, false
+	}
+
+	return validator.GetOperator(), true
+}
+
+// GetValidatorConsPubKeyBech32 returns the consensus public key of a validator
+// bech32 encoded with the consensus pubkey prefix.
+func (k Keeper) GetValidatorConsPubKeyBech32(ctx sdk.Context, addr sdk.ValAddress) (string, error) {
+	validator, found := k.GetValidator(ctx, addr)
+	if !found {
+		return "", types.ErrNoValidatorFound
+	}
+
+	pk, err := validator.ConsPubKey()
+	if err != nil {
+		return "", err
+	}
+
+	return legacybech32.MarshalPubKey(legacybech32.ConsPK, pk) //nolint:staticcheck // operators configure sentries with bech32 keys
+}
+
+// GetValidatorByConsAddrOrError returns the validator with the given consensus
+// address, or ErrNoValidatorFound if it does not exist.
+func (k Keeper) GetValidatorByConsAddrOrError(ctx sdk.Context, consAddr sdk.ConsAddress) (types.Validator, error) {
 	validator, found := k.GetValidatorByConsAddr(ctx, consAddr)
 	if !found {
-		panic(fmt.Errorf("validator with consensus-Address %s not found", consAddr))
+		return validator, sdkerrors.Wrapf(types.ErrNoValidatorFound, "validator with consensus-Address %s not found", consAddr)
 	}
 
-	return validator
+	return validator, nil
 }
 
 // set the main record holding validator details
@@ -61,6 +164,90 @@ func (k Keeper) SetValidator(ctx sdk.Context, validator types.Validator) {
 	store := ctx.KVStore(k.storeKey)
 	bz := types.MustMarshalValidator(k.cdc, &validator)
 	store.Set(types.GetValidatorKey(validator.GetOperator()), bz)
+
+	k.setValidatorModified(ctx, validator.GetOperator())
+}
+
+// SetValidators imports the given validators along with their consensus address
+// index. When deferPowerIndex is set the power index is not updated, and
+// RebuildValidatorPowerIndex must be called once all validators are imported.
+func (k Keeper) SetValidators(ctx sdk.Context, validators []types.Validator, deferPowerIndex bool) error {
+	for _, validator := range validators {
+		k.SetValidator(ctx, validator)
+		if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+			return err
+		}
+
+		if !deferPowerIndex {
+			k.SetValidatorByPowerIndex(ctx, validator)
+		}
+	}
+
+	return nil
+}
+
+// RebuildValidatorPowerIndex clears the validator power index and rebuilds it
+// from the stored validators.
+func (k Keeper) RebuildValidatorPowerIndex(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorsByPowerIndexKey)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, validator := range k.GetAllValidators(ctx) {
+		k.SetValidatorByPowerIndex(ctx, validator)
+	}
+}
+
+// mark a validator as modified in the current block
+func (k Keeper) setValidatorModified(ctx sdk.Context, operator sdk.ValAddress) {
+	store := ctx.TransientStore(k.tStoreKey)
+	store.Set(types.GetValidatorModifiedKey(operator), []byte{})
+}
+
+// GetValidatorsModifiedThisBlock returns the operator addresses of all validators
+// whose record was written during the current block. The underlying transient
+// store is reset on commit, so the set starts out empty at every block.
+func (k Keeper) GetValidatorsModifiedThisBlock(ctx sdk.Context) (valAddrs []sdk.ValAddress) {
+	store := ctx.TransientStore(k.tStoreKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorsModifiedKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		valAddrs = append(valAddrs, sdk.ValAddress(types.AddressFromValidatorModifiedKey(iterator.Key())))
+	}
+
+	return valAddrs
+}
+
+// mark a validator as instantly bonded in the current block
+func (k Keeper) setInstantBonded(ctx sdk.Context, operator sdk.ValAddress) {
+	store := ctx.TransientStore(k.tStoreKey)
+	store.Set(types.GetInstantBondedKey(operator), []byte{})
+}
+
+// getInstantBondedThisBlock returns the operator addresses of the validators
+// instantly bonded during the current block.
+func (k Keeper) getInstantBondedThisBlock(ctx sdk.Context) (valAddrs []sdk.ValAddress) {
+	store := ctx.TransientStore(k.tStoreKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.InstantBondedKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		valAddrs = append(valAddrs, sdk.ValAddress(types.AddressFromInstantBondedKey(iterator.Key())))
+	}
+
+	return valAddrs
 }
 
 // validator index
@@ -74,6 +261,37 @@ func (k Keeper) SetValidatorByConsAddr(ctx sdk.Context, validator types.Validato
 	return nil
 }
 
+// SetValidatorCreationHeight records the block height at which a validator was created.
+func (k Keeper) SetValidatorCreationHeight(ctx sdk.Context, addr sdk.ValAddress, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValidatorCreationHeightKey(addr), sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// GetValidatorCreationHeight returns the block height at which a validator was
+// created. Validators imported at genesis have no recorded creation height.
+func (k Keeper) GetValidatorCreationHeight(ctx sdk.Context, addr sdk.ValAddress) (height int64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValidatorCreationHeightKey(addr))
+	if bz == nil {
+		return 0, false
+	}
+
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// setEvmValidator marks a validator as created through the EVM.
+func (k Keeper) setEvmValidator(ctx sdk.Context, addr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetEvmValidatorKey(addr), []byte{})
+}
+
+// IsEvmValidator returns whether a validator was created through the EVM, via
+// CreateEvmStaking and CreateEvmValidator, rather than natively.
+func (k Keeper) IsEvmValidator(ctx sdk.Context, addr sdk.ValAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetEvmValidatorKey(addr))
+}
+
 // validator index
 func (k Keeper) SetValidatorByPowerIndex(ctx sdk.Context, validator types.Validator) {
 	// jailed validators are not kept in the power index
@@ -82,13 +300,43 @@ func (k Keeper) SetValidatorByPowerIndex(ctx sdk.Context, validator types.Valida
 	}
 
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx)), validator.GetOperator())
+	key := types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx))
+	store.Set(key, validator.GetOperator())
+	k.emitPowerIndexEvent(ctx, types.EventTypeValidatorPowerIndexSet, key)
+	k.recordValidatorPower(ctx, validator)
 }
 
 // validator index
 func (k Keeper) DeleteValidatorByPowerIndex(ctx sdk.Context, validator types.Validator) {
+	k.deleteValidatorPowerIndexKey(ctx, types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx)))
+}
+
+// deleteValidatorPowerIndexKey deletes a validators power index entry by key.
+func (k Keeper) deleteValidatorPowerIndexKey(ctx sdk.Context, key []byte) {
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx)))
+	store.Delete(key)
+	k.emitPowerIndexEvent(ctx, types.EventTypeValidatorPowerIndexDeleted, key)
+}
+
+// emitPowerIndexEvent emits an event carrying the operator and the power
+// encoded in a power index key, if power index events are enabled.
+func (k Keeper) emitPowerIndexEvent(ctx sdk.Context, eventType string, key []byte) {
+	if !k.powerIndexEvents {
+		return
+	}
+
+	power, operator, err := types.ParseValidatorsByPowerIndexKey(key)
+	if err != nil {
+		return
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyValidator, operator.String()),
+			sdk.NewAttribute(types.AttributeKeyValidatorPower, strconv.FormatInt(power, 10)),
+		),
+	)
 }
 
 // validator index
@@ -101,10 +349,10 @@ func (k Keeper) SetNewValidatorByPowerIndex(ctx sdk.Context, validator types.Val
 func (k Keeper) AddValidatorTokensAndShares(ctx sdk.Context, validator types.Validator,
 	tokensToAdd math.Int,
 ) (valOut types.Validator, addedShares sdk.Dec) {
-	k.DeleteValidatorByPowerIndex(ctx, validator)
+	oldPowerIndexKey := types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx))
 	validator, addedShares = validator.AddTokensFromDel(tokensToAdd)
 	k.SetValidator(ctx, validator)
-	k.SetValidatorByPowerIndex(ctx, validator)
+	k.updateValidatorByPowerIndex(ctx, oldPowerIndexKey, validator)
 
 	return validator, addedShares
 }
@@ -113,14 +361,65 @@ func (k Keeper) AddValidatorTokensAndShares(ctx sdk.Context, validator types.Val
 func (k Keeper) RemoveValidatorTokensAndShares(ctx sdk.Context, validator types.Validator,
 	sharesToRemove sdk.Dec,
 ) (valOut types.Validator, removedTokens math.Int) {
-	k.DeleteValidatorByPowerIndex(ctx, validator)
+	oldPowerIndexKey := types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx))
 	validator, removedTokens = validator.RemoveDelShares(sharesToRemove)
 	k.SetValidator(ctx, validator)
-	k.SetValidatorByPowerIndex(ctx, validator)
+	k.updateValidatorByPowerIndex(ctx, oldPowerIndexKey, validator)
 
 	return validator, removedTokens
 }
 
+// updateValidatorByPowerIndex moves the power index entry of a validator whose
+// tokens changed from oldKey to the key of its new power. Token changes too
+// small to move the consensus power leave the key, and so the store, untouched.
+func (k Keeper) updateValidatorByPowerIndex(ctx sdk.Context, oldKey []byte, validator types.Validator) {
+	store := ctx.KVStore(k.storeKey)
+	if !validator.Jailed && bytes.Equal(oldKey, types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx))) && store.Has(oldKey) {
+		return
+	}
+
+	k.deleteValidatorPowerIndexKey(ctx, oldKey)
+	k.SetValidatorByPowerIndex(ctx, validator)
+}
+
+// GetBlockTokenMovement returns the tokens added to or removed from the
+// validators by delegations and undelegations within the current block. The
+// movement is only tracked while the MaxTokenMovementPerBlock cap is enabled.
+func (k Keeper) GetBlockTokenMovement(ctx sdk.Context) math.Int {
+	store := ctx.TransientStore(k.tStoreKey)
+	bz := store.Get(types.TokenMovementKey)
+	if bz == nil {
+		return math.ZeroInt()
+	}
+
+	ip := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &ip)
+
+	return ip.Int
+}
+
+// recordTokenMovement adds the given tokens to the movement of the current
+// block. It returns an error, leaving the movement unchanged, if the movement
+// would exceed the MaxTokenMovementPerBlock cap, which only a runaway loop of
+// token changes should trip. It is only called from the delegation and
+// undelegation paths so that the cap fails the transaction and never a
+// BeginBlock slash.
+func (k Keeper) recordTokenMovement(ctx sdk.Context, tokens math.Int) error {
+	maxMovement := k.GetParams(ctx).MaxTokenMovementPerBlock
+	if maxMovement.IsNil() || maxMovement.IsZero() {
+		return nil
+	}
+
+	movement := k.GetBlockTokenMovement(ctx).Add(tokens)
+	if movement.GT(maxMovement) {
+		return sdkerrors.Wrapf(types.ErrTokenMovementCapExceeded, "%s tokens moved, cap is %s", movement, maxMovement)
+	}
+
+	store := ctx.TransientStore(k.tStoreKey)
+	store.Set(types.TokenMovementKey, k.cdc.MustMarshal(&sdk.IntProto{Int: movement}))
+	return nil
+}
+
 // Update the tokens of an existing validator, update the validators power index key
 func (k Keeper) RemoveValidatorTokens(ctx sdk.Context,
 	validator types.Validator, tokensToRemove math.Int,
@@ -128,6 +427,13 @@ func (k Keeper) RemoveValidatorTokens(ctx sdk.Context,
 	k.DeleteValidatorByPowerIndex(ctx, validator)
 	validator = validator.RemoveTokens(tokensToRemove)
 	k.SetValidator(ctx, validator)
+
+	// a bonded validator left without tokens drops out of the set at the end
+	// of the block, don't leave a stale zero power index entry behind for it
+	if validator.IsBonded() && validator.Tokens.IsZero() {
+		return validator
+	}
+
 	k.SetValidatorByPowerIndex(ctx, validator)
 
 	return validator
@@ -141,7 +447,7 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 	commission := validator.Commission
 	blockTime := ctx.BlockHeader().Time
 
-	if err := commission.ValidateNewRate(newRate, blockTime); err != nil {
+	if err := commission.ValidateNewRate(newRate, blockTime, k.CommissionChangeInterval(ctx)); err != nil {
 		return commission, err
 	}
 
@@ -149,6 +455,10 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 		return commission, fmt.Errorf("cannot set validator commission to less than minimum rate of %s", k.MinCommissionRate(ctx))
 	}
 
+	if newRate.GT(k.MaxCommissionRate(ctx)) {
+		return commission, sdkerrors.Wrapf(types.ErrCommissionGTMaxRate, "cannot set validator commission to more than maximum rate of %s", k.MaxCommissionRate(ctx))
+	}
+
 	commission.Rate = newRate
 	commission.UpdateTime = blockTime
 
@@ -158,19 +468,25 @@ func (k Keeper) UpdateValidatorCommission(ctx sdk.Context,
 // remove the validator record and associated indexes
 // except for the bonded validator index which is only handled in ApplyAndReturnTendermintUpdates
 // TODO, this function panics, and it's not good.
-func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
+func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) error {
 	// first retrieve the old validator record
 	validator, found := k.GetValidator(ctx, address)
 	if !found {
-		return
+		return nil
 	}
 
 	if !validator.IsUnbonded() {
-		panic("cannot call RemoveValidator on bonded or unbonding validators")
+		if k.strictMode {
+			panic("cannot call RemoveValidator on bonded or unbonding validators")
+		}
+		return sdkerrors.Wrap(types.ErrBadValidatorRemoval, "cannot call RemoveValidator on bonded or unbonding validators")
 	}
 
 	if validator.Tokens.IsPositive() {
-		panic("attempting to remove a validator which still contains tokens")
+		if k.strictMode {
+			panic("attempting to remove a validator which still contains tokens")
+		}
+		return sdkerrors.Wrap(types.ErrBadValidatorRemoval, "attempting to remove a validator which still contains tokens")
 	}
 
 	valConsAddr, err := validator.GetConsAddr()
@@ -183,10 +499,13 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.ValAddress) {
 	store.Delete(types.GetValidatorKey(address))
 	store.Delete(types.GetValidatorByConsAddrKey(valConsAddr))
 	store.Delete(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx)))
+	store.Delete(types.GetValidatorCreationHeightKey(address))
+	store.Delete(types.GetValidatorPowerHistoryKey(address))
+	store.Delete(types.GetValidatorJailHistoryKey(address))
+	store.Delete(types.GetCommissionScheduleKey(address))
+	store.Delete(types.GetEvmValidatorKey(address))
 
-	if err := k.Hooks().AfterValidatorRemoved(ctx, valConsAddr, validator.GetOperator()); err != nil {
-		k.Logger(ctx).Error("error in after validator removed hook", "error", err)
-	}
+	return k.handleLoggedHookError(ctx, "after validator removed", k.Hooks().AfterValidatorRemoved(ctx, valConsAddr, validator.GetOperator()))
 }
 
 // get groups of validators
@@ -206,6 +525,36 @@ func (k Keeper) GetAllValidators(ctx sdk.Context) (validators []types.Validator)
 	return validators
 }
 
+// validatorConsensusPower returns the consensus power of a validator, zero if
+// the validator does not exist.
+func (k Keeper) validatorConsensusPower(ctx sdk.Context, addr sdk.ValAddress) int64 {
+	validator, found := k.GetValidator(ctx, addr)
+	if !found {
+		return 0
+	}
+
+	return validator.ConsensusPower(k.PowerReduction(ctx))
+}
+
+// GetValidatorByIndex returns the i-th validator in store order, without
+// unmarshalling the validators before it.
+func (k Keeper) GetValidatorByIndex(ctx sdk.Context, i uint32) (validator types.Validator, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid() && i > 0; iterator.Next() {
+		i--
+	}
+
+	if !iterator.Valid() {
+		return validator, false
+	}
+
+	return types.MustUnmarshalValidator(k.cdc, iterator.Value()), true
+}
+
 // return a given amount of all the validators
 func (k Keeper) GetValidators(ctx sdk.Context, maxRetrieve uint32) (validators []types.Validator) {
 	store := ctx.KVStore(k.storeKey)
@@ -226,7 +575,12 @@ func (k Keeper) GetValidators(ctx sdk.Context, maxRetrieve uint32) (validators [
 
 // get the current group of bonded validators sorted by power-rank
 func (k Keeper) GetBondedValidatorsByPower(ctx sdk.Context) []types.Validator {
-	maxValidators := k.MaxValidators(ctx)
+	return k.bondedValidatorsByPower(ctx, k.maxActiveValidators(ctx, k.GetParams(ctx)))
+}
+
+// bondedValidatorsByPower returns at most maxValidators bonded validators
+// sorted by decreasing power.
+func (k Keeper) bondedValidatorsByPower(ctx sdk.Context, maxValidators uint32) []types.Validator {
 	validators := make([]types.Validator, maxValidators)
 
 	iterator := k.ValidatorsPowerStoreIterator(ctx)
@@ -234,8 +588,10 @@ func (k Keeper) GetBondedValidatorsByPower(ctx sdk.Context) []types.Validator {
 
 	i := 0
 	for ; iterator.Valid() && i < int(maxValidators); iterator.Next() {
-		address := iterator.Value()
-		validator := k.mustGetValidator(ctx, address)
+		validator, found := k.lookupValidator(ctx, iterator.Value())
+		if !found {
+			continue
+		}
 
 		if validator.IsBonded() {
 			validators[i] = validator
@@ -246,6 +602,202 @@ func (k Keeper) GetBondedValidatorsByPower(ctx sdk.Context) []types.Validator {
 	return validators[:i] // trim
 }
 
+// GetValidatorsByPowerRange returns the bonded validators whose consensus power
+// is within [minPower, maxPower], from the highest to the lowest power.
+func (k Keeper) GetValidatorsByPowerRange(ctx sdk.Context, minPower, maxPower int64) []types.Validator {
+	powerReduction := k.PowerReduction(ctx)
+	validators := []types.Validator{}
+
+	iterator := k.ValidatorsPowerStoreIterator(ctx)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		validator, found := k.lookupValidator(ctx, iterator.Value())
+		if !found {
+			continue
+		}
+
+		// the power index is sorted by decreasing potential power, which is
+		// the consensus power of the bonded validators
+		power := validator.PotentialConsensusPower(powerReduction)
+		if power < minPower {
+			break
+		}
+
+		if power <= maxPower && validator.IsBonded() {
+			validators = append(validators, validator)
+		}
+	}
+
+	return validators
+}
+
+// GetMaxValidatorsHeadroom returns the number of validators that can still join
+// the active set, along with the consensus power of the lowest bonded validator.
+func (k Keeper) GetMaxValidatorsHeadroom(ctx sdk.Context) (headroom uint32, thresholdPower int64) {
+	bonded := k.GetBondedValidatorsByPower(ctx)
+	if len(bonded) > 0 {
+		thresholdPower = bonded[len(bonded)-1].ConsensusPower(k.PowerReduction(ctx))
+	}
+
+	maxValidators := k.MaxValidators(ctx)
+	if uint32(len(bonded)) >= maxValidators {
+		return 0, thresholdPower
+	}
+
+	return maxValidators - uint32(len(bonded)), thresholdPower
+}
+
+// GetNakamotoCoefficient returns the minimum number of bonded validators whose
+// combined consensus power exceeds one third of the total bonded power, along
+// with the total bonded power.
+func (k Keeper) GetNakamotoCoefficient(ctx sdk.Context) (coefficient int, totalPower int64) {
+	bonded := k.GetBondedValidatorsByPower(ctx)
+	powerReduction := k.PowerReduction(ctx)
+
+	powers := make([]int64, len(bonded))
+	for i, validator := range bonded {
+		powers[i] = validator.ConsensusPower(powerReduction)
+		totalPower += powers[i]
+	}
+
+	if totalPower == 0 {
+		return 0, 0
+	}
+
+	var accumulated int64
+	for i, power := range powers {
+		accumulated += power
+		if accumulated*3 > totalPower {
+			return i + 1, totalPower
+		}
+	}
+
+	return len(powers), totalPower
+}
+
+// GetTotalStakedByStatus returns the sum of the validator tokens for each bond status.
+func (k Keeper) GetTotalStakedByStatus(ctx sdk.Context) (bonded, unbonding, unbonded math.Int) {
+	bonded, unbonding, unbonded = math.ZeroInt(), math.ZeroInt(), math.ZeroInt()
+
+	for _, validator := range k.GetAllValidators(ctx) {
+		switch validator.GetStatus() {
+		case types.Bonded:
+			bonded = bonded.Add(validator.Tokens)
+		case types.Unbonding:
+			unbonding = unbonding.Add(validator.Tokens)
+		case types.Unbonded:
+			unbonded = unbonded.Add(validator.Tokens)
+		}
+	}
+
+	return bonded, unbonding, unbonded
+}
+
+// GetValidatorsWithCommissionOlderThan returns the validators whose commission
+// was last updated before the given cutoff time.
+func (k Keeper) GetValidatorsWithCommissionOlderThan(ctx sdk.Context, cutoff time.Time) []types.Validator {
+	validators := []types.Validator{}
+	for _, validator := range k.GetAllValidators(ctx) {
+		if validator.Commission.UpdateTime.Before(cutoff) {
+			validators = append(validators, validator)
+		}
+	}
+
+	return validators
+}
+
+// GetValidatorsJoinedAfter returns the validators created after the given block
+// height. Validators without a recorded creation height are not returned.
+func (k Keeper) GetValidatorsJoinedAfter(ctx sdk.Context, height int64) []types.Validator {
+	validators := []types.Validator{}
+	for _, validator := range k.GetAllValidators(ctx) {
+		if creationHeight, found := k.GetValidatorCreationHeight(ctx, validator.GetOperator()); found && creationHeight > height {
+			validators = append(validators, validator)
+		}
+	}
+
+	return validators
+}
+
+// GetBondedValidatorsWithZeroPower returns the bonded validators without
+// consensus power. Bonded validators always hold power, so any validator
+// returned indicates a bug.
+func (k Keeper) GetBondedValidatorsWithZeroPower(ctx sdk.Context) []types.Validator {
+	powerReduction := k.PowerReduction(ctx)
+	validators := []types.Validator{}
+	for _, validator := range k.GetAllValidators(ctx) {
+		if validator.IsBonded() && validator.ConsensusPower(powerReduction) == 0 {
+			validators = append(validators, validator)
+		}
+	}
+
+	return validators
+}
+
+// GetValidatorsTotalShares returns the sum of the delegator shares issued by
+// all the validators.
+func (k Keeper) GetValidatorsTotalShares(ctx sdk.Context) sdk.Dec {
+	total := math.LegacyZeroDec()
+	for _, validator := range k.GetAllValidators(ctx) {
+		total = total.Add(validator.DelegatorShares)
+	}
+
+	return total
+}
+
+// TotalBondedPower returns the sum of the consensus powers of the bonded
+// validators.
+func (k Keeper) TotalBondedPower(ctx sdk.Context) int64 {
+	powerReduction := k.PowerReduction(ctx)
+
+	var total int64
+	for _, validator := range k.GetAllValidators(ctx) {
+		total += validator.ConsensusPower(powerReduction)
+	}
+
+	return total
+}
+
+// GetValidatorVotingPowerPercentage returns the fraction of the total bonded
+// power held by the validator, zero if it is not bonded or nothing is bonded.
+func (k Keeper) GetValidatorVotingPowerPercentage(ctx sdk.Context, addr sdk.ValAddress) (percentage sdk.Dec, found bool) {
+	validator, found := k.GetValidator(ctx, addr)
+	if !found {
+		return percentage, false
+	}
+
+	total := k.TotalBondedPower(ctx)
+	if total == 0 {
+		return math.LegacyZeroDec(), true
+	}
+
+	power := validator.ConsensusPower(k.PowerReduction(ctx))
+	return math.LegacyNewDec(power).QuoInt64(total), true
+}
+
+// GetValidatorsByCommissionBelow returns the bonded validators whose
+// commission rate is below rate, sorted by ascending rate. At most limit
+// validators are returned unless limit is zero.
+func (k Keeper) GetValidatorsByCommissionBelow(ctx sdk.Context, rate sdk.Dec, limit uint32) []types.Validator {
+	validators := []types.Validator{}
+	for _, validator := range k.GetAllValidators(ctx) {
+		if validator.IsBonded() && validator.Commission.Rate.LT(rate) {
+			validators = append(validators, validator)
+		}
+	}
+
+	sort.SliceStable(validators, func(i, j int) bool {
+		return validators[i].Commission.Rate.LT(validators[j].Commission.Rate)
+	})
+
+	if limit > 0 && uint32(len(validators)) > limit {
+		validators = validators[:limit]
+	}
+
+	return validators
+}
+
 // returns an iterator for the current validator power store
 func (k Keeper) ValidatorsPowerStoreIterator(ctx sdk.Context) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
@@ -283,6 +835,28 @@ func (k Keeper) DeleteLastValidatorPower(ctx sdk.Context, operator sdk.ValAddres
 	store.Delete(types.GetLastValidatorPowerKey(operator))
 }
 
+// maxActiveValidators returns the number of validators the active set holds:
+// MaxValidators, or the size of the last validator set while it shrinks
+// towards a lowered MaxValidators by MaxValidatorsTransitionStep per block.
+func (k Keeper) maxActiveValidators(ctx sdk.Context, params types.Params) uint32 {
+	if params.MaxValidatorsTransitionStep == 0 {
+		return params.MaxValidators
+	}
+
+	lastSize := uint32(0)
+	iterator := k.LastValidatorsIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		lastSize++
+	}
+
+	if lastSize > params.MaxValidators {
+		return lastSize
+	}
+
+	return params.MaxValidators
+}
+
 // returns an iterator for the consensus validators in the last block
 func (k Keeper) LastValidatorsIterator(ctx sdk.Context) (iterator sdk.Iterator) {
 	store := ctx.KVStore(k.storeKey)
@@ -315,27 +889,104 @@ func (k Keeper) GetLastValidators(ctx sdk.Context) (validators []types.Validator
 	store := ctx.KVStore(k.storeKey)
 
 	// add the actual validator power sorted store
-	maxValidators := k.MaxValidators(ctx)
-	validators = make([]types.Validator, maxValidators)
+	maxValidators := k.maxActiveValidators(ctx, k.GetParams(ctx))
+	validators = make([]types.Validator, 0, maxValidators)
 
 	iterator := sdk.KVStorePrefixIterator(store, types.LastValidatorPowerKey)
 	defer iterator.Close()
 
-	i := 0
 	for ; iterator.Valid(); iterator.Next() {
 		// sanity check
-		if i >= int(maxValidators) {
+		if len(validators) >= int(maxValidators) {
 			panic("more validators than maxValidators found")
 		}
 
 		address := types.AddressFromLastValidatorPowerKey(iterator.Key())
-		validator := k.mustGetValidator(ctx, address)
+		validator, found := k.lookupValidator(ctx, address)
+		if !found {
+			continue
+		}
 
-		validators[i] = validator
-		i++
+		validators = append(validators, validator)
 	}
 
-	return validators[:i] // trim
+	return validators
+}
+
+// RecomputeLastValidatorPowers clears the last validator power index and
+// rewrites it from the current bonded validators, repairing an index that
+// drifted from the bonded set. It returns the number of entries written.
+func (k Keeper) RecomputeLastValidatorPowers(ctx sdk.Context) int {
+	// the size of the active set depends on the last validator set while it
+	// shrinks towards a lowered MaxValidators, read it before clearing the set
+	maxValidators := k.maxActiveValidators(ctx, k.GetParams(ctx))
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := k.LastValidatorsIterator(ctx)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	validators := k.bondedValidatorsByPower(ctx, maxValidators)
+	for _, validator := range validators {
+		k.SetLastValidatorPower(ctx, validator.GetOperator(), validator.ConsensusPower(k.PowerReduction(ctx)))
+	}
+
+	return len(validators)
+}
+
+// SweepOrphanConsAddrEntries deletes the consensus address index entries whose
+// operator no longer exists and returns the number of entries swept.
+func (k Keeper) SweepOrphanConsAddrEntries(ctx sdk.Context) int {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorsByConsAddrKey)
+
+	var orphans [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		if _, found := k.GetValidator(ctx, iterator.Value()); !found {
+			orphans = append(orphans, iterator.Key())
+		}
+	}
+	iterator.Close()
+
+	for _, key := range orphans {
+		store.Delete(key)
+	}
+
+	return len(orphans)
+}
+
+// ValidateExistingValidatorSetAgainstConsensusParams returns the validators
+// whose consensus pubkey type is no longer allowed by the consensus params,
+// e.g. after a consensus params change, so that governance can act on them.
+func (k Keeper) ValidateExistingValidatorSetAgainstConsensusParams(ctx sdk.Context) []sdk.ValAddress {
+	cp := ctx.ConsensusParams()
+	if cp == nil || cp.Validator == nil {
+		return nil
+	}
+
+	allowed := make(map[string]bool, len(cp.Validator.PubKeyTypes))
+	for _, keyType := range cp.Validator.PubKeyTypes {
+		allowed[keyType] = true
+	}
+
+	var offending []sdk.ValAddress
+	k.IterateValidators(ctx, func(_ int64, validator types.ValidatorI) (stop bool) {
+		pk, err := validator.ConsPubKey()
+		if err != nil || !allowed[pk.Type()] {
+			offending = append(offending, validator.GetOperator())
+		}
+		return false
+	})
+
+	return offending
 }
 
 // GetUnbondingValidators returns a slice of mature validator addresses that
@@ -355,13 +1006,51 @@ func (k Keeper) GetUnbondingValidators(ctx sdk.Context, endTime time.Time, endHe
 }
 
 // SetUnbondingValidatorsQueue sets a given slice of validator addresses into
-// the unbonding validator queue by a given height and time.
+// the unbonding validator queue by a given height and time. The addresses are
+// stored sorted by their bytes so that the stored order is deterministic.
 func (k Keeper) SetUnbondingValidatorsQueue(ctx sdk.Context, endTime time.Time, endHeight int64, addrs []string) {
 	store := ctx.KVStore(k.storeKey)
-	bz := k.cdc.MustMarshal(&types.ValAddresses{Addresses: addrs})
+	bz := k.cdc.MustMarshal(&types.ValAddresses{Addresses: sortValAddresses(addrs)})
 	store.Set(types.GetValidatorQueueKey(endTime, endHeight), bz)
 }
 
+// sortValAddresses returns a copy of the given bech32 validator addresses sorted
+// by their address bytes, so that the order does not depend on the Bech32 prefix.
+// Malformed addresses are kept after all valid ones, ordered by their string.
+func sortValAddresses(addrs []string) []string {
+	type entry struct {
+		addr string
+		bz   sdk.ValAddress
+	}
+
+	entries := make([]entry, len(addrs))
+	for i, addr := range addrs {
+		bz, err := sdk.ValAddressFromBech32(addr)
+		if err != nil {
+			bz = nil
+		}
+		entries[i] = entry{addr: addr, bz: bz}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		switch {
+		case entries[i].bz == nil && entries[j].bz == nil:
+			return entries[i].addr < entries[j].addr
+		case entries[i].bz == nil || entries[j].bz == nil:
+			return entries[j].bz == nil
+		default:
+			return bytes.Compare(entries[i].bz, entries[j].bz) < 0
+		}
+	})
+
+	sorted := make([]string, len(entries))
+	for i, e := range entries {
+		sorted[i] = e.addr
+	}
+
+	return sorted
+}
+
 // InsertUnbondingValidatorQueue inserts a given unbonding validator address into
 // the unbonding validator queue for a given height and time.
 func (k Keeper) InsertUnbondingValidatorQueue(ctx sdk.Context, val types.Validator) {
@@ -380,9 +1069,6 @@ func (k Keeper) DeleteValidatorQueueTimeSlice(ctx sdk.Context, endTime time.Time
 // DeleteValidatorQueue removes a validator by address from the unbonding queue
 // indexed by a given height and time.
 func (k Keeper) DeleteValidatorQueue(ctx sdk.Context, val types.Validator) {
-	addrs := k.GetUnbondingValidators(ctx, val.UnbondingTime, val.UnbondingHeight)
-	newAddrs := []string{}
-
 	// since address string may change due to Bech32 prefix change, we parse the addresses into bytes
 	// format for normalization
 	deletingAddr, err := sdk.ValAddressFromBech32(val.OperatorAddress)
@@ -390,10 +1076,24 @@ func (k Keeper) DeleteValidatorQueue(ctx sdk.Context, val types.Validator) {
 		panic(err)
 	}
 
+	k.deleteFromValidatorQueue(ctx, val.UnbondingTime, val.UnbondingHeight, deletingAddr)
+}
+
+// deleteFromValidatorQueue removes an address from the unbonding queue slice
+// indexed by a given height and time.
+func (k Keeper) deleteFromValidatorQueue(ctx sdk.Context, endTime time.Time, endHeight int64, deletingAddr sdk.ValAddress) {
+	addrs := k.GetUnbondingValidators(ctx, endTime, endHeight)
+	newAddrs := []string{}
+
 	for _, addr := range addrs {
 		storedAddr, err := sdk.ValAddressFromBech32(addr)
 		if err != nil {
-			// even if we don't panic here, it will panic in UnbondAllMatureValidators at unbond time
+			// in lenient mode the malformed address is dropped from the queue,
+			// otherwise it would panic in UnbondAllMatureValidators at unbond time
+			if k.lenientQueue() {
+				k.Logger(ctx).Error("dropping malformed address from the validator queue", "address", addr, "error", err.Error())
+				continue
+			}
 			panic(err)
 		}
 		if !storedAddr.Equals(deletingAddr) {
@@ -402,9 +1102,9 @@ func (k Keeper) DeleteValidatorQueue(ctx sdk.Context, val types.Validator) {
 	}
 
 	if len(newAddrs) == 0 {
-		k.DeleteValidatorQueueTimeSlice(ctx, val.UnbondingTime, val.UnbondingHeight)
+		k.DeleteValidatorQueueTimeSlice(ctx, endTime, endHeight)
 	} else {
-		k.SetUnbondingValidatorsQueue(ctx, val.UnbondingTime, val.UnbondingHeight, newAddrs)
+		k.SetUnbondingValidatorsQueue(ctx, endTime, endHeight, newAddrs)
 	}
 }
 
@@ -415,67 +1115,191 @@ func (k Keeper) ValidatorQueueIterator(ctx sdk.Context, endTime time.Time, endHe
 	return store.Iterator(types.ValidatorQueueKey, sdk.InclusiveEndBytes(types.GetValidatorQueueKey(endTime, endHeight)))
 }
 
-// UnbondAllMatureValidators unbonds all the mature unbonding validators that
-// have finished their unbonding period.
-func (k Keeper) UnbondAllMatureValidators(ctx sdk.Context) {
-	blockTime := ctx.BlockTime()
-	blockHeight := ctx.BlockHeight()
+// IterateMatureValidatorQueue iterates over the validators in the unbonding
+// queue whose unbonding height and time are at or before endHeight and endTime,
+// calling fn with the validator address and its unbonding completion time.
+// Iteration stops when fn returns true.
+func (k Keeper) IterateMatureValidatorQueue(ctx sdk.Context, endTime time.Time, endHeight int64, fn func(valAddr sdk.ValAddress, completionTime time.Time) (stop bool)) {
+	k.iterateMatureValidatorQueue(ctx, types.UnbondingMaturityHeightAndTime, endTime, endHeight, func(valAddr sdk.ValAddress, completionTime time.Time, _ int64) bool {
+		return fn(valAddr, completionTime)
+	})
+}
 
-	// unbondingValIterator will contains all validator addresses indexed under
-	// the ValidatorQueueKey prefix. Note, the entire index key is composed as
+// iterateMatureValidatorQueue iterates over the validators in the unbonding
+// queue that are mature at endHeight and endTime given the maturity mode.
+func (k Keeper) iterateMatureValidatorQueue(ctx sdk.Context, mode types.UnbondingMaturityMode, endTime time.Time, endHeight int64, fn func(valAddr sdk.ValAddress, completionTime time.Time, completionHeight int64) (stop bool)) {
+	// the iterator contains all validator addresses indexed under the
+	// ValidatorQueueKey prefix. Note, the entire index key is composed as
 	// ValidatorQueueKey | timeBzLen (8-byte big endian) | timeBz | heightBz (8-byte big endian),
 	// so it may be possible that certain validator addresses that are iterated
 	// over are not ready to unbond, so an explicit check is required.
-	unbondingValIterator := k.ValidatorQueueIterator(ctx, blockTime, blockHeight)
-	defer unbondingValIterator.Close()
+	// When only one of the height or time is considered, mature entries can be
+	// found past the (endTime, endHeight) key and the whole queue is scanned.
+	var iterator sdk.Iterator
+	if mode == types.UnbondingMaturityHeightAndTime {
+		iterator = k.ValidatorQueueIterator(ctx, endTime, endHeight)
+	} else {
+		iterator = sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ValidatorQueueKey)
+	}
+	defer iterator.Close()
 
-	for ; unbondingValIterator.Valid(); unbondingValIterator.Next() {
-		key := unbondingValIterator.Key()
-		keyTime, keyHeight, err := types.ParseValidatorQueueKey(key)
+	for ; iterator.Valid(); iterator.Next() {
+		keyTime, keyHeight, err := types.ParseValidatorQueueKey(iterator.Key())
 		if err != nil {
 			panic(fmt.Errorf("failed to parse unbonding key: %w", err))
 		}
 
 		// All addresses for the given key have the same unbonding height and time.
-		// We only unbond if the height and time are less than the current height
-		// and time.
-		if keyHeight <= blockHeight && (keyTime.Before(blockTime) || keyTime.Equal(blockTime)) {
-			addrs := types.ValAddresses{}
-			k.cdc.MustUnmarshal(unbondingValIterator.Value(), &addrs)
-
-			for _, valAddr := range addrs.Addresses {
-				addr, err := sdk.ValAddressFromBech32(valAddr)
-				if err != nil {
-					panic(err)
-				}
-				val, found := k.GetValidator(ctx, addr)
-				if !found {
-					panic("validator in the unbonding queue was not found")
-				}
-
-				if !val.IsUnbonding() {
-					panic("unexpected validator in unbonding queue; status was not unbonding")
-				}
-
-				if val.UnbondingOnHoldRefCount == 0 {
-					for _, id := range val.UnbondingIds {
-						k.DeleteUnbondingIndex(ctx, id)
-					}
-
-					val = k.UnbondingToUnbonded(ctx, val)
-
-					if val.GetDelegatorShares().IsZero() {
-						k.RemoveValidator(ctx, val.GetOperator())
-					} else {
-						// remove unbonding ids
-						val.UnbondingIds = []uint64{}
-					}
-
-					// remove validator from queue
-					k.DeleteValidatorQueue(ctx, val)
-				}
+		if mode != types.UnbondingMaturityTimeOnly && keyHeight > endHeight {
+			continue
+		}
+		if mode != types.UnbondingMaturityHeightOnly && keyTime.After(endTime) {
+			continue
+		}
+
+		addrs := types.ValAddresses{}
+		k.cdc.MustUnmarshal(iterator.Value(), &addrs)
+
+		for _, valAddr := range addrs.Addresses {
+			addr, err := sdk.ValAddressFromBech32(valAddr)
+			if err != nil {
+				panic(err)
+			}
+
+			if fn(addr, keyTime, keyHeight) {
+				return
+			}
+		}
+	}
+}
+
+// GetValidatorQueueDepth returns the number of distinct (time, height) slices
+// in the unbonding validator queue and the total number of queued validator
+// addresses.
+func (k Keeper) GetValidatorQueueDepth(ctx sdk.Context) (slices, total uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ValidatorQueueKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		addrs := types.ValAddresses{}
+		k.cdc.MustUnmarshal(iterator.Value(), &addrs)
+
+		slices++
+		total += uint64(len(addrs.Addresses))
+	}
+
+	return slices, total
+}
+
+// GetMatureUnbondingValidators returns the unbonding validators that have
+// finished their unbonding period and are not on hold, in queue order. The
+// unbonding period is finished according to the unbonding maturity mode.
+func (k Keeper) GetMatureUnbondingValidators(ctx sdk.Context) []types.Validator {
+	validators := []types.Validator{}
+	k.iterateMatureValidatorQueue(ctx, k.UnbondingMaturityMode(ctx), ctx.BlockTime(), ctx.BlockHeight(), func(addr sdk.ValAddress, _ time.Time, _ int64) bool {
+		val, found := k.GetValidator(ctx, addr)
+		if !found {
+			panic(types.ErrValidatorQueueEntryNotFound)
+		}
+
+		if !val.IsUnbonding() {
+			panic("unexpected validator in unbonding queue; status was not unbonding")
+		}
+
+		if val.UnbondingOnHoldRefCount == 0 {
+			validators = append(validators, val)
+		}
+
+		return false
+	})
+
+	return validators
+}
+
+// IterateUnbondingValidatorQueue iterates over the validators in the unbonding
+// queue whose unbonding completion time is at or before endTime, in queue
+// order, calling fn with the validator address and its unbonding completion
+// time and height. Iteration stops when fn returns true.
+func (k Keeper) IterateUnbondingValidatorQueue(ctx sdk.Context, endTime time.Time, fn func(valAddr sdk.ValAddress, completionTime time.Time, completionHeight int64) (stop bool)) {
+	// the queue is ordered by time first, every height is in range
+	k.iterateMatureValidatorQueue(ctx, types.UnbondingMaturityHeightAndTime, endTime, gomath.MaxInt64, fn)
+}
+
+// GetValidatorsPendingUnbond returns the unbonding validators whose unbonding
+// completion time falls within the given duration from the current block time,
+// in queue order, along with the estimated completion time and height of their
+// unbonding. Validators on hold may complete later than estimated.
+func (k Keeper) GetValidatorsPendingUnbond(ctx sdk.Context, within time.Duration) []types.PendingUnbondingValidator {
+	pending := []types.PendingUnbondingValidator{}
+	k.IterateUnbondingValidatorQueue(ctx, ctx.BlockTime().Add(within), func(addr sdk.ValAddress, completionTime time.Time, completionHeight int64) bool {
+		validator, found := k.GetValidator(ctx, addr)
+		if !found || !validator.IsUnbonding() {
+			return false
+		}
+
+		pending = append(pending, types.PendingUnbondingValidator{
+			Validator:        validator,
+			CompletionTime:   completionTime,
+			CompletionHeight: completionHeight,
+		})
+
+		return false
+	})
+
+	return pending
+}
+
+// UnbondAllMatureValidators unbonds all the mature unbonding validators that
+// have finished their unbonding period.
+func (k Keeper) UnbondAllMatureValidators(ctx sdk.Context) {
+	if k.lenientQueue() {
+		k.deleteMatureValidatorQueueDanglingEntries(ctx)
+	}
+
+	for _, val := range k.GetMatureUnbondingValidators(ctx) {
+		for _, id := range val.UnbondingIds {
+			k.DeleteUnbondingIndex(ctx, id)
+		}
+
+		val = k.UnbondingToUnbonded(ctx, val)
+
+		if val.GetDelegatorShares().IsZero() {
+			if err := k.RemoveValidator(ctx, val.GetOperator()); err != nil {
+				k.handleRecoverableError(ctx, "failed to remove unbonded validator", err)
 			}
+		} else {
+			// remove unbonding ids
+			val.UnbondingIds = []uint64{}
 		}
+
+		// remove validator from queue
+		k.DeleteValidatorQueue(ctx, val)
+	}
+}
+
+// deleteMatureValidatorQueueDanglingEntries removes the mature unbonding queue
+// entries whose validator does not exist, so that a single inconsistency does
+// not halt block production.
+func (k Keeper) deleteMatureValidatorQueueDanglingEntries(ctx sdk.Context) {
+	type queueEntry struct {
+		addr   sdk.ValAddress
+		time   time.Time
+		height int64
+	}
+
+	var dangling []queueEntry
+	k.iterateMatureValidatorQueue(ctx, k.UnbondingMaturityMode(ctx), ctx.BlockTime(), ctx.BlockHeight(), func(addr sdk.ValAddress, completionTime time.Time, completionHeight int64) bool {
+		if _, found := k.GetValidator(ctx, addr); !found {
+			dangling = append(dangling, queueEntry{addr: addr, time: completionTime, height: completionHeight})
+		}
+
+		return false
+	})
+
+	for _, entry := range dangling {
+		k.Logger(ctx).Error("dropping missing validator from the validator queue", "address", entry.addr.String(), "error", types.ErrValidatorQueueEntryNotFound.Error())
+		k.deleteFromValidatorQueue(ctx, entry.time, entry.height, entry.addr)
 	}
 }
 
@@ -498,6 +1322,10 @@ func (k Keeper) CreateEvmStaking(ctx sdk.Context, msg *types.MsgCreateValidator)
 		logger.Error(err.Error())
 		return nil, err
 	}
+	if err = k.validateBondDenom(ctx, msg.Value.Denom); err != nil {
+		logger.Error("invalid bond denom", "denom", msg.Value.Denom, "error", err.Error())
+		return nil, err
+	}
 	err = k.govCallback(ctx, &sdk.GovEvent{
 		Type: sdk.GovEventCheckValidatorStatus,
 		Data: msg,
@@ -509,7 +1337,7 @@ func (k Keeper) CreateEvmStaking(ctx sdk.Context, msg *types.MsgCreateValidator)
 	//delegate validator tokens to not bonded pool
 	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
 	if err != nil {
-		logger.Error("malformed delegator address '%s'", msg.DelegatorAddress)
+		logger.Error("malformed delegator address", "address", msg.DelegatorAddress, "error", err.Error())
 		return nil, err
 	}
 	delCoins := sdk.NewCoins(sdk.NewCoin(msg.Value.Denom, msg.Value.Amount))
@@ -523,7 +1351,11 @@ func (k Keeper) CreateEvmStaking(ctx sdk.Context, msg *types.MsgCreateValidator)
 	var valAddr sdk.ValAddress
 	valAddr, err = sdk.ValAddressFromBech32(msg.ValidatorAddress)
 	if err != nil {
-		logger.Error("malformed validator address '%s'", msg.ValidatorAddress)
+		logger.Error("malformed validator address", "address", msg.ValidatorAddress, "error", err.Error())
+		// return the delegated coins, nothing else has been written yet
+		if undelErr := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delegatorAddress, delCoins); undelErr != nil {
+			logger.Error("undelegate coins from not bonded pool to account", "error", undelErr.Error())
+		}
 		return nil, err
 	}
 	k.SetCreateValidatorMsgByValAddr(ctx, valAddr, msg)
@@ -534,6 +1366,12 @@ func (k Keeper) CreateEvmStaking(ctx sdk.Context, msg *types.MsgCreateValidator)
 	})
 	if err != nil {
 		logger.Error("set validator status", "error", err.Error())
+		// roll back the delegation so that a failed callback leaves no state behind
+		k.DeleteCreateValidatorMsgByValAddr(ctx, valAddr)
+		if undelErr := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, delegatorAddress, delCoins); undelErr != nil {
+			logger.Error("undelegate coins from not bonded pool to account", "error", undelErr.Error())
+			return nil, sdkerrors.Wrapf(err, "failed to roll back delegation: %s", undelErr)
+		}
 		return nil, err
 	}
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -542,12 +1380,13 @@ func (k Keeper) CreateEvmStaking(ctx sdk.Context, msg *types.MsgCreateValidator)
 			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
 			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
 			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Value.String()),
+			sdk.NewAttribute(types.AttributeKeyValidatorPower, strconv.FormatInt(k.validatorConsensusPower(ctx, valAddr), 10)),
 		),
 	})
 	return &types.MsgCreateValidatorResponse{}, nil
 }
 
-func (k Keeper) createNativeValidator(ctx sdk.Context, msg *types.MsgCreateValidator) (*types.MsgCreateValidatorResponse, error) {
+func (k Keeper) createNativeValidator(ctx sdk.Context, msg *types.MsgCreateValidator, path types.ValidatorCreationPath) (*types.MsgCreateValidatorResponse, error) {
 	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
 	if err != nil {
 		return nil, err
@@ -557,6 +1396,10 @@ func (k Keeper) createNativeValidator(ctx sdk.Context, msg *types.MsgCreateValid
 		return nil, sdkerrors.Wrapf(types.ErrCommissionLTMinRate, "cannot set validator commission to less than minimum rate of %s", k.MinCommissionRate(ctx))
 	}
 
+	if msg.Commission.Rate.GT(k.MaxCommissionRate(ctx)) {
+		return nil, sdkerrors.Wrapf(types.ErrCommissionGTMaxRate, "cannot set validator commission to more than maximum rate of %s", k.MaxCommissionRate(ctx))
+	}
+
 	// check to see if the pubkey or sender has been registered before
 	if _, found := k.GetValidator(ctx, valAddr); found {
 		return nil, types.ErrValidatorOwnerExists
@@ -571,11 +1414,8 @@ func (k Keeper) createNativeValidator(ctx sdk.Context, msg *types.MsgCreateValid
 		return nil, types.ErrValidatorPubKeyExists
 	}
 
-	bondDenom := k.BondDenom(ctx)
-	if msg.Value.Denom != bondDenom {
-		return nil, sdkerrors.Wrapf(
-			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", msg.Value.Denom, bondDenom,
-		)
+	if err := k.validateBondDenom(ctx, msg.Value.Denom); err != nil {
+		return nil, err
 	}
 
 	if _, err := msg.Description.EnsureLength(); err != nil {
@@ -600,6 +1440,22 @@ func (k Keeper) createNativeValidator(ctx sdk.Context, msg *types.MsgCreateValid
 		}
 	}
 
+	if keyTypes := k.AllowedPubKeyTypes(path); keyTypes != nil {
+		hasKeyType := false
+		for _, keyType := range keyTypes {
+			if pk.Type() == keyType {
+				hasKeyType = true
+				break
+			}
+		}
+		if !hasKeyType {
+			return nil, sdkerrors.Wrapf(
+				types.ErrValidatorPubKeyTypeNotSupported,
+				"got: %s, expected: %s for %s validators", pk.Type(), keyTypes, path,
+			)
+		}
+	}
+
 	validator, err := types.NewValidator(valAddr, pk, msg.Description)
 	if err != nil {
 		return nil, err
@@ -622,12 +1478,29 @@ func (k Keeper) createNativeValidator(ctx sdk.Context, msg *types.MsgCreateValid
 
 	validator.MinSelfDelegation = msg.MinSelfDelegation
 
+	// instantly bonded validators skip the unbonded state, the self-delegation
+	// below then goes straight to the bonded pool. Validators that would not
+	// make it into the active set go through the regular unbonded path and are
+	// left to the next validator set update, which also unbonds the instantly
+	// bonded validators pushed out of the set later in the block.
+	instantBond := k.InstantBond(path) && k.hasActiveSetRoom(ctx, msg.Value.Amount)
+	if instantBond {
+		validator.Status = types.Bonded
+	}
+
 	k.SetValidator(ctx, validator)
 	k.SetValidatorByConsAddr(ctx, validator)
 	k.SetNewValidatorByPowerIndex(ctx, validator)
+	k.SetValidatorCreationHeight(ctx, validator.GetOperator(), ctx.BlockHeight())
+	if instantBond {
+		k.setInstantBonded(ctx, validator.GetOperator())
+	}
+	if path == types.ValidatorCreationPathEvm {
+		k.setEvmValidator(ctx, validator.GetOperator())
+	}
 
 	// call the after-creation hook
-	if err := k.Hooks().AfterValidatorCreated(ctx, validator.GetOperator()); err != nil {
+	if err := k.handleHookError(ctx, "after validator created", k.Hooks().AfterValidatorCreated(ctx, validator.GetOperator())); err != nil {
 		return nil, err
 	}
 
@@ -636,9 +1509,16 @@ func (k Keeper) createNativeValidator(ctx sdk.Context, msg *types.MsgCreateValid
 	// NOTE source will always be from a wallet which are unbonded
 	_, err = k.Delegate(ctx, delegatorAddress, msg.Value.Amount, types.Unbonded, validator, true)
 	if err != nil {
+		k.removeUndelegatedValidator(ctx, validator)
 		return nil, err
 	}
 
+	if instantBond {
+		if err := k.handleHookError(ctx, "after validator bonded", k.Hooks().AfterValidatorBonded(ctx, sdk.GetConsAddress(pk), validator.GetOperator())); err != nil {
+			return nil, err
+		}
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeCreateValidator,
@@ -650,11 +1530,70 @@ func (k Keeper) createNativeValidator(ctx sdk.Context, msg *types.MsgCreateValid
 	return &types.MsgCreateValidatorResponse{}, nil
 }
 
+// hasActiveSetRoom returns whether a validator bonding the given tokens fits
+// in the active set right away: it must carry consensus power and the bonded
+// set must be below MaxValidators.
+func (k Keeper) hasActiveSetRoom(ctx sdk.Context, tokens math.Int) bool {
+	if sdk.TokensToConsensusPower(tokens, k.PowerReduction(ctx)) <= 0 {
+		return false
+	}
+
+	bonded := uint32(0)
+	k.IterateBondedValidatorsByPower(ctx, func(_ int64, _ types.ValidatorI) bool {
+		bonded++
+		return false
+	})
+
+	return bonded < k.MaxValidators(ctx)
+}
+
+// validateBondDenom returns ErrInvalidBondDenom if denom is not the bond denom.
+func (k Keeper) validateBondDenom(ctx sdk.Context, denom string) error {
+	bondDenom := k.BondDenom(ctx)
+	if denom != bondDenom {
+		return sdkerrors.Wrapf(types.ErrInvalidBondDenom, "got %s, expected %s", denom, bondDenom)
+	}
+
+	return nil
+}
+
+// removeUndelegatedValidator deletes a validator whose self-delegation failed
+// right after creation, together with its indexes, and reverses the creation
+// hook so that no phantom validator is left behind.
+func (k Keeper) removeUndelegatedValidator(ctx sdk.Context, validator types.Validator) {
+	// the failed delegation may already have updated the stored validator
+	if stored, found := k.GetValidator(ctx, validator.GetOperator()); found {
+		validator = stored
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetValidatorKey(validator.GetOperator()))
+	store.Delete(types.GetValidatorsByPowerIndexKey(validator, k.PowerReduction(ctx)))
+	store.Delete(types.GetValidatorCreationHeightKey(validator.GetOperator()))
+	store.Delete(types.GetValidatorPowerHistoryKey(validator.GetOperator()))
+	store.Delete(types.GetValidatorJailHistoryKey(validator.GetOperator()))
+	store.Delete(types.GetEvmValidatorKey(validator.GetOperator()))
+
+	valConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		k.Logger(ctx).Error("failed to get consensus address of validator", "validator", validator.GetOperator().String(), "error", err.Error())
+		return
+	}
+	store.Delete(types.GetValidatorByConsAddrKey(valConsAddr))
+
+	if err := k.Hooks().AfterValidatorRemoved(ctx, valConsAddr, validator.GetOperator()); err != nil {
+		k.Logger(ctx).Error("failed to call after validator removed hook", "validator", validator.GetOperator().String(), "error", err.Error())
+	}
+}
+
 // create validator message set
 func (k Keeper) GetCreateValidatorMsgByValAddr(ctx sdk.Context, valAddr sdk.ValAddress) *types.MsgCreateValidator {
 	var msg types.MsgCreateValidator
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(valAddr.Bytes())
+	bz := store.Get(types.GetPendingEvmValidatorKey(valAddr))
+	if bz == nil {
+		return nil
+	}
 	err := k.cdc.Unmarshal(bz, &msg)
 	if err != nil {
 		return nil
@@ -666,10 +1605,55 @@ func (k Keeper) GetCreateValidatorMsgByValAddr(ctx sdk.Context, valAddr sdk.ValA
 func (k Keeper) SetCreateValidatorMsgByValAddr(ctx sdk.Context, valAddr sdk.ValAddress, msg *types.MsgCreateValidator) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(msg)
-	store.Set(valAddr.Bytes(), bz)
+	store.Set(types.GetPendingEvmValidatorKey(valAddr), bz)
+}
+
+// delete the stored create validator message
+func (k Keeper) DeleteCreateValidatorMsgByValAddr(ctx sdk.Context, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingEvmValidatorKey(valAddr))
+}
+
+// GetValidatorsPendingEvmCreation returns the stored create validator
+// messages of the EVM validators that have not been created yet.
+func (k Keeper) GetValidatorsPendingEvmCreation(ctx sdk.Context) (msgs []types.MsgCreateValidator) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingEvmValidatorKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var msg types.MsgCreateValidator
+		k.cdc.MustUnmarshal(iterator.Value(), &msg)
+		msgs = append(msgs, msg)
+	}
+
+	return msgs
+}
+
+// QueryEvmValidatorStatus returns the status of the validator reported by the
+// EVM contract, without attempting to create the validator.
+func (k Keeper) QueryEvmValidatorStatus(ctx sdk.Context, valAddr sdk.ValAddress) (string, error) {
+	if k.govCallback == nil {
+		return "", fmt.Errorf("evm callback not set")
+	}
+
+	query := &sdk.ValidatorStatusQuery{ValidatorAddress: valAddr.String()}
+	err := k.govCallback(ctx, &sdk.GovEvent{
+		Type: sdk.GovEventQueryValidatorStatus,
+		Data: query,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return query.Status, nil
 }
 
 func (k Keeper) CreateEvmValidator(ctx sdk.Context, valAddr sdk.ValAddress) (*types.MsgCreateValidatorResponse, error) {
+	// duplicate EVM events must not release the escrow of a created validator
+	if _, found := k.GetValidator(ctx, valAddr); found {
+		return nil, types.ErrValidatorOwnerExists
+	}
 	msg := k.GetCreateValidatorMsgByValAddr(ctx, valAddr)
 	if msg == nil {
 		return nil, fmt.Errorf("create validator error: message is nil")
@@ -683,5 +1667,11 @@ func (k Keeper) CreateEvmValidator(ctx sdk.Context, valAddr sdk.ValAddress) (*ty
 	if err != nil {
 		return nil, err
 	}
-	return k.createNativeValidator(ctx, msg)
+	res, err := k.createNativeValidator(ctx, msg, types.ValidatorCreationPathEvm)
+	if err != nil {
+		return nil, err
+	}
+	// the registration is complete, a retry finds nothing to create
+	k.DeleteCreateValidatorMsgByValAddr(ctx, valAddr)
+	return res, nil
 }
@@ -1,12 +1,27 @@
 package keeper_test
 
 import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
 	"time"
 
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/golang/mock/gomock"
 
 	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
@@ -173,9 +188,9 @@ func (s *KeeperTestSuite) TestValidatorBasics() {
 	require.PanicsWithValue("attempting to remove a validator which still contains tokens",
 		func() { keeper.RemoveValidator(ctx, validators[1].GetOperator()) })
 
-	validators[1].Tokens = math.ZeroInt()                    // ...remove all tokens
-	keeper.SetValidator(ctx, validators[1])                  // ...set the validator
-	keeper.RemoveValidator(ctx, validators[1].GetOperator()) // Now it can be removed.
+	validators[1].Tokens = math.ZeroInt()                                     // ...remove all tokens
+	keeper.SetValidator(ctx, validators[1])                                   // ...set the validator
+	require.NoError(keeper.RemoveValidator(ctx, validators[1].GetOperator())) // Now it can be removed.
 	_, found = keeper.GetValidator(ctx, sdk.ValAddress(PKs[1].Address().Bytes()))
 	require.False(found)
 }
@@ -267,6 +282,128 @@ func (s *KeeperTestSuite) TestApplyAndReturnValidatorSetUpdatesPowerDecrease() {
 	require.Equal(validators[1].ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[1])
 }
 
+// powerIndexWrites returns the number of writes and deletes of power index
+// entries in the store trace.
+func powerIndexWrites(s *KeeperTestSuite, trace *bytes.Buffer) int {
+	writes := 0
+	decoder := json.NewDecoder(trace)
+	for decoder.More() {
+		var op struct {
+			Operation string `json:"operation"`
+			Key       string `json:"key"`
+		}
+		s.Require().NoError(decoder.Decode(&op))
+
+		key, err := base64.StdEncoding.DecodeString(op.Key)
+		s.Require().NoError(err)
+		if (op.Operation == "write" || op.Operation == "delete") && bytes.HasPrefix(key, stakingtypes.ValidatorsByPowerIndexKey) {
+			writes++
+		}
+	}
+
+	return writes
+}
+
+func (s *KeeperTestSuite) TestAddValidatorTokensSkipsUnchangedPowerIndex() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0])
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByPowerIndex(ctx, validator)
+
+	trace := new(bytes.Buffer)
+	ctx.MultiStore().SetTracer(trace)
+	defer ctx.MultiStore().SetTracer(nil)
+
+	// a token change below one power unit keeps the power index entry as is
+	validator, _ = keeper.AddValidatorTokensAndShares(ctx, validator, math.OneInt())
+	require.Zero(powerIndexWrites(s, trace))
+	validator, _ = keeper.RemoveValidatorTokensAndShares(ctx, validator, math.LegacyOneDec())
+	require.Zero(powerIndexWrites(s, trace))
+
+	// a whole power unit moves the entry
+	validator, _ = keeper.AddValidatorTokensAndShares(ctx, validator, keeper.PowerReduction(ctx))
+	require.Equal(2, powerIndexWrites(s, trace))
+
+	iterator := keeper.ValidatorsPowerStoreIterator(ctx)
+	defer iterator.Close()
+	require.True(iterator.Valid())
+	require.Equal(stakingtypes.GetValidatorsByPowerIndexKey(validator, keeper.PowerReduction(ctx)), iterator.Key())
+	iterator.Next()
+	require.False(iterator.Valid())
+}
+
+func (s *KeeperTestSuite) TestPowerIndexEvents() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+	keeper.SetValidator(ctx, validator)
+
+	// no events are emitted by default
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	keeper.SetValidatorByPowerIndex(ctx, validator)
+	require.Empty(ctx.EventManager().Events())
+
+	keeper.SetPowerIndexEvents(true)
+	defer keeper.SetPowerIndexEvents(false)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	keeper.AddValidatorTokensAndShares(ctx, validator, keeper.PowerReduction(ctx))
+
+	var events sdk.Events
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == stakingtypes.EventTypeValidatorPowerIndexSet || event.Type == stakingtypes.EventTypeValidatorPowerIndexDeleted {
+			events = append(events, event)
+		}
+	}
+
+	require.Equal(sdk.Events{
+		sdk.NewEvent(
+			stakingtypes.EventTypeValidatorPowerIndexDeleted,
+			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(stakingtypes.AttributeKeyValidatorPower, "10"),
+		),
+		sdk.NewEvent(
+			stakingtypes.EventTypeValidatorPowerIndexSet,
+			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(stakingtypes.AttributeKeyValidatorPower, "11"),
+		),
+	}, events)
+}
+
+func BenchmarkAddValidatorTokensAndSharesSubPowerUnit(b *testing.B) {
+	key := sdk.NewKVStoreKey(stakingtypes.StoreKey)
+	tkey := sdk.NewTransientStoreKey(stakingtypes.TStoreKey)
+	ctx := sdktestutil.DefaultContext(key, tkey)
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	ctrl := gomock.NewController(b)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(stakingtypes.BondedPoolName).Return(bondedAcc.GetAddress())
+	accountKeeper.EXPECT().GetModuleAddress(stakingtypes.NotBondedPoolName).Return(notBondedAcc.GetAddress())
+	keeper := stakingkeeper.NewKeeper(
+		encCfg.Codec, key, tkey, accountKeeper, testutil.NewMockBankKeeper(ctrl),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	keeper.SetParams(ctx, stakingtypes.DefaultParams())
+
+	validator := testutil.NewValidator(b, sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0])
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByPowerIndex(ctx, validator)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		validator, _ = keeper.AddValidatorTokensAndShares(ctx, validator, math.OneInt())
+	}
+}
+
 func (s *KeeperTestSuite) TestUpdateValidatorCommission() {
 	ctx, keeper := s.ctx, s.stakingKeeper
 	require := s.Require()
@@ -330,6 +467,118 @@ func (s *KeeperTestSuite) TestUpdateValidatorCommission() {
 	}
 }
 
+func (s *KeeperTestSuite) TestUpdateValidatorCommissionMaxCommissionRate() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	params := keeper.GetParams(ctx)
+	maxRate := sdk.NewDecWithPrec(2, 1)
+	params.MaxCommissionRate = &maxRate
+	require.NoError(keeper.SetParams(ctx, params))
+
+	val := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0])
+	val, _ = val.SetInitialCommission(stakingtypes.NewCommissionWithTime(
+		sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1), ctx.BlockTime().Add(-params.CommissionChangeInterval),
+	))
+	keeper.SetValidator(ctx, val)
+
+	testCases := []struct {
+		name    string
+		newRate sdk.Dec
+		expErr  bool
+	}{
+		{"just below the ceiling", sdk.NewDecWithPrec(19, 2), false},
+		{"at the ceiling", sdk.NewDecWithPrec(2, 1), false},
+		{"above the ceiling", sdk.NewDecWithPrec(21, 2), true},
+	}
+
+	for _, tc := range testCases {
+		commission, err := keeper.UpdateValidatorCommission(ctx, val, tc.newRate)
+		if tc.expErr {
+			require.ErrorIs(err, stakingtypes.ErrCommissionGTMaxRate, tc.name)
+			continue
+		}
+		require.NoError(err, tc.name)
+		require.Equal(tc.newRate, commission.Rate, tc.name)
+	}
+}
+
+func (s *KeeperTestSuite) TestCreateValidatorMaxCommissionRate() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	params := keeper.GetParams(ctx)
+	maxRate := sdk.NewDecWithPrec(2, 1)
+	params.MaxCommissionRate = &maxRate
+	require.NoError(keeper.SetParams(ctx, params))
+	bondCoin := sdk.NewCoin(sdk.DefaultBondDenom, params.MinBondAmount.TruncateInt())
+
+	testCases := []struct {
+		name   string
+		rate   sdk.Dec
+		expErr bool
+	}{
+		{"just below the ceiling", sdk.NewDecWithPrec(19, 2), false},
+		{"at the ceiling", sdk.NewDecWithPrec(2, 1), false},
+		{"above the ceiling", sdk.NewDecWithPrec(21, 2), true},
+	}
+
+	for i, tc := range testCases {
+		valAddr := sdk.ValAddress(PKs[i].Address().Bytes())
+		msg, err := stakingtypes.NewMsgCreateValidator(
+			valAddr, PKs[i], bondCoin, stakingtypes.Description{Moniker: "native"},
+			stakingtypes.NewCommissionRates(tc.rate, math.LegacyOneDec(), math.LegacyZeroDec()), math.OneInt(),
+		)
+		require.NoError(err)
+
+		if tc.expErr {
+			_, err = s.msgServer.CreateValidator(ctx, msg)
+			require.ErrorIs(err, stakingtypes.ErrCommissionGTMaxRate, tc.name)
+			_, found := keeper.GetValidator(ctx, valAddr)
+			require.False(found, tc.name)
+			continue
+		}
+
+		s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(valAddr), stakingtypes.NotBondedPoolName, sdk.NewCoins(bondCoin)).Return(nil)
+		_, err = s.msgServer.CreateValidator(ctx, msg)
+		require.NoError(err, tc.name)
+		validator, found := keeper.GetValidator(ctx, valAddr)
+		require.True(found, tc.name)
+		require.Equal(tc.rate, validator.Commission.Rate, tc.name)
+	}
+}
+
+func (s *KeeperTestSuite) TestUpdateValidatorCommissionChangeInterval() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	params := keeper.GetParams(ctx)
+	params.CommissionChangeInterval = time.Hour
+	require.NoError(keeper.SetParams(ctx, params))
+
+	updateTime := time.Unix(1000, 0).UTC()
+	val := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0])
+	val, _ = val.SetInitialCommission(stakingtypes.NewCommissionWithTime(
+		sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(1, 1), updateTime,
+	))
+	keeper.SetValidator(ctx, val)
+
+	// too early
+	ctx = ctx.WithBlockTime(updateTime.Add(30 * time.Minute))
+	_, err := keeper.UpdateValidatorCommission(ctx, val, sdk.NewDecWithPrec(2, 1))
+	require.ErrorIs(err, stakingtypes.ErrCommissionUpdateTime)
+
+	// the max change rate is still enforced
+	ctx = ctx.WithBlockTime(updateTime.Add(time.Hour))
+	_, err = keeper.UpdateValidatorCommission(ctx, val, sdk.NewDecWithPrec(3, 1))
+	require.ErrorIs(err, stakingtypes.ErrCommissionGTMaxChangeRate)
+
+	commission, err := keeper.UpdateValidatorCommission(ctx, val, sdk.NewDecWithPrec(2, 1))
+	require.NoError(err)
+	require.Equal(sdk.NewDecWithPrec(2, 1), commission.Rate)
+	require.Equal(updateTime.Add(time.Hour), commission.UpdateTime)
+}
+
 func (s *KeeperTestSuite) TestValidatorToken() {
 	ctx, keeper := s.ctx, s.stakingKeeper
 	require := s.Require()
@@ -355,6 +604,32 @@ func (s *KeeperTestSuite) TestValidatorToken() {
 	require.True(validator.Tokens.IsZero())
 }
 
+func (s *KeeperTestSuite) TestRemoveAllValidatorTokensPowerIndex() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	valTokens := keeper.TokensFromConsensusPower(ctx, 10)
+
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, _ = validator.AddTokensFromDel(valTokens)
+	validator = validator.UpdateStatus(stakingtypes.Bonded)
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByPowerIndex(ctx, validator)
+	require.True(stakingkeeper.ValidatorByPowerIndexExists(ctx, keeper, stakingtypes.GetValidatorsByPowerIndexKey(validator, keeper.PowerReduction(ctx))))
+
+	validator = keeper.RemoveValidatorTokens(ctx, validator, valTokens)
+	require.True(validator.Tokens.IsZero())
+
+	iterator := keeper.ValidatorsPowerStoreIterator(ctx)
+	defer iterator.Close()
+	require.False(iterator.Valid(), "stale zero power index entry")
+
+	stored, found := keeper.GetValidator(ctx, valAddr)
+	require.True(found)
+	require.True(stored.Tokens.IsZero())
+}
+
 func (s *KeeperTestSuite) TestUnbondingValidator() {
 	ctx, keeper := s.ctx, s.stakingKeeper
 	require := s.Require()
@@ -391,7 +666,7 @@ func (s *KeeperTestSuite) TestUnbondingValidator() {
 
 	// check unbonding mature validators
 	ctx = ctx.WithBlockHeight(endHeight).WithBlockTime(endTime)
-	require.PanicsWithValue("validator in the unbonding queue was not found", func() {
+	require.PanicsWithError(stakingtypes.ErrValidatorQueueEntryNotFound.Error(), func() {
 		keeper.UnbondAllMatureValidators(ctx)
 	})
 
@@ -417,3 +692,1298 @@ func (s *KeeperTestSuite) TestUnbondingValidator() {
 	require.True(found)
 	require.Equal(stakingtypes.Unbonded, validator.Status)
 }
+
+func (s *KeeperTestSuite) TestCreateEvmStakingFailures() {
+	require := s.Require()
+
+	valPubKey := PKs[0]
+	valAddr := sdk.ValAddress(valPubKey.Address().Bytes())
+	delAddr := sdk.AccAddress(valAddr)
+	bondCoin := sdk.NewCoin(sdk.DefaultBondDenom, s.stakingKeeper.TokensFromConsensusPower(s.ctx, 10))
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr, valPubKey, bondCoin, stakingtypes.Description{Moniker: "evm"},
+		stakingtypes.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()), math.OneInt(),
+	)
+	require.NoError(err)
+
+	var callbackErr error
+	s.stakingKeeper.SetEvmCallback(func(_ sdk.Context, e *sdk.GovEvent) error {
+		if e.Type == sdk.GovEventSetValidatorStatus {
+			return callbackErr
+		}
+		return nil
+	})
+
+	// insufficient delegator balance returns a clean error and leaves no state behind
+	var buf bytes.Buffer
+	ctx := s.ctx.WithLogger(log.NewTMLogger(log.NewSyncWriter(&buf)))
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), delAddr, stakingtypes.NotBondedPoolName, sdk.NewCoins(bondCoin)).
+		Return(sdkerrors.ErrInsufficientFunds)
+	_, err = s.stakingKeeper.CreateEvmStaking(ctx, msg)
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+	require.Nil(s.stakingKeeper.GetCreateValidatorMsgByValAddr(ctx, valAddr))
+	require.Contains(buf.String(), "delegate coins from account to not bonded pool")
+
+	// malformed delegator addresses are logged as key-value pairs
+	buf.Reset()
+	badMsg := *msg
+	badMsg.DelegatorAddress = "invalid"
+	_, err = s.stakingKeeper.CreateEvmStaking(ctx, &badMsg)
+	require.Error(err)
+	require.Contains(buf.String(), "malformed delegator address")
+	require.Contains(buf.String(), "address=invalid")
+	require.NotContains(buf.String(), "%s")
+
+	// a failing set validator status callback undelegates the coins again
+	callbackErr = errors.New("evm failure")
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), delAddr, stakingtypes.NotBondedPoolName, sdk.NewCoins(bondCoin)).
+		Return(nil)
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), stakingtypes.NotBondedPoolName, delAddr, sdk.NewCoins(bondCoin)).
+		Return(nil)
+	_, err = s.stakingKeeper.CreateEvmStaking(ctx, msg)
+	require.ErrorIs(err, callbackErr)
+	require.Nil(s.stakingKeeper.GetCreateValidatorMsgByValAddr(ctx, valAddr))
+}
+
+func (s *KeeperTestSuite) TestCreateEvmValidatorTwice() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	valPubKey := PKs[0]
+	valAddr := sdk.ValAddress(valPubKey.Address().Bytes())
+	delAddr := sdk.AccAddress(valAddr)
+	bondCoin := sdk.NewCoin(sdk.DefaultBondDenom, keeper.TokensFromConsensusPower(ctx, 10))
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr, valPubKey, bondCoin, stakingtypes.Description{Moniker: "evm"},
+		stakingtypes.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()), math.OneInt(),
+	)
+	require.NoError(err)
+	keeper.SetCreateValidatorMsgByValAddr(ctx, valAddr, msg)
+
+	// the escrow is released and delegated once
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), stakingtypes.NotBondedPoolName, delAddr, sdk.NewCoins(bondCoin)).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), delAddr, stakingtypes.NotBondedPoolName, sdk.NewCoins(bondCoin)).Return(nil).Times(1)
+	_, err = keeper.CreateEvmValidator(ctx, valAddr)
+	require.NoError(err)
+	require.Nil(keeper.GetCreateValidatorMsgByValAddr(ctx, valAddr))
+
+	// a duplicate EVM event fails without moving any coins
+	_, err = keeper.CreateEvmValidator(ctx, valAddr)
+	require.ErrorIs(err, stakingtypes.ErrValidatorOwnerExists)
+
+	validator, found := keeper.GetValidator(ctx, valAddr)
+	require.True(found)
+	require.Equal(bondCoin.Amount, validator.Tokens)
+}
+
+func (s *KeeperTestSuite) TestGetValidatorsModifiedThisBlock() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	require.Empty(keeper.GetValidatorsModifiedThisBlock(ctx))
+
+	var valAddrs []sdk.ValAddress
+	for i := 0; i < 2; i++ {
+		valAddr := sdk.ValAddress(PKs[i].Address().Bytes())
+		validator := testutil.NewValidator(s.T(), valAddr, PKs[i])
+		keeper.SetValidator(ctx, validator)
+		valAddrs = append(valAddrs, valAddr)
+	}
+
+	// setting the same validator again must not report it twice
+	validator, found := keeper.GetValidator(ctx, valAddrs[0])
+	require.True(found)
+	keeper.SetValidator(ctx, validator)
+
+	modified := keeper.GetValidatorsModifiedThisBlock(ctx)
+	require.Len(modified, 2)
+	require.ElementsMatch(valAddrs, modified)
+}
+
+func (s *KeeperTestSuite) TestUnbondingValidatorsQueueOrder() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	endTime := time.Now()
+	endHeight := int64(10)
+
+	valAddrs := make([]sdk.ValAddress, 4)
+	for i := range valAddrs {
+		valAddrs[i] = sdk.ValAddress(PKs[i].Address().Bytes())
+	}
+	sorted := make([]sdk.ValAddress, len(valAddrs))
+	copy(sorted, valAddrs)
+	sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i], sorted[j]) < 0 })
+
+	// insert out of order
+	unsorted := []string{sorted[2].String(), sorted[0].String(), sorted[3].String(), sorted[1].String()}
+	keeper.SetUnbondingValidatorsQueue(ctx, endTime, endHeight, unsorted)
+
+	expected := []string{sorted[0].String(), sorted[1].String(), sorted[2].String(), sorted[3].String()}
+	require.Equal(expected, keeper.GetUnbondingValidators(ctx, endTime, endHeight))
+
+	// removing an address keeps the remaining ones sorted
+	validator := testutil.NewValidator(s.T(), sorted[1], PKs[0])
+	validator.UnbondingTime = endTime
+	validator.UnbondingHeight = endHeight
+	keeper.DeleteValidatorQueue(ctx, validator)
+	require.Equal([]string{sorted[0].String(), sorted[2].String(), sorted[3].String()}, keeper.GetUnbondingValidators(ctx, endTime, endHeight))
+}
+
+// failingRemovalHooks is a no-op staking hook that fails on AfterValidatorRemoved.
+type failingRemovalHooks struct {
+	stakingtypes.MultiStakingHooks
+}
+
+func (failingRemovalHooks) AfterValidatorRemoved(sdk.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return errors.New("hook failed")
+}
+
+func (s *KeeperTestSuite) TestRemoveValidatorHookErrorPolicy() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	keeper.SetHooks(failingRemovalHooks{})
+	require.Equal(stakingtypes.HookErrorPolicyDefault, keeper.HookErrorPolicy())
+
+	newUnbondedValidator := func(i int) sdk.ValAddress {
+		valAddr := sdk.ValAddress(PKs[i].Address().Bytes())
+		validator := testutil.NewValidator(s.T(), valAddr, PKs[i])
+		keeper.SetValidator(ctx, validator)
+		return valAddr
+	}
+
+	// the removal hook error is only logged by default
+	valAddr := newUnbondedValidator(0)
+	require.NoError(keeper.RemoveValidator(ctx, valAddr))
+	_, found := keeper.GetValidator(ctx, valAddr)
+	require.False(found)
+
+	// propagate surfaces the hook error
+	keeper.SetHookErrorPolicy(stakingtypes.HookErrorPolicyPropagate)
+	valAddr = newUnbondedValidator(1)
+	require.EqualError(keeper.RemoveValidator(ctx, valAddr), "hook failed")
+
+	// log and continue swallows the hook error and the validator is removed
+	keeper.SetHookErrorPolicy(stakingtypes.HookErrorPolicyLogAndContinue)
+	valAddr = newUnbondedValidator(2)
+	require.NoError(keeper.RemoveValidator(ctx, valAddr))
+	_, found = keeper.GetValidator(ctx, valAddr)
+	require.False(found)
+}
+
+// failingUnbondingHooks is a no-op staking hook that fails on
+// AfterUnbondingInitiated.
+type failingUnbondingHooks struct {
+	stakingtypes.MultiStakingHooks
+}
+
+func (failingUnbondingHooks) AfterUnbondingInitiated(sdk.Context, uint64) error {
+	return errors.New("hook failed")
+}
+
+func (s *KeeperTestSuite) TestUnbondingInitiatedHookErrorPolicy() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	keeper.SetHooks(failingUnbondingHooks{})
+	delAddr := sdk.AccAddress(PKs[0].Address())
+	valAddr := sdk.ValAddress(PKs[1].Address())
+
+	// the hook error is only logged by default, so it cannot halt EndBlock
+	require.NotPanics(func() {
+		keeper.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, ctx.BlockHeight(), ctx.BlockTime(), math.OneInt())
+	})
+
+	keeper.SetHookErrorPolicy(stakingtypes.HookErrorPolicyPropagate)
+	require.PanicsWithError("hook failed", func() {
+		keeper.SetUnbondingDelegationEntry(ctx, delAddr, valAddr, ctx.BlockHeight(), ctx.BlockTime(), math.OneInt())
+	})
+}
+
+// removalRecordingHooks is a no-op staking hook that records removed validators.
+type removalRecordingHooks struct {
+	stakingtypes.MultiStakingHooks
+	removed *[]sdk.ValAddress
+}
+
+func (h removalRecordingHooks) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, valAddr sdk.ValAddress) error {
+	*h.removed = append(*h.removed, valAddr)
+	return nil
+}
+
+func (s *KeeperTestSuite) TestCreateValidatorDelegateFailureCleanup() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	var removed []sdk.ValAddress
+	keeper.SetHooks(removalRecordingHooks{removed: &removed})
+
+	valPubKey := PKs[0]
+	valAddr := sdk.ValAddress(valPubKey.Address().Bytes())
+	bondCoin := sdk.NewCoin(sdk.DefaultBondDenom, keeper.GetParams(ctx).MinBondAmount.TruncateInt())
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr, valPubKey, bondCoin, stakingtypes.Description{Moniker: "native"},
+		stakingtypes.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()), math.OneInt(),
+	)
+	require.NoError(err)
+
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(valAddr), stakingtypes.NotBondedPoolName, sdk.NewCoins(bondCoin)).
+		Return(sdkerrors.ErrInsufficientFunds)
+	_, err = s.msgServer.CreateValidator(ctx, msg)
+	require.ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// no validator record or index remains and the creation hook is reversed
+	_, found := keeper.GetValidator(ctx, valAddr)
+	require.False(found)
+	_, found = keeper.GetValidatorByConsAddr(ctx, sdk.GetConsAddress(valPubKey))
+	require.False(found)
+	iterator := keeper.ValidatorsPowerStoreIterator(ctx)
+	require.False(iterator.Valid())
+	iterator.Close()
+	require.Equal([]sdk.ValAddress{valAddr}, removed)
+}
+
+func (s *KeeperTestSuite) TestCreateValidatorInstantBond() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	valPubKey := PKs[0]
+	valAddr := sdk.ValAddress(valPubKey.Address().Bytes())
+	bondCoin := sdk.NewCoin(sdk.DefaultBondDenom, keeper.GetParams(ctx).MinBondAmount.TruncateInt())
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr, valPubKey, bondCoin, stakingtypes.Description{Moniker: "instant"},
+		stakingtypes.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()), math.OneInt(),
+	)
+	require.NoError(err)
+
+	// disabled by default
+	require.False(keeper.InstantBond(stakingtypes.ValidatorCreationPathNative))
+	keeper.SetInstantBond(stakingtypes.ValidatorCreationPathNative, true)
+	require.True(keeper.InstantBond(stakingtypes.ValidatorCreationPathNative))
+	require.False(keeper.InstantBond(stakingtypes.ValidatorCreationPathEvm))
+
+	// the self-delegation goes straight to the bonded pool
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(valAddr), stakingtypes.BondedPoolName, sdk.NewCoins(bondCoin)).Return(nil)
+	_, err = s.msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	validator, found := keeper.GetValidator(ctx, valAddr)
+	require.True(found)
+	require.Equal(stakingtypes.Bonded, validator.GetStatus())
+	require.Equal(bondCoin.Amount, validator.Tokens)
+	require.Equal(math.LegacyNewDecFromInt(bondCoin.Amount), validator.DelegatorShares)
+
+	keeper.SetInstantBond(stakingtypes.ValidatorCreationPathNative, false)
+	require.False(keeper.InstantBond(stakingtypes.ValidatorCreationPathNative))
+}
+
+func (s *KeeperTestSuite) TestCreateValidatorInstantBondFullSet() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	params := keeper.GetParams(ctx)
+	params.MaxValidators = 1
+	require.NoError(keeper.SetParams(ctx, params))
+
+	// fill the active set
+	bonded := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[1].Address().Bytes()), PKs[1])
+	bonded, _ = bonded.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+	bonded = bonded.UpdateStatus(stakingtypes.Bonded)
+	keeper.SetValidator(ctx, bonded)
+	keeper.SetValidatorByPowerIndex(ctx, bonded)
+
+	valPubKey := PKs[0]
+	valAddr := sdk.ValAddress(valPubKey.Address().Bytes())
+	bondCoin := sdk.NewCoin(sdk.DefaultBondDenom, params.MinBondAmount.TruncateInt())
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr, valPubKey, bondCoin, stakingtypes.Description{Moniker: "instant"},
+		stakingtypes.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()), math.OneInt(),
+	)
+	require.NoError(err)
+
+	keeper.SetInstantBond(stakingtypes.ValidatorCreationPathNative, true)
+
+	// no room left, the self-delegation goes to the not bonded pool
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(valAddr), stakingtypes.NotBondedPoolName, sdk.NewCoins(bondCoin)).Return(nil)
+	_, err = s.msgServer.CreateValidator(ctx, msg)
+	require.NoError(err)
+
+	validator, found := keeper.GetValidator(ctx, valAddr)
+	require.True(found)
+	require.Equal(stakingtypes.Unbonded, validator.GetStatus())
+	require.Equal(bondCoin.Amount, validator.Tokens)
+}
+
+func (s *KeeperTestSuite) TestCreateValidatorInstantBondPushedOut() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	params := keeper.GetParams(ctx)
+	params.MaxValidators = 1
+	require.NoError(keeper.SetParams(ctx, params))
+	keeper.SetInstantBond(stakingtypes.ValidatorCreationPathNative, true)
+
+	createValidator := func(i int, amount math.Int, pool string) sdk.ValAddress {
+		valAddr := sdk.ValAddress(PKs[i].Address().Bytes())
+		bondCoin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+		msg, err := stakingtypes.NewMsgCreateValidator(
+			valAddr, PKs[i], bondCoin, stakingtypes.Description{Moniker: "instant"},
+			stakingtypes.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()), math.OneInt(),
+		)
+		require.NoError(err)
+		s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(valAddr), pool, sdk.NewCoins(bondCoin)).Return(nil)
+		_, err = s.msgServer.CreateValidator(ctx, msg)
+		require.NoError(err)
+		return valAddr
+	}
+
+	// the first validator gets the only seat, the second one with more power is
+	// created in the same block once the seat is taken
+	lowAmount := params.MinBondAmount.TruncateInt()
+	highAmount := lowAmount.Add(keeper.TokensFromConsensusPower(ctx, 10))
+	low := createValidator(0, lowAmount, stakingtypes.BondedPoolName)
+	high := createValidator(1, highAmount, stakingtypes.NotBondedPoolName)
+
+	validator, found := keeper.GetValidator(ctx, low)
+	require.True(found)
+	require.Equal(stakingtypes.Bonded, validator.GetStatus())
+
+	// the set update bonds the second validator and unbonds the first one,
+	// moving the difference between their tokens to the bonded pool
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, highAmount.Sub(lowAmount))))
+	updates, err := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(err)
+
+	// only the validator in the set is reported, the first one never was
+	require.Len(updates, 1)
+	bonded, found := keeper.GetValidator(ctx, high)
+	require.True(found)
+	require.Equal(stakingtypes.Bonded, bonded.GetStatus())
+	require.Equal(bonded.ABCIValidatorUpdate(keeper.PowerReduction(ctx)), updates[0])
+	require.Equal([]stakingtypes.Validator{bonded}, keeper.GetLastValidators(ctx))
+
+	validator, found = keeper.GetValidator(ctx, low)
+	require.True(found)
+	require.Equal(stakingtypes.Unbonding, validator.GetStatus())
+	require.Zero(keeper.GetLastValidatorPower(ctx, low))
+}
+
+func (s *KeeperTestSuite) TestIterateMatureValidatorQueue() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	endTime := time.Now()
+	endHeight := int64(10)
+
+	valAddrs := make([]sdk.ValAddress, 4)
+	for i := range valAddrs {
+		valAddrs[i] = sdk.ValAddress(PKs[i].Address().Bytes())
+	}
+
+	// matured by both time and height
+	keeper.SetUnbondingValidatorsQueue(ctx, endTime.Add(-time.Hour), endHeight-5, []string{valAddrs[0].String()})
+	keeper.SetUnbondingValidatorsQueue(ctx, endTime, endHeight, []string{valAddrs[1].String()})
+	// matured by time only
+	keeper.SetUnbondingValidatorsQueue(ctx, endTime.Add(-time.Hour), endHeight+5, []string{valAddrs[2].String()})
+	// matured by height only
+	keeper.SetUnbondingValidatorsQueue(ctx, endTime.Add(time.Hour), endHeight-5, []string{valAddrs[3].String()})
+
+	var visited []sdk.ValAddress
+	keeper.IterateMatureValidatorQueue(ctx, endTime, endHeight, func(valAddr sdk.ValAddress, completionTime time.Time) bool {
+		require.False(completionTime.After(endTime))
+		visited = append(visited, valAddr)
+		return false
+	})
+	require.Equal([]sdk.ValAddress{valAddrs[0], valAddrs[1]}, visited)
+
+	// returning true stops the iteration
+	visited = nil
+	keeper.IterateMatureValidatorQueue(ctx, endTime, endHeight, func(valAddr sdk.ValAddress, _ time.Time) bool {
+		visited = append(visited, valAddr)
+		return true
+	})
+	require.Equal([]sdk.ValAddress{valAddrs[0]}, visited)
+}
+
+func (s *KeeperTestSuite) TestCreateValidatorPubKeyTypesByPath() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	keeper.SetAllowedPubKeyTypes(stakingtypes.ValidatorCreationPathNative, "ed25519")
+	keeper.SetAllowedPubKeyTypes(stakingtypes.ValidatorCreationPathEvm, "ed25519", "secp256k1")
+
+	valPubKey := secp256k1.GenPrivKey().PubKey()
+	valAddr := sdk.ValAddress(valPubKey.Address())
+	delAddr := sdk.AccAddress(valAddr)
+	bondCoin := sdk.NewCoin(sdk.DefaultBondDenom, keeper.GetParams(ctx).MinBondAmount.TruncateInt())
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr, valPubKey, bondCoin, stakingtypes.Description{Moniker: "secp"},
+		stakingtypes.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()), math.OneInt(),
+	)
+	require.NoError(err)
+
+	// native validators are restricted to ed25519
+	_, err = s.msgServer.CreateValidator(ctx, msg)
+	require.ErrorIs(err, stakingtypes.ErrValidatorPubKeyTypeNotSupported)
+	_, found := keeper.GetValidator(ctx, valAddr)
+	require.False(found)
+
+	// validators registered through the EVM path accept secp256k1
+	keeper.SetCreateValidatorMsgByValAddr(ctx, valAddr, msg)
+	s.bankKeeper.EXPECT().UndelegateCoinsFromModuleToAccount(gomock.Any(), stakingtypes.NotBondedPoolName, delAddr, sdk.NewCoins(bondCoin)).Return(nil)
+	s.bankKeeper.EXPECT().DelegateCoinsFromAccountToModule(gomock.Any(), delAddr, stakingtypes.NotBondedPoolName, sdk.NewCoins(bondCoin)).Return(nil)
+	_, err = keeper.CreateEvmValidator(ctx, valAddr)
+	require.NoError(err)
+	validator, found := keeper.GetValidator(ctx, valAddr)
+	require.True(found)
+	require.Equal(bondCoin.Amount, validator.Tokens)
+
+	// removing the restriction falls back to the consensus params
+	keeper.SetAllowedPubKeyTypes(stakingtypes.ValidatorCreationPathNative)
+	require.Nil(keeper.AllowedPubKeyTypes(stakingtypes.ValidatorCreationPathNative))
+}
+
+func (s *KeeperTestSuite) TestGetValidatorsWithCommissionOlderThan() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	cutoff := time.Unix(1000, 0).UTC()
+	updateTimes := []time.Time{cutoff.Add(-time.Hour), cutoff, cutoff.Add(time.Hour), cutoff.Add(-time.Second)}
+
+	var valAddrs []sdk.ValAddress
+	for i, updateTime := range updateTimes {
+		valAddr := sdk.ValAddress(PKs[i].Address().Bytes())
+		validator := testutil.NewValidator(s.T(), valAddr, PKs[i])
+		validator.Commission = stakingtypes.NewCommissionWithTime(math.LegacyZeroDec(), math.LegacyOneDec(), math.LegacyZeroDec(), updateTime)
+		keeper.SetValidator(ctx, validator)
+		valAddrs = append(valAddrs, valAddr)
+	}
+
+	var stale []sdk.ValAddress
+	for _, validator := range keeper.GetValidatorsWithCommissionOlderThan(ctx, cutoff) {
+		stale = append(stale, validator.GetOperator())
+	}
+	require.ElementsMatch([]sdk.ValAddress{valAddrs[0], valAddrs[3]}, stale)
+
+	require.Empty(keeper.GetValidatorsWithCommissionOlderThan(ctx, cutoff.Add(-2*time.Hour)))
+}
+
+func (s *KeeperTestSuite) TestCreateValidatorInvalidBondDenom() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	valPubKey := PKs[0]
+	valAddr := sdk.ValAddress(valPubKey.Address().Bytes())
+	wrongCoin := sdk.NewCoin("wrongdenom", keeper.GetParams(ctx).MinBondAmount.TruncateInt())
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr, valPubKey, wrongCoin, stakingtypes.Description{Moniker: "denom"},
+		stakingtypes.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()), math.OneInt(),
+	)
+	require.NoError(err)
+	expectedMsg := fmt.Sprintf("got wrongdenom, expected %s: %s", keeper.BondDenom(ctx), stakingtypes.ErrInvalidBondDenom)
+
+	// native path
+	_, err = s.msgServer.CreateValidator(ctx, msg)
+	require.ErrorIs(err, stakingtypes.ErrInvalidBondDenom)
+	require.EqualError(err, expectedMsg)
+
+	// evm path, rejected before any coins are delegated
+	keeper.SetEvmCallback(func(sdk.Context, *sdk.GovEvent) error { return nil })
+	_, err = keeper.CreateEvmStaking(ctx, msg)
+	require.ErrorIs(err, stakingtypes.ErrInvalidBondDenom)
+	require.EqualError(err, expectedMsg)
+	require.Nil(keeper.GetCreateValidatorMsgByValAddr(ctx, valAddr))
+}
+
+func (s *KeeperTestSuite) TestGetValidatorByConsAddrAsOf() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	ctx = ctx.WithBlockHeight(10)
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	consAddr := sdk.ConsAddress(PKs[0].Address())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+	validator = validator.UpdateStatus(stakingtypes.Bonded)
+	keeper.SetValidator(ctx, validator)
+	require.NoError(keeper.SetValidatorByConsAddr(ctx, validator))
+	keeper.SetLastValidatorPower(ctx, valAddr, 10)
+
+	// the validator was part of the set at the end of height 8
+	hi := stakingtypes.NewHistoricalInfo(ctx.BlockHeader(), []stakingtypes.Validator{validator}, keeper.PowerReduction(ctx))
+	keeper.SetHistoricalInfo(ctx, 9, &hi)
+
+	// the validator leaves the set during this block
+	validator = validator.UpdateStatus(stakingtypes.Unbonding)
+	keeper.SetValidator(ctx, validator)
+
+	_, found := keeper.GetValidatorByConsAddrAsOf(ctx, consAddr, 10)
+	require.False(found)
+
+	// its prior block membership is still resolvable
+	resolved, found := keeper.GetValidatorByConsAddrAsOf(ctx, consAddr, 9)
+	require.True(found)
+	require.Equal(valAddr, resolved.GetOperator())
+
+	resolved, found = keeper.GetValidatorByConsAddrAsOf(ctx, consAddr, 8)
+	require.True(found)
+	require.Equal(valAddr, resolved.GetOperator())
+	require.True(resolved.IsBonded())
+
+	// no historical info was recorded for older heights
+	_, found = keeper.GetValidatorByConsAddrAsOf(ctx, consAddr, 7)
+	require.False(found)
+
+	// validators that were not in the prior set are not resolved
+	keeper.DeleteLastValidatorPower(ctx, valAddr)
+	_, found = keeper.GetValidatorByConsAddrAsOf(ctx, consAddr, 9)
+	require.False(found)
+
+	_, found = keeper.GetValidatorByConsAddrAsOf(ctx, consAddr, 11)
+	require.False(found)
+}
+
+func (s *KeeperTestSuite) TestResolveValidator() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	// the operator address differs from the consensus address
+	valAddr := sdk.ValAddress(PKs[1].Address().Bytes())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByConsAddr(ctx, validator)
+
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(err)
+	require.NotEqual(consAddr.Bytes(), valAddr.Bytes())
+
+	for _, addr := range [][]byte{consAddr, valAddr} {
+		resolved, found := keeper.ResolveValidator(ctx, addr)
+		require.True(found)
+		require.Equal(valAddr, resolved.GetOperator())
+	}
+
+	_, found := keeper.ResolveValidator(ctx, PKs[2].Address().Bytes())
+	require.False(found)
+}
+
+func (s *KeeperTestSuite) TestGetValidatorOperatorFromPubKey() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	valAddr := sdk.ValAddress(PKs[1].Address().Bytes())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	keeper.SetValidator(ctx, validator)
+	keeper.SetValidatorByConsAddr(ctx, validator)
+
+	operator, found := keeper.GetValidatorOperatorFromPubKey(ctx, PKs[0])
+	require.True(found)
+	require.Equal(valAddr, operator)
+
+	_, found = keeper.GetValidatorOperatorFromPubKey(ctx, PKs[1])
+	require.False(found)
+}
+
+func (s *KeeperTestSuite) TestGetValidatorsJoinedAfter() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	var valAddrs []sdk.ValAddress
+	for i, height := range []int64{10, 20, 30} {
+		valAddr := sdk.ValAddress(PKs[i].Address().Bytes())
+		keeper.SetValidator(ctx, testutil.NewValidator(s.T(), valAddr, PKs[i]))
+		keeper.SetValidatorCreationHeight(ctx, valAddr, height)
+		valAddrs = append(valAddrs, valAddr)
+	}
+
+	// genesis validators have no creation height and are never returned
+	genesisAddr := sdk.ValAddress(PKs[3].Address().Bytes())
+	keeper.SetValidator(ctx, testutil.NewValidator(s.T(), genesisAddr, PKs[3]))
+
+	var joined []sdk.ValAddress
+	for _, validator := range keeper.GetValidatorsJoinedAfter(ctx, 15) {
+		joined = append(joined, validator.GetOperator())
+	}
+	require.ElementsMatch([]sdk.ValAddress{valAddrs[1], valAddrs[2]}, joined)
+
+	require.Empty(keeper.GetValidatorsJoinedAfter(ctx, 30))
+	require.Len(keeper.GetValidatorsJoinedAfter(ctx, 0), 3)
+}
+
+func (s *KeeperTestSuite) TestSetValidatorsDeferredPowerIndex() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	validators := make([]stakingtypes.Validator, 100)
+	for i := range validators {
+		valAddr := sdk.ValAddress(PKs[i].Address().Bytes())
+		validator := testutil.NewValidator(s.T(), valAddr, PKs[i])
+		validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, int64(i%10+1)))
+		// jailed validators are not indexed
+		validator.Jailed = i%7 == 0
+		validators[i] = validator
+	}
+
+	powerIndex := func() (keys [][]byte) {
+		iterator := keeper.ValidatorsPowerStoreIterator(ctx)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		return keys
+	}
+
+	require.NoError(keeper.SetValidators(ctx, validators, false))
+	expected := powerIndex()
+	require.Len(expected, 85)
+
+	// rebuilding over an existing index leaves it unchanged
+	keeper.RebuildValidatorPowerIndex(ctx)
+	require.Equal(expected, powerIndex())
+	for _, validator := range validators {
+		keeper.DeleteValidatorByPowerIndex(ctx, validator)
+	}
+	require.Empty(powerIndex())
+
+	require.NoError(keeper.SetValidators(ctx, validators, true))
+	require.Empty(powerIndex())
+
+	keeper.RebuildValidatorPowerIndex(ctx)
+	require.Equal(expected, powerIndex())
+}
+
+func (s *KeeperTestSuite) TestUnbondAllMatureValidatorsEmitsUnbondedEvent() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	endTime := time.Now()
+	endHeight := ctx.BlockHeight() + 10
+
+	// the first validator has no shares left and is removed, the second is retained
+	var valAddrs []sdk.ValAddress
+	for i := 0; i < 2; i++ {
+		valAddr := sdk.ValAddress(PKs[i].Address().Bytes())
+		validator := testutil.NewValidator(s.T(), valAddr, PKs[i])
+		if i == 1 {
+			validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+		}
+		validator.Status = stakingtypes.Unbonding
+		validator.UnbondingHeight = endHeight
+		validator.UnbondingTime = endTime
+		keeper.SetValidator(ctx, validator)
+		keeper.InsertUnbondingValidatorQueue(ctx, validator)
+		valAddrs = append(valAddrs, valAddr)
+	}
+
+	ctx = ctx.WithBlockHeight(endHeight).WithBlockTime(endTime).WithEventManager(sdk.NewEventManager())
+	keeper.UnbondAllMatureValidators(ctx)
+
+	_, found := keeper.GetValidator(ctx, valAddrs[0])
+	require.False(found)
+	validator, found := keeper.GetValidator(ctx, valAddrs[1])
+	require.True(found)
+	require.Equal(stakingtypes.Unbonded, validator.Status)
+
+	var unbonded []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != stakingtypes.EventTypeValidatorUnbonded {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == stakingtypes.AttributeKeyValidator {
+				unbonded = append(unbonded, attr.Value)
+			}
+		}
+	}
+	require.ElementsMatch([]string{valAddrs[0].String(), valAddrs[1].String()}, unbonded)
+}
+
+func (s *KeeperTestSuite) TestGetMatureUnbondingValidators() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	endTime := time.Now()
+	endHeight := ctx.BlockHeight() + 10
+
+	var valAddrs []sdk.ValAddress
+	for i := 0; i < 2; i++ {
+		valAddr := sdk.ValAddress(PKs[i].Address().Bytes())
+		validator := testutil.NewValidator(s.T(), valAddr, PKs[i])
+		validator.Status = stakingtypes.Unbonding
+		validator.UnbondingHeight = endHeight
+		validator.UnbondingTime = endTime
+		// the first validator is on hold
+		if i == 0 {
+			validator.UnbondingOnHoldRefCount = 1
+		}
+		keeper.SetValidator(ctx, validator)
+		keeper.InsertUnbondingValidatorQueue(ctx, validator)
+		valAddrs = append(valAddrs, valAddr)
+	}
+
+	// not mature yet
+	require.Empty(keeper.GetMatureUnbondingValidators(ctx))
+
+	ctx = ctx.WithBlockHeight(endHeight).WithBlockTime(endTime)
+	mature := keeper.GetMatureUnbondingValidators(ctx)
+	require.Len(mature, 1)
+	require.Equal(valAddrs[1], mature[0].GetOperator())
+
+	// the state is not modified
+	validator, found := keeper.GetValidator(ctx, valAddrs[1])
+	require.True(found)
+	require.Equal(stakingtypes.Unbonding, validator.Status)
+}
+
+func (s *KeeperTestSuite) TestQueryEvmValidatorStatus() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+
+	_, err := keeper.QueryEvmValidatorStatus(ctx, valAddr)
+	require.Error(err)
+
+	keeper.SetEvmCallback(func(_ sdk.Context, e *sdk.GovEvent) error {
+		require.Equal(sdk.GovEventQueryValidatorStatus, e.Type)
+		query, ok := e.Data.(*sdk.ValidatorStatusQuery)
+		require.True(ok)
+		require.Equal(valAddr.String(), query.ValidatorAddress)
+		query.Status = "active"
+		return nil
+	})
+	status, err := keeper.QueryEvmValidatorStatus(ctx, valAddr)
+	require.NoError(err)
+	require.Equal("active", status)
+
+	callbackErr := errors.New("contract reverted")
+	keeper.SetEvmCallback(func(sdk.Context, *sdk.GovEvent) error { return callbackErr })
+	_, err = keeper.QueryEvmValidatorStatus(ctx, valAddr)
+	require.ErrorIs(err, callbackErr)
+}
+
+func (s *KeeperTestSuite) TestDeleteValidatorQueueMalformedAddress() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	endTime := time.Unix(1000, 0).UTC()
+	endHeight := int64(10)
+
+	var validators []stakingtypes.Validator
+	addrs := []string{"malformed"}
+	for i := 0; i < 3; i++ {
+		validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		validator.UnbondingTime = endTime
+		validator.UnbondingHeight = endHeight
+		validators = append(validators, validator)
+		addrs = append(addrs, validator.OperatorAddress)
+	}
+	keeper.SetUnbondingValidatorsQueue(ctx, endTime, endHeight, addrs)
+
+	// strict by default
+	require.False(keeper.LenientValidatorQueue())
+	require.Panics(func() {
+		keeper.DeleteValidatorQueue(ctx, validators[0])
+	})
+
+	keeper.SetLenientValidatorQueue(true)
+	require.NotPanics(func() {
+		keeper.DeleteValidatorQueue(ctx, validators[0])
+	})
+	require.ElementsMatch(
+		[]string{validators[1].OperatorAddress, validators[2].OperatorAddress},
+		keeper.GetUnbondingValidators(ctx, endTime, endHeight),
+	)
+}
+
+func (s *KeeperTestSuite) TestUnbondAllMatureValidatorsMissingValidator() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	endTime := time.Unix(1000, 0).UTC()
+	endHeight := int64(10)
+	ctx = ctx.WithBlockHeight(endHeight).WithBlockTime(endTime)
+
+	// the first validator was deleted without leaving the queue
+	missing := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0])
+	validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[1].Address().Bytes()), PKs[1])
+	validator.Status = stakingtypes.Unbonding
+	validator.UnbondingTime = endTime
+	validator.UnbondingHeight = endHeight
+	validator.DelegatorShares = math.LegacyOneDec()
+	keeper.SetValidator(ctx, validator)
+	keeper.SetUnbondingValidatorsQueue(ctx, endTime, endHeight, []string{missing.OperatorAddress, validator.OperatorAddress})
+
+	// strict by default
+	require.PanicsWithError(stakingtypes.ErrValidatorQueueEntryNotFound.Error(), func() {
+		keeper.UnbondAllMatureValidators(ctx)
+	})
+
+	keeper.SetLenientValidatorQueue(true)
+	require.NotPanics(func() {
+		keeper.UnbondAllMatureValidators(ctx)
+	})
+	require.Empty(keeper.GetUnbondingValidators(ctx, endTime, endHeight))
+
+	validator, found := keeper.GetValidator(ctx, validator.GetOperator())
+	require.True(found)
+	require.Equal(stakingtypes.Unbonded, validator.Status)
+}
+
+func (s *KeeperTestSuite) TestStrictModeMissingValidator() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	// the validator is still indexed but its record was deleted
+	missing := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0])
+	missing, _ = missing.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+	missing = missing.UpdateStatus(stakingtypes.Bonded)
+	keeper.SetValidatorByPowerIndex(ctx, missing)
+	keeper.SetLastValidatorPower(ctx, missing.GetOperator(), 10)
+	consAddr, err := missing.GetConsAddr()
+	require.NoError(err)
+
+	_, err = keeper.GetValidatorOrError(ctx, missing.GetOperator())
+	require.ErrorIs(err, stakingtypes.ErrNoValidatorFound)
+	_, err = keeper.GetValidatorByConsAddrOrError(ctx, consAddr)
+	require.ErrorIs(err, stakingtypes.ErrNoValidatorFound)
+
+	// strict by default
+	require.True(keeper.StrictMode())
+	require.Panics(func() { keeper.GetBondedValidatorsByPower(ctx) })
+	require.Panics(func() { keeper.GetLastValidators(ctx) })
+	require.Panics(func() { keeper.Jail(ctx, consAddr) })
+
+	keeper.SetStrictMode(false)
+	require.NotPanics(func() {
+		require.Empty(keeper.GetBondedValidatorsByPower(ctx))
+		require.Empty(keeper.GetLastValidators(ctx))
+		keeper.IterateBondedValidatorsByPower(ctx, func(int64, stakingtypes.ValidatorI) bool {
+			require.Fail("missing validator iterated")
+			return false
+		})
+		keeper.IterateLastValidators(ctx, func(int64, stakingtypes.ValidatorI) bool {
+			require.Fail("missing validator iterated")
+			return false
+		})
+		keeper.Jail(ctx, consAddr)
+		keeper.Unjail(ctx, consAddr)
+	})
+}
+
+func (s *KeeperTestSuite) TestStrictModeUnbondingQueue() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	endTime := time.Unix(1000, 0).UTC()
+	endHeight := int64(10)
+	ctx = ctx.WithBlockHeight(endHeight).WithBlockTime(endTime)
+
+	missing := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0])
+	keeper.SetUnbondingValidatorsQueue(ctx, endTime, endHeight, []string{missing.OperatorAddress})
+
+	require.Panics(func() { keeper.UnbondAllMatureValidators(ctx) })
+
+	keeper.SetStrictMode(false)
+	require.NotPanics(func() { keeper.UnbondAllMatureValidators(ctx) })
+	require.Empty(keeper.GetUnbondingValidators(ctx, endTime, endHeight))
+}
+
+func (s *KeeperTestSuite) TestStrictModeRemoveValidator() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0])
+	validator = validator.UpdateStatus(stakingtypes.Bonded)
+	keeper.SetValidator(ctx, validator)
+
+	keeper.SetStrictMode(false)
+	err := keeper.RemoveValidator(ctx, validator.GetOperator())
+	require.ErrorIs(err, stakingtypes.ErrBadValidatorRemoval)
+
+	validator = validator.UpdateStatus(stakingtypes.Unbonded)
+	validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 1))
+	keeper.SetValidator(ctx, validator)
+	err = keeper.RemoveValidator(ctx, validator.GetOperator())
+	require.ErrorIs(err, stakingtypes.ErrBadValidatorRemoval)
+
+	_, found := keeper.GetValidator(ctx, validator.GetOperator())
+	require.True(found)
+}
+
+func (s *KeeperTestSuite) TestValidatorCommissionSchedule() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	now := time.Unix(1000, 0).UTC()
+	ctx = ctx.WithBlockTime(now)
+
+	valAddr := sdk.ValAddress(PKs[0].Address().Bytes())
+	validator := testutil.NewValidator(s.T(), valAddr, PKs[0])
+	validator, _ = validator.SetInitialCommission(stakingtypes.NewCommission(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1)))
+	keeper.SetValidator(ctx, validator)
+
+	entry := func(after time.Duration, rate sdk.Dec) stakingtypes.CommissionScheduleEntry {
+		return stakingtypes.CommissionScheduleEntry{EffectiveTime: now.Add(after), Rate: rate}
+	}
+	schedule := func(entries ...stakingtypes.CommissionScheduleEntry) stakingtypes.CommissionSchedule {
+		return stakingtypes.CommissionSchedule{Entries: entries}
+	}
+
+	// invalid schedules
+	day := keeper.CommissionChangeInterval(ctx)
+	require.ErrorIs(keeper.SetValidatorCommissionSchedule(ctx, valAddr, schedule(entry(0, sdk.NewDecWithPrec(2, 1)))), stakingtypes.ErrInvalidCommissionSchedule)
+	require.ErrorIs(keeper.SetValidatorCommissionSchedule(ctx, valAddr, schedule(entry(2*day, sdk.NewDecWithPrec(2, 1)), entry(day, sdk.NewDecWithPrec(2, 1)))), stakingtypes.ErrInvalidCommissionSchedule)
+	require.ErrorIs(keeper.SetValidatorCommissionSchedule(ctx, valAddr, schedule(entry(day, sdk.NewDecWithPrec(-1, 1)))), stakingtypes.ErrCommissionNegative)
+	require.ErrorIs(keeper.SetValidatorCommissionSchedule(ctx, valAddr, schedule(entry(day, sdk.NewDecWithPrec(6, 1)))), stakingtypes.ErrCommissionGTMaxRate)
+	require.ErrorIs(keeper.SetValidatorCommissionSchedule(ctx, valAddr, schedule(entry(day, sdk.NewDecWithPrec(3, 1)))), stakingtypes.ErrCommissionGTMaxChangeRate)
+	require.ErrorIs(keeper.SetValidatorCommissionSchedule(ctx, sdk.ValAddress(PKs[1].Address().Bytes()), schedule(entry(day, sdk.NewDecWithPrec(2, 1)))), stakingtypes.ErrNoValidatorFound)
+	// each step is bounded by the max change rate and the change interval
+	require.ErrorIs(keeper.SetValidatorCommissionSchedule(ctx, valAddr, schedule(entry(day, sdk.NewDecWithPrec(2, 1)), entry(2*day, sdk.NewDecWithPrec(4, 1)))), stakingtypes.ErrCommissionGTMaxChangeRate)
+	require.ErrorIs(keeper.SetValidatorCommissionSchedule(ctx, valAddr, schedule(entry(day, sdk.NewDecWithPrec(2, 1)), entry(day+time.Hour, sdk.NewDecWithPrec(3, 1)))), stakingtypes.ErrCommissionUpdateTime)
+	require.Empty(keeper.GetValidatorCommissionSchedule(ctx, valAddr).Entries)
+
+	require.NoError(keeper.SetValidatorCommissionSchedule(ctx, valAddr, schedule(entry(day, sdk.NewDecWithPrec(2, 1)), entry(2*day, sdk.NewDecWithPrec(3, 1)))))
+
+	require.Equal(sdk.NewDecWithPrec(1, 1), keeper.GetValidatorEffectiveCommissionRate(ctx, valAddr))
+	require.Equal(sdk.NewDecWithPrec(1, 1), keeper.GetValidatorEffectiveCommissionRate(ctx.WithBlockTime(now.Add(day-time.Second)), valAddr))
+	require.Equal(sdk.NewDecWithPrec(2, 1), keeper.GetValidatorEffectiveCommissionRate(ctx.WithBlockTime(now.Add(day)), valAddr))
+	require.Equal(sdk.NewDecWithPrec(3, 1), keeper.GetValidatorEffectiveCommissionRate(ctx.WithBlockTime(now.Add(3*day)), valAddr))
+
+	// the first entry is spaced from the last commission update
+	validator.Commission.UpdateTime = now
+	keeper.SetValidator(ctx, validator)
+	require.ErrorIs(keeper.SetValidatorCommissionSchedule(ctx, valAddr, schedule(entry(time.Hour, sdk.NewDecWithPrec(2, 1)))), stakingtypes.ErrCommissionUpdateTime)
+
+	// an empty schedule removes the scheduled changes
+	require.NoError(keeper.SetValidatorCommissionSchedule(ctx, valAddr, stakingtypes.CommissionSchedule{}))
+	require.Equal(sdk.NewDecWithPrec(1, 1), keeper.GetValidatorEffectiveCommissionRate(ctx.WithBlockTime(now.Add(3*day)), valAddr))
+}
+
+func (s *KeeperTestSuite) TestRecomputeLastValidatorPowers() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	var valAddrs []sdk.ValAddress
+	for i, power := range []int64{10, 20, 30} {
+		valAddr := sdk.ValAddress(PKs[i].Address().Bytes())
+		validator := testutil.NewValidator(s.T(), valAddr, PKs[i])
+		validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, power))
+		validator.Status = stakingtypes.Bonded
+		keeper.SetValidator(ctx, validator)
+		keeper.SetValidatorByPowerIndex(ctx, validator)
+		valAddrs = append(valAddrs, valAddr)
+	}
+
+	// corrupt the index: a wrong power, a missing validator and an unknown one
+	keeper.SetLastValidatorPower(ctx, valAddrs[0], 99)
+	keeper.SetLastValidatorPower(ctx, valAddrs[1], 20)
+	keeper.SetLastValidatorPower(ctx, sdk.ValAddress(PKs[3].Address().Bytes()), 5)
+
+	require.Equal(3, keeper.RecomputeLastValidatorPowers(ctx))
+
+	powers := map[string]int64{}
+	keeper.IterateLastValidatorPowers(ctx, func(operator sdk.ValAddress, power int64) bool {
+		powers[operator.String()] = power
+		return false
+	})
+	require.Equal(map[string]int64{
+		valAddrs[0].String(): 10,
+		valAddrs[1].String(): 20,
+		valAddrs[2].String(): 30,
+	}, powers)
+}
+
+func (s *KeeperTestSuite) TestMaxValidatorsTransitionStep() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	for i := 0; i < 6; i++ {
+		valAddr := sdk.ValAddress(PKs[i].Address().Bytes())
+		validator := testutil.NewValidator(s.T(), valAddr, PKs[i])
+		validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, int64(10*(i+1))))
+		keeper.SetValidator(ctx, validator)
+		keeper.SetValidatorByPowerIndex(ctx, validator)
+	}
+
+	params := keeper.GetParams(ctx)
+	params.MaxValidators = 6
+	require.NoError(keeper.SetParams(ctx, params))
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.NotBondedPoolName, stakingtypes.BondedPoolName, gomock.Any())
+	_, err := keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(err)
+	require.Len(keeper.GetLastValidators(ctx), 6)
+
+	// lowering MaxValidators shrinks the active set by at most two per block
+	params.MaxValidators = 1
+	params.MaxValidatorsTransitionStep = 2
+	require.NoError(keeper.SetParams(ctx, params))
+	for _, expected := range []int{4, 2, 1, 1} {
+		if expected < len(keeper.GetLastValidators(ctx)) {
+			s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(gomock.Any(), stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, gomock.Any())
+		}
+		_, err = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+		require.NoError(err)
+
+		last := keeper.GetLastValidators(ctx)
+		require.Len(last, expected)
+		// the strongest validators stay in the active set
+		for _, validator := range last {
+			require.GreaterOrEqual(validator.ConsensusPower(keeper.PowerReduction(ctx)), int64(10*(6-expected+1)))
+		}
+
+		// the bonded validators are not truncated to MaxValidators mid-transition
+		require.Len(keeper.GetBondedValidatorsByPower(ctx), expected)
+		bonded := 0
+		keeper.IterateBondedValidatorsByPower(ctx, func(int64, stakingtypes.ValidatorI) bool {
+			bonded++
+			return false
+		})
+		require.Equal(expected, bonded)
+		require.Equal(expected, keeper.RecomputeLastValidatorPowers(ctx))
+		require.Len(keeper.GetLastValidators(ctx), expected)
+	}
+
+	// once the transition is over the bonded validators are bounded by
+	// MaxValidators again, even with a transition step configured
+	validator, found := keeper.GetValidator(ctx, sdk.ValAddress(PKs[4].Address().Bytes()))
+	require.True(found)
+	keeper.SetValidator(ctx, validator.UpdateStatus(stakingtypes.Bonded))
+	bonded := keeper.GetBondedValidatorsByPower(ctx)
+	require.Len(bonded, 1)
+	require.Equal(sdk.ValAddress(PKs[5].Address().Bytes()), bonded[0].GetOperator())
+}
+
+func (s *KeeperTestSuite) TestSweepOrphanConsAddrEntries() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	for i := 0; i < 2; i++ {
+		validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i])
+		keeper.SetValidator(ctx, validator)
+		keeper.SetValidatorByConsAddr(ctx, validator)
+	}
+
+	// an index entry left behind by a removed validator
+	orphan := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[2].Address().Bytes()), PKs[2])
+	keeper.SetValidatorByConsAddr(ctx, orphan)
+
+	require.Equal(1, keeper.SweepOrphanConsAddrEntries(ctx))
+
+	// the entry is gone: storing the operator again does not resolve it
+	keeper.SetValidator(ctx, orphan)
+	_, found := keeper.GetValidatorOperatorFromPubKey(ctx, PKs[2])
+	require.False(found)
+	for i := 0; i < 2; i++ {
+		_, found = keeper.GetValidatorOperatorFromPubKey(ctx, PKs[i])
+		require.True(found)
+	}
+
+	require.Zero(keeper.SweepOrphanConsAddrEntries(ctx))
+}
+
+func (s *KeeperTestSuite) TestGetValidatorByIndex() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	for i := 0; i < 4; i++ {
+		keeper.SetValidator(ctx, testutil.NewValidator(s.T(), sdk.ValAddress(PKs[i].Address().Bytes()), PKs[i]))
+	}
+
+	all := keeper.GetAllValidators(ctx)
+	for i := range all {
+		validator, found := keeper.GetValidatorByIndex(ctx, uint32(i))
+		require.True(found)
+		require.Equal(all[i], validator)
+	}
+
+	validator, found := keeper.GetValidatorByIndex(ctx, 2)
+	require.True(found)
+	require.Equal(all[2], validator)
+
+	_, found = keeper.GetValidatorByIndex(ctx, 4)
+	require.False(found)
+}
+
+func (s *KeeperTestSuite) TestValidateExistingValidatorSetAgainstConsensusParams() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	edValidator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0])
+	keeper.SetValidator(ctx, edValidator)
+	secpPubKey := secp256k1.GenPrivKey().PubKey()
+	secpValidator := testutil.NewValidator(s.T(), sdk.ValAddress(secpPubKey.Address()), secpPubKey)
+	keeper.SetValidator(ctx, secpValidator)
+
+	// both key types are allowed
+	ctx = ctx.WithConsensusParams(&tmproto.ConsensusParams{
+		Validator: &tmproto.ValidatorParams{PubKeyTypes: []string{"ed25519", "secp256k1"}},
+	})
+	require.Empty(keeper.ValidateExistingValidatorSetAgainstConsensusParams(ctx))
+
+	// ed25519 is no longer allowed
+	ctx = ctx.WithConsensusParams(&tmproto.ConsensusParams{
+		Validator: &tmproto.ValidatorParams{PubKeyTypes: []string{"secp256k1"}},
+	})
+	require.Equal([]sdk.ValAddress{edValidator.GetOperator()}, keeper.ValidateExistingValidatorSetAgainstConsensusParams(ctx))
+}
+
+func (s *KeeperTestSuite) TestUnbondAllMatureValidatorsMaturityMode() {
+	blockTime := time.Now()
+	blockHeight := s.ctx.BlockHeight() + 10
+
+	// the first validator is mature by time but not height, the second by
+	// height but not time
+	timeMatured := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0])
+	timeMatured.UnbondingHeight = blockHeight + 1
+	timeMatured.UnbondingTime = blockTime
+	heightMatured := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[1].Address().Bytes()), PKs[1])
+	heightMatured.UnbondingHeight = blockHeight
+	heightMatured.UnbondingTime = blockTime.Add(time.Second)
+
+	testCases := []struct {
+		mode     stakingtypes.UnbondingMaturityMode
+		unbonded []sdk.ValAddress
+	}{
+		{stakingtypes.UnbondingMaturityHeightAndTime, nil},
+		{stakingtypes.UnbondingMaturityTimeOnly, []sdk.ValAddress{timeMatured.GetOperator()}},
+		{stakingtypes.UnbondingMaturityHeightOnly, []sdk.ValAddress{heightMatured.GetOperator()}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.mode.String(), func() {
+			require := s.Require()
+			ctx, _ := s.ctx.CacheContext()
+			keeper := s.stakingKeeper
+
+			params := keeper.GetParams(ctx)
+			params.UnbondingMaturityMode = tc.mode
+			require.NoError(keeper.SetParams(ctx, params))
+
+			for _, validator := range []stakingtypes.Validator{timeMatured, heightMatured} {
+				validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, 10))
+				validator.Status = stakingtypes.Unbonding
+				keeper.SetValidator(ctx, validator)
+				keeper.InsertUnbondingValidatorQueue(ctx, validator)
+			}
+
+			ctx = ctx.WithBlockHeight(blockHeight).WithBlockTime(blockTime)
+			keeper.UnbondAllMatureValidators(ctx)
+
+			var unbonded []sdk.ValAddress
+			for _, valAddr := range []sdk.ValAddress{timeMatured.GetOperator(), heightMatured.GetOperator()} {
+				validator, found := keeper.GetValidator(ctx, valAddr)
+				require.True(found)
+				if validator.IsUnbonded() {
+					unbonded = append(unbonded, valAddr)
+				}
+			}
+			require.Equal(tc.unbonded, unbonded)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestGetValidatorsByPowerRange() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	powers := []int64{5, 10, 20, 30, 40}
+	var valAddrs []sdk.ValAddress
+	for i, power := range powers {
+		valAddr := sdk.ValAddress(PKs[i].Address().Bytes())
+		validator := testutil.NewValidator(s.T(), valAddr, PKs[i])
+		validator, _ = validator.AddTokensFromDel(keeper.TokensFromConsensusPower(ctx, power))
+		// the validator of power 20 is not bonded
+		if power != 20 {
+			validator = validator.UpdateStatus(stakingtypes.Bonded)
+		}
+		keeper.SetValidator(ctx, validator)
+		keeper.SetValidatorByPowerIndex(ctx, validator)
+		valAddrs = append(valAddrs, valAddr)
+	}
+
+	operators := func(validators []stakingtypes.Validator) []sdk.ValAddress {
+		addrs := []sdk.ValAddress{}
+		for _, validator := range validators {
+			addrs = append(addrs, validator.GetOperator())
+		}
+		return addrs
+	}
+
+	require.Equal([]sdk.ValAddress{valAddrs[3], valAddrs[1]}, operators(keeper.GetValidatorsByPowerRange(ctx, 10, 30)))
+	require.Equal([]sdk.ValAddress{valAddrs[4], valAddrs[3], valAddrs[1], valAddrs[0]}, operators(keeper.GetValidatorsByPowerRange(ctx, 0, 100)))
+	require.Equal([]sdk.ValAddress{valAddrs[0]}, operators(keeper.GetValidatorsByPowerRange(ctx, 5, 5)))
+	require.Empty(keeper.GetValidatorsByPowerRange(ctx, 15, 25))
+	require.Empty(keeper.GetValidatorsByPowerRange(ctx, 50, 100))
+}
+
+func (s *KeeperTestSuite) TestMaxTokenMovementPerBlock() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddr := sdk.AccAddress(PKs[1].Address())
+	validator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0])
+	keeper.SetValidator(ctx, validator)
+
+	// the movement is not tracked while the cap is disabled
+	_, err := keeper.Delegate(ctx, delAddr, math.NewInt(1000), stakingtypes.Unbonded, validator, false)
+	require.NoError(err)
+	require.True(keeper.GetBlockTokenMovement(ctx).IsZero())
+
+	params := keeper.GetParams(ctx)
+	params.MaxTokenMovementPerBlock = math.NewInt(100)
+	require.NoError(keeper.SetParams(ctx, params))
+
+	// delegations and undelegations both count against the cap
+	validator, _ = keeper.GetValidator(ctx, validator.GetOperator())
+	_, err = keeper.Delegate(ctx, delAddr, math.NewInt(60), stakingtypes.Unbonded, validator, false)
+	require.NoError(err)
+	_, err = keeper.Unbond(ctx, delAddr, validator.GetOperator(), math.LegacyNewDec(30))
+	require.NoError(err)
+	require.Equal(math.NewInt(90), keeper.GetBlockTokenMovement(ctx))
+
+	// exceeding the cap fails with an error instead of panicking
+	validator, _ = keeper.GetValidator(ctx, validator.GetOperator())
+	_, err = keeper.Delegate(ctx, delAddr, math.NewInt(20), stakingtypes.Unbonded, validator, false)
+	require.ErrorIs(err, stakingtypes.ErrTokenMovementCapExceeded)
+	_, err = keeper.Unbond(ctx, delAddr, validator.GetOperator(), math.LegacyNewDec(20))
+	require.ErrorIs(err, stakingtypes.ErrTokenMovementCapExceeded)
+	require.Equal(math.NewInt(90), keeper.GetBlockTokenMovement(ctx))
+}
+
+func (s *KeeperTestSuite) TestMaxTokenMovementPerBlockSlashRedelegation() {
+	ctx, keeper := s.ctx, s.stakingKeeper
+	require := s.Require()
+
+	delAddr := sdk.AccAddress(PKs[2].Address())
+	srcValidator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[0].Address().Bytes()), PKs[0])
+	dstValidator := testutil.NewValidator(s.T(), sdk.ValAddress(PKs[1].Address().Bytes()), PKs[1])
+	keeper.SetValidator(ctx, srcValidator)
+	keeper.SetValidator(ctx, dstValidator)
+
+	shares, err := keeper.Delegate(ctx, delAddr, math.NewInt(1000), stakingtypes.Unbonded, dstValidator, false)
+	require.NoError(err)
+	redelegation := stakingtypes.NewRedelegation(delAddr, srcValidator.GetOperator(), dstValidator.GetOperator(),
+		ctx.BlockHeight(), ctx.BlockTime().Add(time.Hour), math.NewInt(1000), shares, 0)
+	keeper.SetRedelegation(ctx, redelegation)
+
+	// the cap is already exhausted by the transactions of the block
+	params := keeper.GetParams(ctx)
+	params.MaxTokenMovementPerBlock = math.NewInt(10)
+	require.NoError(keeper.SetParams(ctx, params))
+
+	// slashing the redelegation unbonds well past the cap without halting
+	s.bankKeeper.EXPECT().BurnCoins(gomock.Any(), stakingtypes.NotBondedPoolName, gomock.Any()).Return(nil)
+	var slashed math.Int
+	require.NotPanics(func() {
+		slashed = keeper.SlashRedelegation(ctx, srcValidator, redelegation, ctx.BlockHeight(), math.LegacyNewDecWithPrec(5, 1))
+	})
+	require.Equal(math.NewInt(500), slashed)
+	require.True(keeper.GetBlockTokenMovement(ctx).IsZero())
+
+	dstValidator, found := keeper.GetValidator(ctx, dstValidator.GetOperator())
+	require.True(found)
+	require.Equal(math.NewInt(500), dstValidator.Tokens)
+}
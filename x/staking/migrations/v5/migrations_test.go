@@ -0,0 +1,98 @@
+package v5_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	v5 "github.com/cosmos/cosmos-sdk/x/staking/migrations/v5"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+
+	storeKey := sdk.NewKVStoreKey(v5.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// params as stored by v4, without any of the params added since
+	defaults := types.DefaultParams()
+	params := types.Params{
+		UnbondingTime:     time.Hour,
+		MaxValidators:     50,
+		MaxEntries:        defaults.MaxEntries,
+		HistoricalEntries: defaults.HistoricalEntries,
+		BondDenom:         defaults.BondDenom,
+		MinCommissionRate: math.LegacyNewDecWithPrec(5, 2),
+		MinBondAmount:     defaults.MinBondAmount,
+		MaxBondAmount:     defaults.MaxBondAmount,
+		EnableEvm:         true,
+	}
+	store.Set(v5.ParamsKey, cdc.MustMarshal(&params))
+
+	require.NoError(t, v5.MigrateStore(ctx, storeKey, cdc))
+
+	var res types.Params
+	require.NoError(t, cdc.Unmarshal(store.Get(v5.ParamsKey), &res))
+
+	// the v4 params are kept
+	require.Equal(t, time.Hour, res.UnbondingTime)
+	require.Equal(t, uint32(50), res.MaxValidators)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 2), res.MinCommissionRate)
+	require.True(t, res.EnableEvm)
+
+	// the params added since v4 get their default value
+	require.Equal(t, types.DefaultCommissionChangeInterval, res.CommissionChangeInterval)
+	require.Equal(t, defaults.PowerHistoryEntries, res.PowerHistoryEntries)
+	require.Equal(t, defaults.MaxValidatorsTransitionStep, res.MaxValidatorsTransitionStep)
+	require.Equal(t, defaults.UnbondingMaturityMode, res.UnbondingMaturityMode)
+	require.True(t, res.MaxTokenMovementPerBlock.IsZero())
+	require.True(t, res.MinDelegation.IsZero())
+	require.Equal(t, defaults.MinDelegationExemptSelfDelegation, res.MinDelegationExemptSelfDelegation)
+	require.Nil(t, res.MaxCommissionRate)
+	require.Equal(t, defaults.JailHistoryEntries, res.JailHistoryEntries)
+	require.NoError(t, res.Validate())
+}
+
+func TestMigratePendingEvmValidators(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(staking.AppModuleBasic{}).Codec
+
+	storeKey := sdk.NewKVStoreKey(v5.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	params := types.DefaultParams()
+	store.Set(v5.ParamsKey, cdc.MustMarshal(&params))
+
+	pk := ed25519.GenPrivKey().PubKey()
+	valAddr := sdk.ValAddress(pk.Address())
+	msg, err := types.NewMsgCreateValidator(
+		valAddr, pk, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), types.Description{Moniker: "evm"},
+		types.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()), math.OneInt(),
+	)
+	require.NoError(t, err)
+	bz := cdc.MustMarshal(msg)
+
+	// registration stored under the bare validator address
+	store.Set(valAddr, bz)
+	// unrelated entry keyed by another address
+	otherAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	store.Set(otherAddr, bz)
+
+	require.NoError(t, v5.MigrateStore(ctx, storeKey, cdc))
+
+	require.Nil(t, store.Get(valAddr))
+	require.Equal(t, bz, store.Get(types.GetPendingEvmValidatorKey(valAddr)))
+	require.Equal(t, bz, store.Get(otherAddr))
+	require.Nil(t, store.Get(types.GetPendingEvmValidatorKey(otherAddr)))
+}
@@ -0,0 +1,87 @@
+package v5
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// MigrateStore performs in-place store migrations from v4 to v5.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	if err := migrateParams(store, cdc); err != nil {
+		return err
+	}
+
+	migratePendingEvmValidators(store, cdc)
+
+	return nil
+}
+
+// migrateParams sets the params added since v4, which read back as their zero
+// value from the params stored before they existed, to their default value
+func migrateParams(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	bz := store.Get(ParamsKey)
+	if bz == nil {
+		return nil
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	defaults := types.DefaultParams()
+	params.CommissionChangeInterval = defaults.CommissionChangeInterval
+	params.PowerHistoryEntries = defaults.PowerHistoryEntries
+	params.MaxValidatorsTransitionStep = defaults.MaxValidatorsTransitionStep
+	params.UnbondingMaturityMode = defaults.UnbondingMaturityMode
+	params.MaxTokenMovementPerBlock = defaults.MaxTokenMovementPerBlock
+	params.MinDelegation = defaults.MinDelegation
+	params.MinDelegationExemptSelfDelegation = defaults.MinDelegationExemptSelfDelegation
+	params.MaxCommissionRate = defaults.MaxCommissionRate
+	params.JailHistoryEntries = defaults.JailHistoryEntries
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, cdc.MustMarshal(&params))
+	return nil
+}
+
+// migratePendingEvmValidators moves the pending EVM validator creation
+// messages keyed by the bare validator address under the
+// PendingEvmValidatorKey prefix. Legacy keys have no prefix, so the whole store
+// is scanned for values that decode to a message whose validator address is
+// the key itself.
+func migratePendingEvmValidators(store storetypes.KVStore, cdc codec.BinaryCodec) {
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var legacyKeys [][]byte
+	var msgs [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var msg types.MsgCreateValidator
+		if err := cdc.Unmarshal(iterator.Value(), &msg); err != nil {
+			continue
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+		if err != nil || !bytes.Equal(valAddr, iterator.Key()) {
+			continue
+		}
+
+		legacyKeys = append(legacyKeys, iterator.Key())
+		msgs = append(msgs, iterator.Value())
+	}
+
+	for i, key := range legacyKeys {
+		store.Delete(key)
+		store.Set(types.GetPendingEvmValidatorKey(key), msgs[i])
+	}
+}
@@ -81,11 +81,11 @@ func (cr CommissionRates) Validate() error {
 
 // ValidateNewRate performs basic sanity validation checks of a new commission
 // rate. If validation fails, an SDK error is returned.
-func (c Commission) ValidateNewRate(newRate sdk.Dec, blockTime time.Time) error {
+func (c Commission) ValidateNewRate(newRate sdk.Dec, blockTime time.Time, changeInterval time.Duration) error {
 	switch {
-	case blockTime.Sub(c.UpdateTime).Hours() < 24:
-		// new rate cannot be changed more than once within 24 hours
-		return ErrCommissionUpdateTime
+	case blockTime.Sub(c.UpdateTime) < changeInterval:
+		// new rate cannot be changed more than once within the change interval
+		return ErrCommissionUpdateTime.Wrapf("last update at %s, interval %s", c.UpdateTime, changeInterval)
 
 	case newRate.IsNegative():
 		// new rate cannot be negative
@@ -21,7 +21,7 @@ var (
 	ErrCommissionNegative              = sdkerrors.Register(ModuleName, 9, "commission must be positive")
 	ErrCommissionHuge                  = sdkerrors.Register(ModuleName, 10, "commission cannot be more than 100%")
 	ErrCommissionGTMaxRate             = sdkerrors.Register(ModuleName, 11, "commission cannot be more than the max rate")
-	ErrCommissionUpdateTime            = sdkerrors.Register(ModuleName, 12, "commission cannot be changed more than once in 24h")
+	ErrCommissionUpdateTime            = sdkerrors.Register(ModuleName, 12, "commission cannot be changed more than once within the commission change interval")
 	ErrCommissionChangeRateNegative    = sdkerrors.Register(ModuleName, 13, "commission change rate must be positive")
 	ErrCommissionChangeRateGTMaxRate   = sdkerrors.Register(ModuleName, 14, "commission change rate cannot be more than the max rate")
 	ErrCommissionGTMaxChangeRate       = sdkerrors.Register(ModuleName, 15, "commission cannot be changed more than max change rate")
@@ -52,4 +52,12 @@ var (
 	ErrCommissionLTMinRate             = sdkerrors.Register(ModuleName, 40, "commission cannot be less than min rate")
 	ErrUnbondingNotFound               = sdkerrors.Register(ModuleName, 41, "unbonding operation not found")
 	ErrUnbondingOnHoldRefCountNegative = sdkerrors.Register(ModuleName, 42, "cannot un-hold unbonding operation that is not on hold")
+	ErrValidatorNotJailed              = sdkerrors.Register(ModuleName, 43, "validator for this address is not jailed")
+	ErrInvalidBondDenom                = sdkerrors.Register(ModuleName, 44, "invalid coin denomination for bonding")
+	ErrInvalidCommissionSchedule       = sdkerrors.Register(ModuleName, 45, "invalid commission schedule")
+	ErrBondDenomChange                 = sdkerrors.Register(ModuleName, 46, "bond denom cannot be changed")
+	ErrTokenMovementCapExceeded        = sdkerrors.Register(ModuleName, 47, "validator token movement exceeds the per-block cap")
+	ErrDelegationBelowMinimum          = sdkerrors.Register(ModuleName, 48, "delegation amount is below the minimum delegation")
+	ErrValidatorQueueEntryNotFound     = sdkerrors.Register(ModuleName, 49, "validator in the unbonding queue was not found")
+	ErrBadValidatorRemoval             = sdkerrors.Register(ModuleName, 50, "validator cannot be removed")
 )
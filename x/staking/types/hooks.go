@@ -4,6 +4,23 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// HookErrorPolicy defines how the staking keeper handles errors returned by
+// the registered staking hooks.
+type HookErrorPolicy int
+
+const (
+	// HookErrorPolicyDefault keeps the historical behavior: errors of the hooks
+	// called while slashing, initiating unbondings and removing validators are
+	// logged, the errors of the other hooks are returned to the caller.
+	HookErrorPolicyDefault HookErrorPolicy = iota
+	// HookErrorPolicyPropagate returns all hook errors to the caller. Where the
+	// caller cannot return an error (e.g. slashing), the keeper panics.
+	HookErrorPolicyPropagate
+	// HookErrorPolicyLogAndContinue logs hook errors and carries on as if the
+	// hook had succeeded.
+	HookErrorPolicyLogAndContinue
+)
+
 // combine multiple staking hooks, all hook functions are run in array sequence
 var _ StakingHooks = &MultiStakingHooks{}
 
@@ -21,6 +21,10 @@ const (
 	// StoreKey is the string store representation
 	StoreKey = ModuleName
 
+	// TStoreKey is the string transient store representation, it matches the
+	// name the app runtime assigns to the module's transient store key
+	TStoreKey = "transient:" + ModuleName
+
 	// RouterKey is the msg router key for the staking module
 	RouterKey = ModuleName
 )
@@ -31,9 +35,15 @@ var (
 	LastValidatorPowerKey = []byte{0x11} // prefix for each key to a validator index, for bonded validators
 	LastTotalPowerKey     = []byte{0x12} // prefix for the total power
 
-	ValidatorsKey             = []byte{0x21} // prefix for each key to a validator
-	ValidatorsByConsAddrKey   = []byte{0x22} // prefix for each key to a validator index, by pubkey
-	ValidatorsByPowerIndexKey = []byte{0x23} // prefix for each key to a validator index, sorted by power
+	ValidatorsKey              = []byte{0x21} // prefix for each key to a validator
+	ValidatorsByConsAddrKey    = []byte{0x22} // prefix for each key to a validator index, by pubkey
+	ValidatorsByPowerIndexKey  = []byte{0x23} // prefix for each key to a validator index, sorted by power
+	ValidatorCreationHeightKey = []byte{0x24} // prefix for each key to a validator creation height
+	ValidatorPowerHistoryKey   = []byte{0x25} // prefix for each key to a validator power history
+	CommissionScheduleKey      = []byte{0x26} // prefix for each key to a validator commission schedule
+	EvmValidatorKey            = []byte{0x27} // prefix for each key to a validator created through the EVM
+	PendingEvmValidatorKey     = []byte{0x28} // prefix for each key to a pending EVM validator creation message
+	ValidatorJailHistoryKey    = []byte{0x29} // prefix for each key to a validator jail history
 
 	DelegationKey                    = []byte{0x31} // key for a delegation
 	UnbondingDelegationKey           = []byte{0x32} // key for an unbonding-delegation
@@ -54,6 +64,11 @@ var (
 	ValidatorUpdatesKey = []byte{0x61} // prefix for the end block validator updates key
 
 	ParamsKey = []byte{0x51} // prefix for parameters for module x/staking
+
+	// Keys for transient store prefixes, cleared at the end of every block.
+	ValidatorsModifiedKey = []byte{0x01} // prefix for each key to a validator modified in the current block
+	TokenMovementKey      = []byte{0x02} // key for the validator tokens added or removed in the current block
+	InstantBondedKey      = []byte{0x03} // prefix for each key to a validator instantly bonded in the current block
 )
 
 // UnbondingType defines the type of unbonding operation
@@ -86,6 +101,44 @@ func GetValidatorKey(operatorAddr sdk.ValAddress) []byte {
 	return append(ValidatorsKey, address.MustLengthPrefix(operatorAddr)...)
 }
 
+// GetValidatorCreationHeightKey creates the key for the creation height of the validator with address
+// VALUE: big endian uint64 block height
+func GetValidatorCreationHeightKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorCreationHeightKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetValidatorJailHistoryKey creates the key for the jail history of the validator with address
+// VALUE: staking/ValidatorJailHistory
+func GetValidatorJailHistoryKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorJailHistoryKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetValidatorPowerHistoryKey creates the key for the power history of the validator with address
+// VALUE: staking/ValidatorPowerHistory
+func GetValidatorPowerHistoryKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorPowerHistoryKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetCommissionScheduleKey creates the key for the commission schedule of the validator with address
+// VALUE: staking/CommissionSchedule
+func GetCommissionScheduleKey(operatorAddr sdk.ValAddress) []byte {
+	return append(CommissionScheduleKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetEvmValidatorKey creates the key marking the validator with address as
+// created through the EVM
+// VALUE: empty
+func GetEvmValidatorKey(operatorAddr sdk.ValAddress) []byte {
+	return append(EvmValidatorKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// GetPendingEvmValidatorKey creates the key for the pending EVM creation
+// message of the validator with address
+// VALUE: staking/MsgCreateValidator
+func GetPendingEvmValidatorKey(operatorAddr sdk.ValAddress) []byte {
+	return append(PendingEvmValidatorKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
 // GetValidatorByConsAddrKey creates the key for the validator with pubkey
 // VALUE: validator operator address ([]byte)
 func GetValidatorByConsAddrKey(addr sdk.ConsAddress) []byte {
@@ -104,6 +157,28 @@ func AddressFromLastValidatorPowerKey(key []byte) []byte {
 	return key[2:] // remove prefix bytes and address length
 }
 
+// GetValidatorModifiedKey creates the transient key marking a validator as modified in the current block
+func GetValidatorModifiedKey(operatorAddr sdk.ValAddress) []byte {
+	return append(ValidatorsModifiedKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// AddressFromValidatorModifiedKey creates the validator operator address from ValidatorsModifiedKey
+func AddressFromValidatorModifiedKey(key []byte) []byte {
+	kv.AssertKeyAtLeastLength(key, 3)
+	return key[2:] // remove prefix bytes and address length
+}
+
+// GetInstantBondedKey creates the transient key marking a validator as instantly bonded in the current block
+func GetInstantBondedKey(operatorAddr sdk.ValAddress) []byte {
+	return append(InstantBondedKey, address.MustLengthPrefix(operatorAddr)...)
+}
+
+// AddressFromInstantBondedKey creates the validator operator address from InstantBondedKey
+func AddressFromInstantBondedKey(key []byte) []byte {
+	kv.AssertKeyAtLeastLength(key, 3)
+	return key[2:] // remove prefix bytes and address length
+}
+
 // GetValidatorsByPowerIndexKey creates the validator by power index.
 // Power index is the key used in the power-store, and represents the relative
 // power ranking of the validator.
@@ -160,6 +235,26 @@ func ParseValidatorPowerRankKey(key []byte) (operAddr []byte) {
 	return operAddr
 }
 
+// ParseValidatorsByPowerIndexKey returns the consensus power and the operator
+// address encoded in a key created by GetValidatorsByPowerIndexKey. The power is
+// stored already reduced, so the entries can be decoded without reading the
+// validators.
+func ParseValidatorsByPowerIndexKey(key []byte) (power int64, operator sdk.ValAddress, err error) {
+	powerBytesLen := 8
+	if len(key) < 1+powerBytesLen+1 || key[0] != ValidatorsByPowerIndexKey[0] {
+		return 0, nil, fmt.Errorf("invalid validators by power index key: %X", key)
+	}
+
+	addrLen := int(key[powerBytesLen+1])
+	if len(key) != 1+powerBytesLen+1+addrLen {
+		return 0, nil, fmt.Errorf("invalid validators by power index key address length: %X", key)
+	}
+
+	power = int64(binary.BigEndian.Uint64(key[1 : powerBytesLen+1]))
+
+	return power, ParseValidatorPowerRankKey(key), nil
+}
+
 // GetValidatorQueueKey returns the prefix key used for getting a set of unbonding
 // validators whose unbonding completion occurs at the given time and height.
 func GetValidatorQueueKey(timestamp time.Time, height int64) []byte {
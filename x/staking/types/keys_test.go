@@ -50,6 +50,28 @@ func TestGetValidatorPowerRank(t *testing.T) {
 	}
 }
 
+func TestParseValidatorsByPowerIndexKey(t *testing.T) {
+	valAddr := sdk.ValAddress(keysAddr1)
+	val := newValidator(t, valAddr, keysPK1)
+
+	for _, power := range []int64{0, 1, 10, 1 << 40} {
+		val.Tokens = sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
+		key := types.GetValidatorsByPowerIndexKey(val, sdk.DefaultPowerReduction)
+
+		gotPower, gotOperator, err := types.ParseValidatorsByPowerIndexKey(key)
+		require.NoError(t, err)
+		require.Equal(t, power, gotPower)
+		require.Equal(t, valAddr, gotOperator)
+	}
+
+	key := types.GetValidatorsByPowerIndexKey(val, sdk.DefaultPowerReduction)
+	_, _, err := types.ParseValidatorsByPowerIndexKey(key[:len(key)-1])
+	require.Error(t, err)
+
+	_, _, err = types.ParseValidatorsByPowerIndexKey(append([]byte{types.ValidatorsKey[0]}, key[1:]...))
+	require.Error(t, err)
+}
+
 func TestGetREDByValDstIndexKey(t *testing.T) {
 	tests := []struct {
 		delAddr    sdk.AccAddress
@@ -31,6 +31,10 @@ const (
 	// SetOrderBeginBlockers.
 	DefaultHistoricalEntries uint32 = 10000
 
+	// DefaultCommissionChangeInterval is the default minimum time between two
+	// commission rate changes of a validator.
+	DefaultCommissionChangeInterval time.Duration = time.Hour * 24
+
 	DefaultMinBondAmountStr = "200000000000000000000000"
 	DefaultMaxBondAmountStr = "300000000000000000000000"
 )
@@ -70,6 +74,10 @@ func DefaultParams() Params {
 		MinBondAmount:     DefaultMinBondAmount,
 		MaxBondAmount:     DefaultMaxBondAmount,
 		EnableEvm:         true,
+
+		CommissionChangeInterval: DefaultCommissionChangeInterval,
+		MaxTokenMovementPerBlock: math.ZeroInt(),
+		MinDelegation:            math.ZeroInt(),
 	}
 }
 
@@ -125,6 +133,30 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateCommissionChangeInterval(p.CommissionChangeInterval); err != nil {
+		return err
+	}
+
+	if err := validateUnbondingMaturityMode(p.UnbondingMaturityMode); err != nil {
+		return err
+	}
+
+	if err := validateMaxTokenMovementPerBlock(p.MaxTokenMovementPerBlock); err != nil {
+		return err
+	}
+
+	if err := validateMinDelegation(p.MinDelegation); err != nil {
+		return err
+	}
+
+	if err := validateMaxCommissionRate(p.MaxCommissionRate); err != nil {
+		return err
+	}
+
+	if maxRate := p.MaxCommissionRate; maxRate != nil && !maxRate.IsNil() && !p.MinCommissionRate.IsNil() && maxRate.LT(p.MinCommissionRate) {
+		return fmt.Errorf("maximum commission rate cannot be less than the minimum commission rate: %s < %s", maxRate, p.MinCommissionRate)
+	}
+
 	return nil
 }
 
@@ -141,6 +173,80 @@ func validateUnbondingTime(i interface{}) error {
 	return nil
 }
 
+func validateCommissionChangeInterval(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("commission change interval cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateUnbondingMaturityMode(i interface{}) error {
+	v, ok := i.(UnbondingMaturityMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := UnbondingMaturityMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid unbonding maturity mode: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxTokenMovementPerBlock(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an unset cap is disabled
+	if !v.IsNil() && v.IsNegative() {
+		return fmt.Errorf("max token movement per block cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinDelegation(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an unset minimum is disabled
+	if !v.IsNil() && v.IsNegative() {
+		return fmt.Errorf("min delegation cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxCommissionRate(i interface{}) error {
+	v, ok := i.(*sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an unset ceiling is disabled
+	if v == nil || v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("maximum commission rate cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("maximum commission rate cannot be greater than 100%%: %s", v)
+	}
+
+	return nil
+}
+
 func validateMaxValidators(i interface{}) error {
 	v, ok := i.(uint32)
 	if !ok {
@@ -35,4 +35,37 @@ func TestValidateParams(t *testing.T) {
 
 	params.MinCommissionRate = math.LegacyNewDec(2)
 	require.Error(t, params.Validate())
+
+	// validate unbonding maturity mode
+	params = types.DefaultParams()
+	params.UnbondingMaturityMode = types.UnbondingMaturityHeightOnly
+	require.NoError(t, params.Validate())
+
+	params.UnbondingMaturityMode = types.UnbondingMaturityMode(3)
+	require.Error(t, params.Validate())
+
+	// validate min delegation
+	params = types.DefaultParams()
+	params.MinDelegation = math.NewInt(100)
+	require.NoError(t, params.Validate())
+
+	params.MinDelegation = math.NewInt(-1)
+	require.Error(t, params.Validate())
+
+	// validate max commission rate
+	params = types.DefaultParams()
+	require.Nil(t, params.MaxCommissionRate)
+	maxRate := math.LegacyNewDec(-1)
+	params.MaxCommissionRate = &maxRate
+	require.Error(t, params.Validate())
+
+	maxRate = math.LegacyNewDec(2)
+	require.Error(t, params.Validate())
+
+	params.MinCommissionRate = math.LegacyNewDecWithPrec(2, 1)
+	maxRate = math.LegacyNewDecWithPrec(1, 1)
+	require.Error(t, params.Validate())
+
+	maxRate = math.LegacyNewDecWithPrec(3, 1)
+	require.NoError(t, params.Validate())
 }
@@ -0,0 +1,13 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+func (m *QueryValidatorConsPubKeyResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pk cryptotypes.PubKey
+	return unpacker.UnpackAny(m.Pubkey, &pk)
+}
+
+var _ codectypes.UnpackInterfacesMessage = &QueryValidatorConsPubKeyResponse{}
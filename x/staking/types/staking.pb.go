@@ -78,6 +78,38 @@ func (BondStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_64c30c6cf92913c9, []int{0}
 }
 
+// UnbondingMaturityMode defines when an unbonding validator is mature.
+type UnbondingMaturityMode int32
+
+const (
+	// HEIGHT_AND_TIME requires both the unbonding height and time to be reached.
+	UnbondingMaturityHeightAndTime UnbondingMaturityMode = 0
+	// TIME_ONLY only requires the unbonding time to be reached.
+	UnbondingMaturityTimeOnly UnbondingMaturityMode = 1
+	// HEIGHT_ONLY only requires the unbonding height to be reached.
+	UnbondingMaturityHeightOnly UnbondingMaturityMode = 2
+)
+
+var UnbondingMaturityMode_name = map[int32]string{
+	0: "UNBONDING_MATURITY_MODE_HEIGHT_AND_TIME",
+	1: "UNBONDING_MATURITY_MODE_TIME_ONLY",
+	2: "UNBONDING_MATURITY_MODE_HEIGHT_ONLY",
+}
+
+var UnbondingMaturityMode_value = map[string]int32{
+	"UNBONDING_MATURITY_MODE_HEIGHT_AND_TIME": 0,
+	"UNBONDING_MATURITY_MODE_TIME_ONLY":       1,
+	"UNBONDING_MATURITY_MODE_HEIGHT_ONLY":     2,
+}
+
+func (x UnbondingMaturityMode) String() string {
+	return proto.EnumName(UnbondingMaturityMode_name, int32(x))
+}
+
+func (UnbondingMaturityMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{1}
+}
+
 // Infraction indicates the infraction a validator commited.
 type Infraction int32
 
@@ -107,7 +139,7 @@ func (x Infraction) String() string {
 }
 
 func (Infraction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{1}
+	return fileDescriptor_64c30c6cf92913c9, []int{2}
 }
 
 // HistoricalInfo contains header and validator information for a given block.
@@ -256,6 +288,101 @@ func (m *Commission) GetUpdateTime() time.Time {
 	return time.Time{}
 }
 
+// CommissionScheduleEntry defines a commission rate taking effect at a given
+// time.
+type CommissionScheduleEntry struct {
+	// effective_time is the time from which the rate applies.
+	EffectiveTime time.Time `protobuf:"bytes,1,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
+	// rate is the commission rate charged to delegators, as a fraction.
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+}
+
+func (m *CommissionScheduleEntry) Reset()         { *m = CommissionScheduleEntry{} }
+func (m *CommissionScheduleEntry) String() string { return proto.CompactTextString(m) }
+func (*CommissionScheduleEntry) ProtoMessage()    {}
+func (*CommissionScheduleEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{3}
+}
+func (m *CommissionScheduleEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionScheduleEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionScheduleEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionScheduleEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionScheduleEntry.Merge(m, src)
+}
+func (m *CommissionScheduleEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionScheduleEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionScheduleEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionScheduleEntry proto.InternalMessageInfo
+
+func (m *CommissionScheduleEntry) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return time.Time{}
+}
+
+// CommissionSchedule defines the scheduled commission rate changes of a
+// validator, ordered by effective time.
+type CommissionSchedule struct {
+	Entries []CommissionScheduleEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *CommissionSchedule) Reset()         { *m = CommissionSchedule{} }
+func (m *CommissionSchedule) String() string { return proto.CompactTextString(m) }
+func (*CommissionSchedule) ProtoMessage()    {}
+func (*CommissionSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{4}
+}
+func (m *CommissionSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionSchedule.Merge(m, src)
+}
+func (m *CommissionSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionSchedule proto.InternalMessageInfo
+
+func (m *CommissionSchedule) GetEntries() []CommissionScheduleEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
 // Description defines a validator description.
 type Description struct {
 	// moniker defines a human-readable name for the validator.
@@ -273,7 +400,7 @@ type Description struct {
 func (m *Description) Reset()      { *m = Description{} }
 func (*Description) ProtoMessage() {}
 func (*Description) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{3}
+	return fileDescriptor_64c30c6cf92913c9, []int{5}
 }
 func (m *Description) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -379,7 +506,7 @@ type Validator struct {
 func (m *Validator) Reset()      { *m = Validator{} }
 func (*Validator) ProtoMessage() {}
 func (*Validator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{4}
+	return fileDescriptor_64c30c6cf92913c9, []int{6}
 }
 func (m *Validator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -416,7 +543,7 @@ type ValAddresses struct {
 func (m *ValAddresses) Reset()      { *m = ValAddresses{} }
 func (*ValAddresses) ProtoMessage() {}
 func (*ValAddresses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{5}
+	return fileDescriptor_64c30c6cf92913c9, []int{7}
 }
 func (m *ValAddresses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -452,6 +579,209 @@ func (m *ValAddresses) GetAddresses() []string {
 	return nil
 }
 
+// PowerHistoryEntry defines the consensus power of a validator from a given
+// block height.
+type PowerHistoryEntry struct {
+	// height is the block height at which the power changed.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// power is the consensus power of the validator.
+	Power int64 `protobuf:"varint,2,opt,name=power,proto3" json:"power,omitempty"`
+}
+
+func (m *PowerHistoryEntry) Reset()         { *m = PowerHistoryEntry{} }
+func (m *PowerHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*PowerHistoryEntry) ProtoMessage()    {}
+func (*PowerHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{8}
+}
+func (m *PowerHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PowerHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PowerHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PowerHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PowerHistoryEntry.Merge(m, src)
+}
+func (m *PowerHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PowerHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PowerHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PowerHistoryEntry proto.InternalMessageInfo
+
+func (m *PowerHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PowerHistoryEntry) GetPower() int64 {
+	if m != nil {
+		return m.Power
+	}
+	return 0
+}
+
+// ValidatorPowerHistory defines the latest consensus power changes of a
+// validator, oldest first.
+type ValidatorPowerHistory struct {
+	Entries []PowerHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *ValidatorPowerHistory) Reset()         { *m = ValidatorPowerHistory{} }
+func (m *ValidatorPowerHistory) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPowerHistory) ProtoMessage()    {}
+func (*ValidatorPowerHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{9}
+}
+func (m *ValidatorPowerHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPowerHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPowerHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPowerHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPowerHistory.Merge(m, src)
+}
+func (m *ValidatorPowerHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPowerHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPowerHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPowerHistory proto.InternalMessageInfo
+
+func (m *ValidatorPowerHistory) GetEntries() []PowerHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// JailHistoryEntry defines a jailing of a validator.
+type JailHistoryEntry struct {
+	// height is the block height at which the validator was jailed.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// reason is why the validator was jailed.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *JailHistoryEntry) Reset()         { *m = JailHistoryEntry{} }
+func (m *JailHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*JailHistoryEntry) ProtoMessage()    {}
+func (*JailHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{10}
+}
+func (m *JailHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JailHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JailHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JailHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JailHistoryEntry.Merge(m, src)
+}
+func (m *JailHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *JailHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_JailHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JailHistoryEntry proto.InternalMessageInfo
+
+func (m *JailHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *JailHistoryEntry) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// ValidatorJailHistory defines the latest jailings of a validator, oldest
+// first.
+type ValidatorJailHistory struct {
+	Entries []JailHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+}
+
+func (m *ValidatorJailHistory) Reset()         { *m = ValidatorJailHistory{} }
+func (m *ValidatorJailHistory) String() string { return proto.CompactTextString(m) }
+func (*ValidatorJailHistory) ProtoMessage()    {}
+func (*ValidatorJailHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{11}
+}
+func (m *ValidatorJailHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorJailHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorJailHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorJailHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorJailHistory.Merge(m, src)
+}
+func (m *ValidatorJailHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorJailHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorJailHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorJailHistory proto.InternalMessageInfo
+
+func (m *ValidatorJailHistory) GetEntries() []JailHistoryEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
 // DVPair is struct that just has a delegator-validator pair with no other data.
 // It is intended to be used as a marshalable pointer. For example, a DVPair can
 // be used to construct the key to getting an UnbondingDelegation from state.
@@ -463,7 +793,7 @@ type DVPair struct {
 func (m *DVPair) Reset()      { *m = DVPair{} }
 func (*DVPair) ProtoMessage() {}
 func (*DVPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{6}
+	return fileDescriptor_64c30c6cf92913c9, []int{12}
 }
 func (m *DVPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -501,7 +831,7 @@ func (m *DVPairs) Reset()         { *m = DVPairs{} }
 func (m *DVPairs) String() string { return proto.CompactTextString(m) }
 func (*DVPairs) ProtoMessage()    {}
 func (*DVPairs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{7}
+	return fileDescriptor_64c30c6cf92913c9, []int{13}
 }
 func (m *DVPairs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -550,7 +880,7 @@ type DVVTriplet struct {
 func (m *DVVTriplet) Reset()      { *m = DVVTriplet{} }
 func (*DVVTriplet) ProtoMessage() {}
 func (*DVVTriplet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{8}
+	return fileDescriptor_64c30c6cf92913c9, []int{14}
 }
 func (m *DVVTriplet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -588,7 +918,7 @@ func (m *DVVTriplets) Reset()         { *m = DVVTriplets{} }
 func (m *DVVTriplets) String() string { return proto.CompactTextString(m) }
 func (*DVVTriplets) ProtoMessage()    {}
 func (*DVVTriplets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{9}
+	return fileDescriptor_64c30c6cf92913c9, []int{15}
 }
 func (m *DVVTriplets) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +969,7 @@ type Delegation struct {
 func (m *Delegation) Reset()      { *m = Delegation{} }
 func (*Delegation) ProtoMessage() {}
 func (*Delegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{10}
+	return fileDescriptor_64c30c6cf92913c9, []int{16}
 }
 func (m *Delegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -682,7 +1012,7 @@ type UnbondingDelegation struct {
 func (m *UnbondingDelegation) Reset()      { *m = UnbondingDelegation{} }
 func (*UnbondingDelegation) ProtoMessage() {}
 func (*UnbondingDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{11}
+	return fileDescriptor_64c30c6cf92913c9, []int{17}
 }
 func (m *UnbondingDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -730,7 +1060,7 @@ type UnbondingDelegationEntry struct {
 func (m *UnbondingDelegationEntry) Reset()      { *m = UnbondingDelegationEntry{} }
 func (*UnbondingDelegationEntry) ProtoMessage() {}
 func (*UnbondingDelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{12}
+	return fileDescriptor_64c30c6cf92913c9, []int{18}
 }
 func (m *UnbondingDelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -806,7 +1136,7 @@ type RedelegationEntry struct {
 func (m *RedelegationEntry) Reset()      { *m = RedelegationEntry{} }
 func (*RedelegationEntry) ProtoMessage() {}
 func (*RedelegationEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{13}
+	return fileDescriptor_64c30c6cf92913c9, []int{19}
 }
 func (m *RedelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +1209,7 @@ type Redelegation struct {
 func (m *Redelegation) Reset()      { *m = Redelegation{} }
 func (*Redelegation) ProtoMessage() {}
 func (*Redelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{14}
+	return fileDescriptor_64c30c6cf92913c9, []int{20}
 }
 func (m *Redelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -928,12 +1258,42 @@ type Params struct {
 	MaxBondAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=max_bond_amount,json=maxBondAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_bond_amount" yaml:"max_bond_amount"`
 	// enable_evm means validator can not accept delegation and needs to apply on evm contract before create validator
 	EnableEvm bool `protobuf:"varint,9,opt,name=enable_evm,json=enableEvm,proto3" json:"enable_evm,omitempty"`
+	// commission_change_interval is the minimum time between two commission rate
+	// changes of a validator.
+	CommissionChangeInterval time.Duration `protobuf:"bytes,10,opt,name=commission_change_interval,json=commissionChangeInterval,proto3,stdduration" json:"commission_change_interval"`
+	// power_history_entries is the number of consensus power changes kept per
+	// validator. Zero disables the power history.
+	PowerHistoryEntries uint32 `protobuf:"varint,11,opt,name=power_history_entries,json=powerHistoryEntries,proto3" json:"power_history_entries,omitempty"`
+	// max_validators_transition_step is the maximum number of validators leaving
+	// the active set per block after max_validators is lowered. Zero shrinks the
+	// active set at once.
+	MaxValidatorsTransitionStep uint32 `protobuf:"varint,12,opt,name=max_validators_transition_step,json=maxValidatorsTransitionStep,proto3" json:"max_validators_transition_step,omitempty"`
+	// unbonding_maturity_mode defines whether the unbonding validators mature
+	// on both their unbonding height and time, or on only one of them.
+	UnbondingMaturityMode UnbondingMaturityMode `protobuf:"varint,13,opt,name=unbonding_maturity_mode,json=unbondingMaturityMode,proto3,enum=cosmos.staking.v1beta1.UnbondingMaturityMode" json:"unbonding_maturity_mode,omitempty"`
+	// max_token_movement_per_block is the maximum amount of tokens added to or
+	// removed from the validators by delegations and undelegations within a
+	// block, a sanity cap tripping on a runaway loop. Exceeding it fails the
+	// transaction, slashing is not subject to it. Zero disables the cap.
+	MaxTokenMovementPerBlock github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,14,opt,name=max_token_movement_per_block,json=maxTokenMovementPerBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_token_movement_per_block"`
+	// min_delegation is the minimum amount of tokens of a delegation, keeping
+	// dust delegations out of the store. Zero disables the minimum.
+	MinDelegation github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,15,opt,name=min_delegation,json=minDelegation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_delegation"`
+	// min_delegation_exempt_self_delegation exempts the self delegations of the
+	// validator operators from min_delegation.
+	MinDelegationExemptSelfDelegation bool `protobuf:"varint,16,opt,name=min_delegation_exempt_self_delegation,json=minDelegationExemptSelfDelegation,proto3" json:"min_delegation_exempt_self_delegation,omitempty"`
+	// max_commission_rate is the chain-wide maximum commission rate that a
+	// validator can charge their delegators. An unset rate disables the ceiling.
+	MaxCommissionRate *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=max_commission_rate,json=maxCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_commission_rate,omitempty"`
+	// jail_history_entries is the number of jailings kept per validator. Zero
+	// disables the jail history.
+	JailHistoryEntries uint32 `protobuf:"varint,18,opt,name=jail_history_entries,json=jailHistoryEntries,proto3" json:"jail_history_entries,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{15}
+	return fileDescriptor_64c30c6cf92913c9, []int{21}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1004,6 +1364,48 @@ func (m *Params) GetEnableEvm() bool {
 	return false
 }
 
+func (m *Params) GetCommissionChangeInterval() time.Duration {
+	if m != nil {
+		return m.CommissionChangeInterval
+	}
+	return 0
+}
+
+func (m *Params) GetPowerHistoryEntries() uint32 {
+	if m != nil {
+		return m.PowerHistoryEntries
+	}
+	return 0
+}
+
+func (m *Params) GetMaxValidatorsTransitionStep() uint32 {
+	if m != nil {
+		return m.MaxValidatorsTransitionStep
+	}
+	return 0
+}
+
+func (m *Params) GetUnbondingMaturityMode() UnbondingMaturityMode {
+	if m != nil {
+		return m.UnbondingMaturityMode
+	}
+	return UnbondingMaturityHeightAndTime
+}
+
+func (m *Params) GetMinDelegationExemptSelfDelegation() bool {
+	if m != nil {
+		return m.MinDelegationExemptSelfDelegation
+	}
+	return false
+}
+
+func (m *Params) GetJailHistoryEntries() uint32 {
+	if m != nil {
+		return m.JailHistoryEntries
+	}
+	return 0
+}
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -1014,7 +1416,7 @@ type DelegationResponse struct {
 func (m *DelegationResponse) Reset()      { *m = DelegationResponse{} }
 func (*DelegationResponse) ProtoMessage() {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{16}
+	return fileDescriptor_64c30c6cf92913c9, []int{22}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1069,7 +1471,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{17}
+	return fileDescriptor_64c30c6cf92913c9, []int{23}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1117,7 +1519,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{18}
+	return fileDescriptor_64c30c6cf92913c9, []int{24}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1171,7 +1573,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{19}
+	return fileDescriptor_64c30c6cf92913c9, []int{25}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1210,7 +1612,7 @@ func (m *ValidatorUpdates) Reset()         { *m = ValidatorUpdates{} }
 func (m *ValidatorUpdates) String() string { return proto.CompactTextString(m) }
 func (*ValidatorUpdates) ProtoMessage()    {}
 func (*ValidatorUpdates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_64c30c6cf92913c9, []int{20}
+	return fileDescriptor_64c30c6cf92913c9, []int{26}
 }
 func (m *ValidatorUpdates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1248,13 +1650,20 @@ func (m *ValidatorUpdates) GetUpdates() []types3.ValidatorUpdate {
 
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
+	proto.RegisterEnum("cosmos.staking.v1beta1.UnbondingMaturityMode", UnbondingMaturityMode_name, UnbondingMaturityMode_value)
 	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
 	proto.RegisterType((*HistoricalInfo)(nil), "cosmos.staking.v1beta1.HistoricalInfo")
 	proto.RegisterType((*CommissionRates)(nil), "cosmos.staking.v1beta1.CommissionRates")
 	proto.RegisterType((*Commission)(nil), "cosmos.staking.v1beta1.Commission")
+	proto.RegisterType((*CommissionScheduleEntry)(nil), "cosmos.staking.v1beta1.CommissionScheduleEntry")
+	proto.RegisterType((*CommissionSchedule)(nil), "cosmos.staking.v1beta1.CommissionSchedule")
 	proto.RegisterType((*Description)(nil), "cosmos.staking.v1beta1.Description")
 	proto.RegisterType((*Validator)(nil), "cosmos.staking.v1beta1.Validator")
 	proto.RegisterType((*ValAddresses)(nil), "cosmos.staking.v1beta1.ValAddresses")
+	proto.RegisterType((*PowerHistoryEntry)(nil), "cosmos.staking.v1beta1.PowerHistoryEntry")
+	proto.RegisterType((*ValidatorPowerHistory)(nil), "cosmos.staking.v1beta1.ValidatorPowerHistory")
+	proto.RegisterType((*JailHistoryEntry)(nil), "cosmos.staking.v1beta1.JailHistoryEntry")
+	proto.RegisterType((*ValidatorJailHistory)(nil), "cosmos.staking.v1beta1.ValidatorJailHistory")
 	proto.RegisterType((*DVPair)(nil), "cosmos.staking.v1beta1.DVPair")
 	proto.RegisterType((*DVPairs)(nil), "cosmos.staking.v1beta1.DVPairs")
 	proto.RegisterType((*DVVTriplet)(nil), "cosmos.staking.v1beta1.DVVTriplet")
@@ -1277,130 +1686,159 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 1960 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0x4d, 0x6c, 0x1b, 0xc7,
-	0x15, 0xe6, 0x52, 0x34, 0x45, 0x3e, 0x4a, 0x22, 0x35, 0x76, 0x6c, 0x9a, 0x6e, 0x44, 0x86, 0x49,
-	0x13, 0xc5, 0x88, 0xa9, 0xda, 0x05, 0x7a, 0x50, 0x83, 0x16, 0xa6, 0x48, 0xc7, 0x4c, 0x1d, 0x99,
-	0x58, 0x4a, 0x4a, 0xd3, 0xa2, 0x58, 0x0c, 0x77, 0x47, 0xd4, 0x56, 0xdc, 0x59, 0x62, 0x67, 0xa8,
-	0x88, 0x40, 0x0f, 0x45, 0x4f, 0x86, 0x0e, 0x45, 0x80, 0x5e, 0x82, 0x02, 0x06, 0x0c, 0xb4, 0x87,
-	0x1e, 0x52, 0x20, 0x87, 0xa0, 0x97, 0x1e, 0x8a, 0x1e, 0x0a, 0xa4, 0xbd, 0xd4, 0xc8, 0xa9, 0x28,
-	0x0a, 0xb5, 0xb0, 0x0f, 0x29, 0x7a, 0x2a, 0x7a, 0x6f, 0x51, 0xcc, 0xec, 0xec, 0x0f, 0x49, 0xc9,
-	0x96, 0x5c, 0x35, 0x08, 0x90, 0x0b, 0xb9, 0x33, 0xf3, 0xde, 0x37, 0xf3, 0xbe, 0xf7, 0xe6, 0xcd,
-	0xbc, 0x81, 0x97, 0x4c, 0x97, 0x39, 0x2e, 0x5b, 0x61, 0x1c, 0xef, 0xda, 0xb4, 0xb7, 0xb2, 0x77,
-	0xbd, 0x4b, 0x38, 0xbe, 0x1e, 0xb4, 0x6b, 0x03, 0xcf, 0xe5, 0x2e, 0xba, 0xe8, 0x4b, 0xd5, 0x82,
-	0x5e, 0x25, 0x55, 0xba, 0xd0, 0x73, 0x7b, 0xae, 0x14, 0x59, 0x11, 0x5f, 0xbe, 0x74, 0xe9, 0x72,
-	0xcf, 0x75, 0x7b, 0x7d, 0xb2, 0x22, 0x5b, 0xdd, 0xe1, 0xf6, 0x0a, 0xa6, 0x23, 0x35, 0xb4, 0x34,
-	0x39, 0x64, 0x0d, 0x3d, 0xcc, 0x6d, 0x97, 0xaa, 0xf1, 0xf2, 0xe4, 0x38, 0xb7, 0x1d, 0xc2, 0x38,
-	0x76, 0x06, 0x01, 0xb6, 0xbf, 0x12, 0xc3, 0x9f, 0x54, 0x2d, 0x4b, 0x61, 0x2b, 0x53, 0xba, 0x98,
-	0x91, 0xd0, 0x0e, 0xd3, 0xb5, 0x03, 0xec, 0x45, 0xec, 0xd8, 0xd4, 0x5d, 0x91, 0xbf, 0xaa, 0xeb,
-	0x4b, 0x9c, 0x50, 0x8b, 0x78, 0x8e, 0x4d, 0xf9, 0x0a, 0x1f, 0x0d, 0x08, 0xf3, 0x7f, 0xd5, 0xe8,
-	0x95, 0xd8, 0x28, 0xee, 0x9a, 0x76, 0x7c, 0xb0, 0xfa, 0x13, 0x0d, 0x16, 0x6e, 0xdb, 0x8c, 0xbb,
-	0x9e, 0x6d, 0xe2, 0x7e, 0x8b, 0x6e, 0xbb, 0xe8, 0xeb, 0x90, 0xde, 0x21, 0xd8, 0x22, 0x5e, 0x51,
-	0xab, 0x68, 0xcb, 0xb9, 0x1b, 0xc5, 0x5a, 0x04, 0x50, 0xf3, 0x75, 0x6f, 0xcb, 0xf1, 0x7a, 0xf6,
-	0xe3, 0xc3, 0x72, 0xe2, 0x17, 0x9f, 0x7e, 0x78, 0x55, 0xd3, 0x95, 0x0a, 0x6a, 0x40, 0x7a, 0x0f,
-	0xf7, 0x19, 0xe1, 0xc5, 0x64, 0x65, 0x66, 0x39, 0x77, 0xe3, 0x85, 0xda, 0xd1, 0x9c, 0xd7, 0xb6,
-	0x70, 0xdf, 0xb6, 0x30, 0x77, 0xc7, 0x51, 0x7c, 0xdd, 0xea, 0x07, 0x49, 0xc8, 0xaf, 0xb9, 0x8e,
-	0x63, 0x33, 0x66, 0xbb, 0x54, 0xc7, 0x9c, 0x30, 0xd4, 0x86, 0x94, 0x87, 0x39, 0x91, 0x8b, 0xca,
-	0xd6, 0x5f, 0x17, 0x4a, 0x7f, 0x3e, 0x2c, 0xbf, 0xdc, 0xb3, 0xf9, 0xce, 0xb0, 0x5b, 0x33, 0x5d,
-	0x47, 0xd1, 0xa8, 0xfe, 0xae, 0x31, 0x6b, 0x57, 0x59, 0xda, 0x20, 0xe6, 0x27, 0x1f, 0x5d, 0x03,
-	0xb5, 0x90, 0x06, 0x31, 0x75, 0x89, 0x84, 0xde, 0x86, 0x8c, 0x83, 0xf7, 0x0d, 0x89, 0x9a, 0x3c,
-	0x03, 0xd4, 0x59, 0x07, 0xef, 0x8b, 0xb5, 0x22, 0x0b, 0xf2, 0x02, 0xd8, 0xdc, 0xc1, 0xb4, 0x47,
-	0x7c, 0xfc, 0x99, 0x33, 0xc0, 0x9f, 0x77, 0xf0, 0xfe, 0x9a, 0xc4, 0x14, 0xb3, 0xac, 0x66, 0xde,
-	0x7f, 0x50, 0x4e, 0xfc, 0xfd, 0x41, 0x59, 0xab, 0xfe, 0x4e, 0x03, 0x88, 0xe8, 0x42, 0x18, 0x0a,
-	0x66, 0xd8, 0x92, 0xd3, 0x33, 0xe5, 0xca, 0x57, 0x8e, 0xf3, 0xc6, 0x04, 0xd9, 0xf5, 0x79, 0xb1,
-	0xd0, 0x87, 0x87, 0x65, 0xcd, 0xf7, 0x4b, 0xde, 0x9c, 0x70, 0xc6, 0x9b, 0x90, 0x1b, 0x0e, 0x2c,
-	0xcc, 0x89, 0x21, 0x22, 0x5b, 0xb2, 0x97, 0xbb, 0x51, 0xaa, 0xf9, 0x61, 0x5f, 0x0b, 0xc2, 0xbe,
-	0xb6, 0x11, 0x84, 0xbd, 0x0f, 0xf8, 0xde, 0x5f, 0x03, 0x40, 0xf0, 0xb5, 0xc5, 0x78, 0xcc, 0x8e,
-	0x0f, 0x34, 0xc8, 0x35, 0x08, 0x33, 0x3d, 0x7b, 0x20, 0x36, 0x13, 0x2a, 0xc2, 0xac, 0xe3, 0x52,
-	0x7b, 0x57, 0x85, 0x62, 0x56, 0x0f, 0x9a, 0xa8, 0x04, 0x19, 0xdb, 0x22, 0x94, 0xdb, 0x7c, 0xe4,
-	0xbb, 0x4e, 0x0f, 0xdb, 0x42, 0xeb, 0x5d, 0xd2, 0x65, 0x76, 0xc0, 0xba, 0x1e, 0x34, 0xd1, 0xab,
-	0x50, 0x60, 0xc4, 0x1c, 0x7a, 0x36, 0x1f, 0x19, 0xa6, 0x4b, 0x39, 0x36, 0x79, 0x31, 0x25, 0x45,
-	0xf2, 0x41, 0xff, 0x9a, 0xdf, 0x2d, 0x40, 0x2c, 0xc2, 0xb1, 0xdd, 0x67, 0xc5, 0x73, 0x3e, 0x88,
-	0x6a, 0xc6, 0x96, 0xfb, 0xeb, 0x59, 0xc8, 0x86, 0x61, 0x8c, 0xd6, 0xa0, 0xe0, 0x0e, 0x88, 0x27,
-	0xbe, 0x0d, 0x6c, 0x59, 0x1e, 0x61, 0x4c, 0xc5, 0x6a, 0xf1, 0x93, 0x8f, 0xae, 0x5d, 0x50, 0xc4,
-	0xdf, 0xf4, 0x47, 0x3a, 0xdc, 0xb3, 0x69, 0x4f, 0xcf, 0x07, 0x1a, 0xaa, 0x1b, 0xbd, 0x23, 0x5c,
-	0x47, 0x19, 0xa1, 0x6c, 0xc8, 0x8c, 0xc1, 0xb0, 0xbb, 0x4b, 0x46, 0x8a, 0xdc, 0x0b, 0x53, 0xe4,
-	0xde, 0xa4, 0xa3, 0x7a, 0xf1, 0x0f, 0x11, 0xb4, 0xe9, 0x8d, 0x06, 0xdc, 0xad, 0xb5, 0x87, 0xdd,
-	0x6f, 0x91, 0x91, 0x70, 0x99, 0xc2, 0x69, 0x4b, 0x18, 0x74, 0x11, 0xd2, 0xdf, 0xc7, 0x76, 0x9f,
-	0x58, 0x92, 0x95, 0x8c, 0xae, 0x5a, 0x68, 0x15, 0xd2, 0x8c, 0x63, 0x3e, 0x64, 0x92, 0x8a, 0x85,
-	0x1b, 0xd5, 0xe3, 0x62, 0xa4, 0xee, 0x52, 0xab, 0x23, 0x25, 0x75, 0xa5, 0x81, 0x36, 0x20, 0xcd,
-	0xdd, 0x5d, 0x42, 0x15, 0x49, 0xa7, 0x8a, 0xef, 0x16, 0xe5, 0xb1, 0xf8, 0x6e, 0x51, 0xae, 0x2b,
-	0x2c, 0xd4, 0x83, 0x82, 0x45, 0xfa, 0xa4, 0x27, 0xa9, 0x64, 0x3b, 0xd8, 0x23, 0xac, 0x98, 0x3e,
-	0x83, 0xfd, 0x93, 0x0f, 0x51, 0x3b, 0x12, 0x14, 0xb5, 0x21, 0x67, 0x45, 0xe1, 0x56, 0x9c, 0x95,
-	0x44, 0xbf, 0x78, 0x9c, 0xfd, 0xb1, 0xc8, 0x8c, 0xe7, 0xac, 0x38, 0x84, 0x88, 0xb0, 0x21, 0xed,
-	0xba, 0xd4, 0xb2, 0x69, 0xcf, 0xd8, 0x21, 0x76, 0x6f, 0x87, 0x17, 0x33, 0x15, 0x6d, 0x79, 0x46,
-	0xcf, 0x87, 0xfd, 0xb7, 0x65, 0x37, 0x6a, 0xc3, 0x42, 0x24, 0x2a, 0x77, 0x51, 0xf6, 0xb4, 0xbb,
-	0x68, 0x3e, 0x04, 0x10, 0x22, 0xe8, 0x2d, 0x80, 0x68, 0x9f, 0x16, 0x41, 0xa2, 0x55, 0x9f, 0xbe,
-	0xe3, 0xe3, 0xc6, 0xc4, 0x00, 0x50, 0x1f, 0xce, 0x3b, 0x36, 0x35, 0x18, 0xe9, 0x6f, 0x1b, 0x8a,
-	0x39, 0x81, 0x9b, 0x3b, 0x03, 0x4f, 0x2f, 0x3a, 0x36, 0xed, 0x90, 0xfe, 0x76, 0x23, 0x84, 0x45,
-	0xaf, 0xc3, 0x95, 0x88, 0x0e, 0x97, 0x1a, 0x3b, 0x6e, 0xdf, 0x32, 0x3c, 0xb2, 0x6d, 0x98, 0xee,
-	0x90, 0xf2, 0xe2, 0x9c, 0x24, 0xf1, 0x52, 0x28, 0x72, 0x97, 0xde, 0x76, 0xfb, 0x96, 0x4e, 0xb6,
-	0xd7, 0xc4, 0x30, 0x7a, 0x11, 0x22, 0x2e, 0x0c, 0xdb, 0x62, 0xc5, 0xf9, 0xca, 0xcc, 0x72, 0x4a,
-	0x9f, 0x0b, 0x3b, 0x5b, 0x16, 0x5b, 0x9d, 0xbb, 0xf7, 0xa0, 0x9c, 0x50, 0xbb, 0x37, 0x51, 0x6d,
-	0xc3, 0xdc, 0x16, 0xee, 0xab, 0x8d, 0x47, 0x18, 0xfa, 0x1a, 0x64, 0x71, 0xd0, 0x28, 0x6a, 0x95,
-	0x99, 0x27, 0x6e, 0xdc, 0x48, 0xd4, 0xcf, 0x07, 0x3f, 0xfc, 0x4b, 0x45, 0xab, 0xfe, 0x5c, 0x83,
-	0x74, 0x63, 0xab, 0x8d, 0x6d, 0x0f, 0x35, 0x61, 0x31, 0x0a, 0xe1, 0x93, 0x66, 0x83, 0x28, 0xea,
-	0x83, 0x74, 0xd0, 0x84, 0xc5, 0xbd, 0x20, 0xc1, 0x84, 0x30, 0xc9, 0xa7, 0xc1, 0x84, 0x2a, 0xaa,
-	0x7f, 0xc2, 0xf0, 0x37, 0x61, 0xd6, 0x5f, 0x25, 0x43, 0xdf, 0x84, 0x73, 0x03, 0xf1, 0x21, 0xed,
-	0xcd, 0xdd, 0x58, 0x3a, 0x36, 0xf4, 0xa5, 0x7c, 0x3c, 0x50, 0x7c, 0xbd, 0xea, 0xbf, 0x35, 0x80,
-	0xc6, 0xd6, 0xd6, 0x86, 0x67, 0x0f, 0xfa, 0x84, 0x9f, 0x95, 0xd9, 0x77, 0xe0, 0xb9, 0xc8, 0x6c,
-	0xe6, 0x99, 0x27, 0x36, 0xfd, 0x7c, 0xa8, 0xd6, 0xf1, 0xcc, 0x23, 0xd1, 0x2c, 0xc6, 0x43, 0xb4,
-	0x99, 0x13, 0xa3, 0x35, 0x18, 0x3f, 0x9a, 0xcb, 0x6f, 0x43, 0x2e, 0x32, 0x9f, 0xa1, 0x16, 0x64,
-	0xb8, 0xfa, 0x56, 0x94, 0x56, 0x8f, 0xa7, 0x34, 0x50, 0x8b, 0xd3, 0x1a, 0xaa, 0x57, 0xff, 0x23,
-	0x98, 0x8d, 0xb6, 0xc7, 0xe7, 0x2a, 0xa0, 0x44, 0xde, 0x57, 0x79, 0xf9, 0x2c, 0xee, 0x35, 0x0a,
-	0x6b, 0x82, 0xda, 0x7b, 0x49, 0x38, 0xbf, 0x19, 0x6c, 0xdf, 0xcf, 0x2d, 0x13, 0x9b, 0x30, 0x4b,
-	0x28, 0xf7, 0x6c, 0x49, 0x85, 0x70, 0xf8, 0x57, 0x8e, 0x73, 0xf8, 0x11, 0xb6, 0x34, 0x29, 0xf7,
-	0x46, 0x71, 0xf7, 0x07, 0x58, 0x13, 0x54, 0xfc, 0x76, 0x06, 0x8a, 0xc7, 0xa9, 0xa3, 0x57, 0x20,
-	0x6f, 0x7a, 0x44, 0x76, 0x04, 0x27, 0x8e, 0x26, 0x93, 0xe5, 0x42, 0xd0, 0xad, 0x0e, 0x1c, 0x1d,
-	0xc4, 0x35, 0x4e, 0x44, 0x97, 0x10, 0x7d, 0xb6, 0x7b, 0xdb, 0x42, 0x84, 0x20, 0x8f, 0x1c, 0x02,
-	0x79, 0x9b, 0xda, 0xdc, 0xc6, 0x7d, 0xa3, 0x8b, 0xfb, 0x98, 0x9a, 0xcf, 0x72, 0xd3, 0x9d, 0x3e,
-	0x1f, 0x16, 0x14, 0x68, 0xdd, 0xc7, 0x44, 0x5b, 0x30, 0x1b, 0xc0, 0xa7, 0xce, 0x00, 0x3e, 0x00,
-	0x43, 0x2f, 0xc0, 0x5c, 0xfc, 0xd8, 0x90, 0xb7, 0x98, 0x94, 0x9e, 0x8b, 0x9d, 0x1a, 0x4f, 0x3b,
-	0x97, 0xd2, 0x4f, 0x3c, 0x97, 0x62, 0x97, 0xc5, 0xdf, 0xcc, 0xc0, 0xa2, 0x4e, 0xac, 0x2f, 0xa0,
-	0xf3, 0xbe, 0x0b, 0xe0, 0x6f, 0x70, 0x91, 0x7c, 0x9f, 0xc1, 0x7f, 0xd3, 0x09, 0x23, 0xeb, 0xe3,
-	0x35, 0x18, 0xff, 0x2c, 0x3d, 0xf8, 0xc7, 0x24, 0xcc, 0xc5, 0x3d, 0xf8, 0x05, 0x38, 0xed, 0xd0,
-	0x7a, 0x94, 0xde, 0x52, 0x32, 0xbd, 0xbd, 0x7a, 0x5c, 0x7a, 0x9b, 0x8a, 0xed, 0x13, 0xe4, 0xb5,
-	0x9f, 0x9e, 0x83, 0x74, 0x1b, 0x7b, 0xd8, 0x61, 0xe8, 0xee, 0xd4, 0x6d, 0xd8, 0xaf, 0x58, 0x2f,
-	0x4f, 0x85, 0x77, 0x43, 0x3d, 0xb5, 0xf8, 0xd1, 0xfd, 0xfe, 0x71, 0x97, 0xe1, 0x2f, 0xc3, 0x82,
-	0xa8, 0xc1, 0x43, 0xa3, 0x7c, 0x3a, 0xe7, 0x65, 0x11, 0x1d, 0x16, 0x6d, 0x0c, 0x95, 0x21, 0x27,
-	0xc4, 0xa2, 0x1c, 0x2e, 0x64, 0xc0, 0xc1, 0xfb, 0x4d, 0xbf, 0x07, 0x5d, 0x03, 0xb4, 0x13, 0xbe,
-	0x8f, 0x18, 0x11, 0x19, 0x42, 0x6e, 0x31, 0x1a, 0x09, 0xc4, 0x9f, 0x07, 0x10, 0xab, 0x30, 0x2c,
-	0x42, 0x5d, 0x47, 0x95, 0x8e, 0x59, 0xd1, 0xd3, 0x10, 0x1d, 0xe8, 0x07, 0xfe, 0x9d, 0x7a, 0xa2,
-	0x3c, 0x57, 0xd5, 0xcd, 0x9d, 0xd3, 0x6d, 0x8a, 0x7f, 0x1d, 0x96, 0x4b, 0x23, 0xec, 0xf4, 0x57,
-	0xab, 0x47, 0x40, 0x56, 0xe5, 0x1d, 0x7b, 0xbc, 0xac, 0x47, 0x03, 0xc8, 0x0b, 0x51, 0xb9, 0x40,
-	0xec, 0xc8, 0xe8, 0x9f, 0x95, 0x33, 0xdf, 0x3e, 0xf5, 0xcc, 0x17, 0xa3, 0x99, 0x63, 0x70, 0x55,
-	0x7d, 0xde, 0xb1, 0xa9, 0x28, 0x14, 0x6f, 0xca, 0xb6, 0x9c, 0x11, 0xef, 0x8f, 0xcd, 0x98, 0xf9,
-	0x1f, 0x67, 0x1c, 0x87, 0xab, 0x4a, 0x87, 0xc6, 0x66, 0x7c, 0x1e, 0x80, 0x50, 0xdc, 0xed, 0x13,
-	0x83, 0xec, 0x39, 0xb2, 0xa4, 0xca, 0xe8, 0x59, 0xbf, 0xa7, 0xb9, 0xe7, 0xac, 0x2e, 0x07, 0xdb,
-	0xf9, 0xe0, 0xd3, 0x0f, 0xaf, 0x5e, 0x89, 0x4d, 0xb5, 0x1f, 0xbe, 0x1d, 0xfa, 0x11, 0x59, 0xfd,
-	0xa5, 0x06, 0x28, 0x3a, 0x6b, 0x75, 0xc2, 0x06, 0xa2, 0xa0, 0x16, 0x45, 0x56, 0xac, 0x18, 0xd2,
-	0x9e, 0x5c, 0x64, 0x45, 0xfa, 0x63, 0x45, 0x56, 0x2c, 0x87, 0x7c, 0x23, 0x3a, 0xd9, 0x92, 0x2a,
-	0xe0, 0x15, 0x56, 0x17, 0x33, 0x12, 0xab, 0xd6, 0xec, 0x31, 0x88, 0x40, 0x29, 0x4c, 0x4f, 0x89,
-	0xea, 0xa1, 0x06, 0x97, 0xa7, 0x36, 0x61, 0xb8, 0x6c, 0x13, 0x90, 0x17, 0x1b, 0x94, 0x81, 0x3c,
-	0x52, 0xcb, 0x7f, 0xb6, 0x3d, 0xbd, 0xe8, 0x4d, 0x9d, 0x66, 0xff, 0xa7, 0x63, 0x7a, 0x35, 0x25,
-	0xf3, 0xef, 0xef, 0x35, 0xb8, 0x10, 0x5f, 0x51, 0x68, 0x5b, 0x07, 0xe6, 0xe2, 0x6b, 0x51, 0x56,
-	0xbd, 0x74, 0x12, 0xab, 0xe2, 0x06, 0x8d, 0x81, 0x08, 0x5b, 0x82, 0xcd, 0xee, 0xbf, 0x64, 0x5e,
-	0x3f, 0x31, 0x4b, 0xc1, 0xc2, 0x8e, 0xcc, 0x80, 0x29, 0xe9, 0xac, 0x1f, 0x27, 0x21, 0xd5, 0x76,
-	0xdd, 0x3e, 0xfa, 0x91, 0x06, 0x8b, 0xd4, 0xe5, 0x32, 0xa4, 0x89, 0x65, 0xa8, 0xd7, 0x14, 0xff,
-	0x10, 0xd9, 0x3a, 0x1d, 0x7b, 0xff, 0x38, 0x2c, 0x4f, 0x43, 0x8d, 0x53, 0xaa, 0x5e, 0xf3, 0xa8,
-	0xcb, 0xeb, 0x52, 0x68, 0xc3, 0x7f, 0x70, 0x79, 0x17, 0xe6, 0xc7, 0xe7, 0xf7, 0x4f, 0x1e, 0xfd,
-	0xd4, 0xf3, 0xcf, 0x3f, 0x75, 0xee, 0xb9, 0x6e, 0x6c, 0xe2, 0xd5, 0x8c, 0x70, 0xec, 0x3f, 0x85,
-	0x73, 0xdf, 0x81, 0x42, 0x98, 0x95, 0x37, 0xe5, 0xdb, 0xa0, 0xb8, 0xa2, 0xcf, 0xfa, 0xcf, 0x84,
-	0x41, 0x31, 0x55, 0x89, 0xbf, 0x44, 0xe3, 0xae, 0x69, 0xd7, 0x26, 0x74, 0xc6, 0x18, 0x57, 0xba,
-	0x57, 0x7f, 0xa5, 0x01, 0x44, 0x6f, 0x57, 0xe8, 0x35, 0xb8, 0x54, 0xbf, 0xbb, 0xde, 0x30, 0x3a,
-	0x1b, 0x37, 0x37, 0x36, 0x3b, 0xc6, 0xe6, 0x7a, 0xa7, 0xdd, 0x5c, 0x6b, 0xdd, 0x6a, 0x35, 0x1b,
-	0x85, 0x44, 0x29, 0x7f, 0x70, 0xbf, 0x92, 0xdb, 0xa4, 0x6c, 0x40, 0x4c, 0x7b, 0xdb, 0x26, 0x16,
-	0x7a, 0x19, 0x2e, 0x8c, 0x4b, 0x8b, 0x56, 0xb3, 0x51, 0xd0, 0x4a, 0x73, 0x07, 0xf7, 0x2b, 0x19,
-	0xff, 0x56, 0x4e, 0x2c, 0xb4, 0x0c, 0xcf, 0x4d, 0xcb, 0xb5, 0xd6, 0xdf, 0x28, 0x24, 0x4b, 0xf3,
-	0x07, 0xf7, 0x2b, 0xd9, 0xf0, 0xfa, 0x8e, 0xaa, 0x80, 0xe2, 0x92, 0x0a, 0x6f, 0xa6, 0x04, 0x07,
-	0xf7, 0x2b, 0x69, 0xdf, 0x2d, 0xa5, 0xd4, 0xbd, 0x9f, 0x2d, 0x25, 0xae, 0x7e, 0x0f, 0xa0, 0x45,
-	0xb7, 0x3d, 0x6c, 0xca, 0x80, 0x2c, 0xc1, 0xc5, 0xd6, 0xfa, 0x2d, 0xfd, 0xe6, 0xda, 0x46, 0xeb,
-	0xee, 0xfa, 0xf8, 0xb2, 0x27, 0xc6, 0x1a, 0x77, 0x37, 0xeb, 0x77, 0x9a, 0x46, 0xa7, 0xf5, 0xc6,
-	0x7a, 0x41, 0x43, 0x97, 0xe0, 0xfc, 0xd8, 0xd8, 0xdb, 0xeb, 0x1b, 0xad, 0xb7, 0x9a, 0x85, 0x64,
-	0xfd, 0xd6, 0xc7, 0x8f, 0x96, 0xb4, 0x87, 0x8f, 0x96, 0xb4, 0xbf, 0x3d, 0x5a, 0xd2, 0xde, 0x7b,
-	0xbc, 0x94, 0x78, 0xf8, 0x78, 0x29, 0xf1, 0xa7, 0xc7, 0x4b, 0x89, 0xef, 0xbc, 0xf6, 0x44, 0x87,
-	0x47, 0x99, 0x52, 0xba, 0xbe, 0x9b, 0x96, 0x47, 0xf3, 0x57, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0x72, 0x04, 0x4c, 0x94, 0x84, 0x19, 0x00, 0x00,
+	// 2425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xd6, 0x52, 0x32, 0x25, 0x3d, 0x4a, 0x22, 0x35, 0x96, 0x64, 0x9a, 0x8e, 0x25, 0x9a, 0x4e,
+	0x62, 0xc5, 0x88, 0xa9, 0xd8, 0x05, 0x7a, 0x50, 0x83, 0x16, 0xa2, 0x48, 0x5b, 0x74, 0x2d, 0x89,
+	0x58, 0x52, 0x4a, 0xdd, 0xa2, 0x58, 0x0c, 0x77, 0x47, 0xd4, 0x5a, 0xfb, 0x43, 0xec, 0x0e, 0x65,
+	0x11, 0xc8, 0xa1, 0xe8, 0xc9, 0xd0, 0xa1, 0x08, 0xd0, 0x4b, 0x2e, 0x02, 0x0c, 0xb4, 0x87, 0x1e,
+	0x52, 0x20, 0x87, 0xa0, 0x3d, 0x14, 0x45, 0xd1, 0x43, 0x81, 0xb4, 0x97, 0x1a, 0x39, 0x15, 0x45,
+	0xa1, 0x16, 0xf6, 0x21, 0x45, 0x4f, 0x45, 0xef, 0x0d, 0x8a, 0x99, 0x9d, 0xfd, 0x21, 0x29, 0x5a,
+	0x92, 0xcb, 0x16, 0x01, 0x72, 0x91, 0x38, 0x33, 0x6f, 0xbe, 0xf7, 0x3b, 0xef, 0xcd, 0xbc, 0x85,
+	0xd7, 0x55, 0xdb, 0x35, 0x6d, 0x77, 0xc9, 0xa5, 0x78, 0x4f, 0xb7, 0x1a, 0x4b, 0xfb, 0xb7, 0xeb,
+	0x84, 0xe2, 0xdb, 0xfe, 0x38, 0xdf, 0x74, 0x6c, 0x6a, 0xa3, 0x39, 0x8f, 0x2a, 0xef, 0xcf, 0x0a,
+	0xaa, 0xcc, 0x4c, 0xc3, 0x6e, 0xd8, 0x9c, 0x64, 0x89, 0xfd, 0xf2, 0xa8, 0x33, 0x97, 0x1b, 0xb6,
+	0xdd, 0x30, 0xc8, 0x12, 0x1f, 0xd5, 0x5b, 0x3b, 0x4b, 0xd8, 0x6a, 0x8b, 0xa5, 0xf9, 0xee, 0x25,
+	0xad, 0xe5, 0x60, 0xaa, 0xdb, 0x96, 0x58, 0x5f, 0xe8, 0x5e, 0xa7, 0xba, 0x49, 0x5c, 0x8a, 0xcd,
+	0xa6, 0x8f, 0xed, 0x49, 0xa2, 0x78, 0x4c, 0x85, 0x58, 0x02, 0x5b, 0xa8, 0x52, 0xc7, 0x2e, 0x09,
+	0xf4, 0x50, 0x6d, 0xdd, 0xc7, 0x9e, 0xc6, 0xa6, 0x6e, 0xd9, 0x4b, 0xfc, 0xaf, 0x98, 0x7a, 0x8d,
+	0x12, 0x4b, 0x23, 0x8e, 0xa9, 0x5b, 0x74, 0x89, 0xb6, 0x9b, 0xc4, 0xf5, 0xfe, 0x8a, 0xd5, 0x2b,
+	0x91, 0x55, 0x5c, 0x57, 0xf5, 0xe8, 0x62, 0xee, 0xc7, 0x12, 0x4c, 0xad, 0xe9, 0x2e, 0xb5, 0x1d,
+	0x5d, 0xc5, 0x46, 0xd9, 0xda, 0xb1, 0xd1, 0x37, 0x20, 0xbe, 0x4b, 0xb0, 0x46, 0x9c, 0xb4, 0x94,
+	0x95, 0x16, 0x13, 0x77, 0xd2, 0xf9, 0x10, 0x20, 0xef, 0xed, 0x5d, 0xe3, 0xeb, 0x85, 0xf1, 0x4f,
+	0x8f, 0x17, 0x86, 0x7e, 0xf6, 0xf9, 0xc7, 0x37, 0x25, 0x59, 0x6c, 0x41, 0x45, 0x88, 0xef, 0x63,
+	0xc3, 0x25, 0x34, 0x1d, 0xcb, 0x0e, 0x2f, 0x26, 0xee, 0x5c, 0xcb, 0x9f, 0x6c, 0xf3, 0xfc, 0x36,
+	0x36, 0x74, 0x0d, 0x53, 0xbb, 0x13, 0xc5, 0xdb, 0x9b, 0xfb, 0x28, 0x06, 0xc9, 0x55, 0xdb, 0x34,
+	0x75, 0xd7, 0xd5, 0x6d, 0x4b, 0xc6, 0x94, 0xb8, 0xa8, 0x02, 0x23, 0x0e, 0xa6, 0x84, 0x0b, 0x35,
+	0x5e, 0x78, 0x97, 0x6d, 0xfa, 0xf3, 0xf1, 0xc2, 0x9b, 0x0d, 0x9d, 0xee, 0xb6, 0xea, 0x79, 0xd5,
+	0x36, 0x85, 0x19, 0xc5, 0xbf, 0x5b, 0xae, 0xb6, 0x27, 0x34, 0x2d, 0x12, 0xf5, 0xb3, 0x4f, 0x6e,
+	0x81, 0x10, 0xa4, 0x48, 0x54, 0x99, 0x23, 0xa1, 0xf7, 0x60, 0xcc, 0xc4, 0x07, 0x0a, 0x47, 0x8d,
+	0x0d, 0x00, 0x75, 0xd4, 0xc4, 0x07, 0x4c, 0x56, 0xa4, 0x41, 0x92, 0x01, 0xab, 0xbb, 0xd8, 0x6a,
+	0x10, 0x0f, 0x7f, 0x78, 0x00, 0xf8, 0x93, 0x26, 0x3e, 0x58, 0xe5, 0x98, 0x8c, 0xcb, 0xf2, 0xd8,
+	0x87, 0x4f, 0x17, 0x86, 0xfe, 0xfe, 0x74, 0x41, 0xca, 0xfd, 0x4e, 0x02, 0x08, 0xcd, 0x85, 0x30,
+	0xa4, 0xd4, 0x60, 0xc4, 0xd9, 0xbb, 0xc2, 0x95, 0x37, 0xfa, 0x79, 0xa3, 0xcb, 0xd8, 0x85, 0x49,
+	0x26, 0xe8, 0xb3, 0xe3, 0x05, 0xc9, 0xf3, 0x4b, 0x52, 0xed, 0x72, 0xc6, 0x7d, 0x48, 0xb4, 0x9a,
+	0x1a, 0xa6, 0x44, 0x61, 0x91, 0xcd, 0xad, 0x97, 0xb8, 0x93, 0xc9, 0x7b, 0x61, 0x9f, 0xf7, 0xc3,
+	0x3e, 0x5f, 0xf3, 0xc3, 0xde, 0x03, 0xfc, 0xe0, 0xaf, 0x3e, 0x20, 0x78, 0xbb, 0xd9, 0x7a, 0x44,
+	0x8f, 0x5f, 0x4b, 0x70, 0x29, 0x94, 0xa4, 0xaa, 0xee, 0x12, 0xad, 0x65, 0x90, 0x92, 0x45, 0x9d,
+	0x36, 0xaa, 0xc0, 0x14, 0xd9, 0xd9, 0x21, 0x2a, 0xd5, 0xf7, 0x05, 0x53, 0xe9, 0xbc, 0x4c, 0x27,
+	0x03, 0x00, 0x46, 0x12, 0x04, 0x54, 0x6c, 0x50, 0x01, 0x95, 0x7b, 0x04, 0xa8, 0x57, 0x7c, 0x54,
+	0x83, 0x51, 0x62, 0x51, 0x47, 0xe7, 0x5e, 0x60, 0x67, 0x62, 0xe9, 0x74, 0x2f, 0x74, 0xe8, 0x1e,
+	0x3d, 0x21, 0x3e, 0x54, 0xee, 0x23, 0x09, 0x12, 0x45, 0xe2, 0xaa, 0x8e, 0xde, 0x64, 0x89, 0x07,
+	0xa5, 0x61, 0xd4, 0xb4, 0x2d, 0x7d, 0x4f, 0x1c, 0xdb, 0x71, 0xd9, 0x1f, 0xa2, 0x0c, 0x8c, 0xe9,
+	0x1a, 0xb1, 0xa8, 0x4e, 0xdb, 0x9e, 0xae, 0x72, 0x30, 0x66, 0xbb, 0x1e, 0x93, 0xba, 0xab, 0xfb,
+	0x11, 0x2a, 0xfb, 0x43, 0xf4, 0x16, 0xa4, 0x5c, 0xa2, 0xb6, 0x1c, 0x9d, 0xb6, 0x15, 0xd5, 0xb6,
+	0x28, 0x56, 0x69, 0x7a, 0x84, 0x93, 0x24, 0xfd, 0xf9, 0x55, 0x6f, 0x9a, 0x81, 0x68, 0x84, 0x62,
+	0xdd, 0x70, 0xd3, 0x17, 0x3c, 0x10, 0x31, 0x8c, 0xb8, 0xf6, 0x57, 0xa3, 0x30, 0x1e, 0x1c, 0x79,
+	0xb4, 0x0a, 0x29, 0xbb, 0x49, 0x1c, 0xf6, 0x5b, 0xc1, 0x9a, 0xe6, 0x10, 0xd7, 0x15, 0xe7, 0x3a,
+	0xfd, 0xd9, 0x27, 0xb7, 0x66, 0x84, 0x79, 0x56, 0xbc, 0x95, 0x2a, 0x75, 0x74, 0xab, 0x21, 0x27,
+	0xfd, 0x1d, 0x62, 0x1a, 0x3d, 0x64, 0x61, 0x6e, 0xb9, 0xc4, 0x72, 0x5b, 0xae, 0xd2, 0x6c, 0xd5,
+	0xf7, 0x48, 0x5b, 0x04, 0xe2, 0x4c, 0x4f, 0x4c, 0xac, 0x58, 0xed, 0x42, 0xfa, 0x0f, 0x21, 0xb4,
+	0xea, 0xb4, 0x9b, 0xd4, 0xce, 0x57, 0x5a, 0xf5, 0x6f, 0x93, 0xb6, 0x9c, 0x0c, 0x70, 0x2a, 0x1c,
+	0x06, 0xcd, 0x41, 0xfc, 0x11, 0xd6, 0x0d, 0xa2, 0x71, 0xab, 0x8c, 0xc9, 0x62, 0x84, 0x96, 0x21,
+	0xee, 0x52, 0x4c, 0x5b, 0x2e, 0x37, 0xc5, 0xd4, 0x9d, 0x5c, 0x3f, 0x4f, 0x16, 0x6c, 0x4b, 0xab,
+	0x72, 0x4a, 0x59, 0xec, 0x40, 0x35, 0x88, 0x53, 0x7b, 0x8f, 0x58, 0xc2, 0x48, 0xe7, 0x0a, 0xb8,
+	0xb2, 0x45, 0x23, 0x01, 0x57, 0xb6, 0xa8, 0x2c, 0xb0, 0x50, 0x03, 0x52, 0x1a, 0x31, 0x48, 0x83,
+	0x9b, 0xd2, 0xdd, 0xc5, 0x0e, 0x71, 0xd3, 0xf1, 0x01, 0x04, 0x74, 0x32, 0x40, 0xad, 0x72, 0x50,
+	0x54, 0x81, 0x84, 0x16, 0x86, 0x5b, 0x7a, 0x94, 0x1b, 0xfa, 0x7a, 0x3f, 0xfd, 0x23, 0x91, 0x19,
+	0x8d, 0xde, 0x28, 0x04, 0x8b, 0xb0, 0x96, 0x55, 0xb7, 0x2d, 0x4d, 0xb7, 0x1a, 0xca, 0x2e, 0xd1,
+	0x1b, 0xbb, 0x34, 0x3d, 0x96, 0x95, 0x16, 0x87, 0xe5, 0x64, 0x30, 0xbf, 0xc6, 0xa7, 0xd9, 0xe1,
+	0x0f, 0x49, 0xf9, 0xe1, 0x1f, 0x3f, 0xf7, 0xe1, 0x0f, 0x00, 0xf8, 0xe1, 0x5f, 0x07, 0x08, 0x73,
+	0x5a, 0x1a, 0x38, 0x5a, 0xee, 0xf4, 0x73, 0x19, 0x55, 0x26, 0x02, 0x80, 0x0c, 0xb8, 0x68, 0xea,
+	0x96, 0xe2, 0x12, 0x63, 0x47, 0x11, 0x96, 0x63, 0xb8, 0x89, 0x01, 0x78, 0x7a, 0xda, 0xd4, 0xad,
+	0x2a, 0x31, 0x76, 0x8a, 0x01, 0x2c, 0x7a, 0x17, 0xae, 0x84, 0xe6, 0xb0, 0x2d, 0x65, 0xd7, 0x36,
+	0x34, 0xc5, 0x21, 0x3b, 0x8a, 0x6a, 0xb7, 0x2c, 0x9a, 0x9e, 0xe0, 0x46, 0xbc, 0x14, 0x90, 0x6c,
+	0x5a, 0x6b, 0xb6, 0xa1, 0xc9, 0x64, 0x67, 0x95, 0x2d, 0xa3, 0xeb, 0x10, 0xda, 0x42, 0xd1, 0x35,
+	0x37, 0x3d, 0x99, 0x1d, 0x5e, 0x1c, 0x91, 0x27, 0x82, 0xc9, 0xb2, 0xe6, 0x2e, 0x4f, 0x3c, 0x79,
+	0xba, 0x30, 0x24, 0x4e, 0xef, 0x50, 0xae, 0x02, 0x13, 0xdb, 0xd8, 0x10, 0x07, 0x8f, 0xb8, 0xe8,
+	0xeb, 0x30, 0x8e, 0xfd, 0x01, 0x4f, 0x6a, 0x2f, 0x3b, 0xb8, 0x21, 0xa9, 0x97, 0x0f, 0x7e, 0xf0,
+	0x97, 0xac, 0x94, 0x5b, 0x81, 0xe9, 0x8a, 0xfd, 0x98, 0x38, 0xde, 0xdd, 0xa3, 0xed, 0xe5, 0xf8,
+	0x39, 0x76, 0xf3, 0xe0, 0x71, 0x20, 0x71, 0x15, 0xc4, 0x08, 0xcd, 0xc0, 0x85, 0x26, 0x23, 0xe6,
+	0xc7, 0x7b, 0x58, 0xf6, 0x06, 0xb9, 0x06, 0xcc, 0x06, 0x19, 0x25, 0x8a, 0x85, 0x36, 0xba, 0x13,
+	0xee, 0x5b, 0xfd, 0x1c, 0xdb, 0x23, 0xc2, 0x89, 0xa9, 0xb6, 0x00, 0xa9, 0xfb, 0x58, 0x37, 0xce,
+	0x24, 0xea, 0x1c, 0xc4, 0x1d, 0x82, 0x5d, 0xdb, 0x12, 0xa9, 0x56, 0x8c, 0x72, 0x04, 0x66, 0x02,
+	0x61, 0x23, 0x60, 0x68, 0xbd, 0x5b, 0xd6, 0xc5, 0x7e, 0xb2, 0x76, 0x8b, 0x70, 0xa2, 0xa8, 0x3f,
+	0x95, 0x20, 0x5e, 0xdc, 0xae, 0x60, 0xdd, 0x41, 0x25, 0x98, 0x0e, 0x33, 0xc3, 0x59, 0x93, 0x6c,
+	0x98, 0x4c, 0xc4, 0x3c, 0x83, 0xd9, 0xf7, 0x05, 0x0f, 0x60, 0x62, 0xa7, 0xc1, 0x04, 0x5b, 0xc4,
+	0x7c, 0x57, 0x3c, 0xdd, 0x87, 0x51, 0x4f, 0x4a, 0x17, 0x7d, 0x0b, 0x2e, 0x34, 0xd9, 0x0f, 0xa1,
+	0xfe, 0x7c, 0xdf, 0x8c, 0xc2, 0xe9, 0xa3, 0x4a, 0x7b, 0xfb, 0x72, 0xff, 0x96, 0x00, 0x8a, 0xdb,
+	0xdb, 0x35, 0x47, 0x6f, 0x1a, 0x84, 0x0e, 0x4a, 0xed, 0x07, 0x30, 0x1b, 0xaa, 0xed, 0x3a, 0xea,
+	0x99, 0x55, 0xbf, 0x18, 0x6c, 0xab, 0x3a, 0xea, 0x89, 0x68, 0x9a, 0x4b, 0x03, 0xb4, 0xe1, 0x33,
+	0xa3, 0x15, 0x5d, 0x7a, 0xb2, 0x2d, 0xbf, 0x03, 0x89, 0x50, 0x7d, 0x17, 0x95, 0x61, 0x8c, 0x8a,
+	0xdf, 0xc2, 0xa4, 0xb9, 0xfe, 0x26, 0xf5, 0xb7, 0x45, 0xcd, 0x1a, 0x6c, 0xcf, 0x7d, 0xc1, 0x2c,
+	0x1b, 0x66, 0x9d, 0x2f, 0x55, 0x40, 0xb1, 0x72, 0x2a, 0xca, 0xdd, 0x20, 0xae, 0xd6, 0x02, 0xab,
+	0xcb, 0xb4, 0x4f, 0x62, 0x70, 0x71, 0xcb, 0xcf, 0x8a, 0x5f, 0x5a, 0x4b, 0x6c, 0x85, 0x29, 0x64,
+	0x98, 0x3b, 0xfc, 0x9d, 0x7e, 0x0e, 0x3f, 0x41, 0x97, 0xbe, 0xa9, 0xa4, 0xcb, 0x14, 0xbf, 0x1d,
+	0x86, 0x74, 0xbf, 0xed, 0xe8, 0x06, 0x24, 0x55, 0x87, 0xf0, 0x09, 0xa5, 0x23, 0x2b, 0x4e, 0xf9,
+	0xd3, 0xa2, 0x8e, 0xcb, 0xc0, 0x5e, 0x12, 0x2c, 0xba, 0x18, 0xe9, 0xab, 0x3d, 0x1d, 0xa6, 0x42,
+	0x04, 0x5e, 0xc9, 0x09, 0x24, 0x75, 0x4b, 0xa7, 0x3a, 0x36, 0x94, 0x3a, 0x36, 0xb0, 0xa5, 0xbe,
+	0xca, 0x63, 0xab, 0xb7, 0xec, 0x4e, 0x09, 0xd0, 0x82, 0x87, 0x89, 0xb6, 0x61, 0xd4, 0x87, 0x1f,
+	0x19, 0x00, 0xbc, 0x0f, 0x86, 0xae, 0xc1, 0x44, 0xb4, 0x1a, 0xf3, 0xcb, 0xe1, 0x88, 0x9c, 0x88,
+	0x14, 0xe3, 0xd3, 0xca, 0x7d, 0xfc, 0xa5, 0xe5, 0x3e, 0x72, 0x07, 0xff, 0xcd, 0x30, 0x4c, 0xcb,
+	0x44, 0xfb, 0x0a, 0x3a, 0xef, 0x7b, 0x00, 0xde, 0x01, 0x67, 0xc9, 0x37, 0x3d, 0x32, 0x80, 0x84,
+	0x31, 0xee, 0xe1, 0x15, 0x5d, 0xfa, 0xff, 0xf4, 0xe0, 0x1f, 0x63, 0x30, 0x11, 0xf5, 0xe0, 0x57,
+	0xa0, 0xda, 0x45, 0x6f, 0x73, 0x23, 0x2f, 0xbf, 0xcd, 0xf5, 0xc4, 0xf6, 0x19, 0xf2, 0xda, 0x2f,
+	0x13, 0x10, 0xaf, 0x60, 0x07, 0x9b, 0x2e, 0xda, 0xec, 0x79, 0x64, 0x78, 0x1d, 0x86, 0xcb, 0x3d,
+	0xe1, 0x5d, 0x14, 0xdd, 0x3e, 0x2f, 0xba, 0x3f, 0xec, 0xf7, 0xc6, 0x78, 0x03, 0xa6, 0x58, 0x1b,
+	0x28, 0x50, 0xca, 0x33, 0xe7, 0x24, 0xef, 0xe3, 0x04, 0x97, 0x41, 0x17, 0x2d, 0x40, 0x82, 0x91,
+	0x85, 0x39, 0x9c, 0xd1, 0x80, 0x89, 0x0f, 0x4a, 0xde, 0x0c, 0xba, 0x05, 0x68, 0x37, 0x68, 0xd1,
+	0x29, 0xa1, 0x31, 0x18, 0xdd, 0x74, 0xb8, 0xe2, 0x93, 0x5f, 0x05, 0x60, 0x52, 0x28, 0x1a, 0xb1,
+	0x6c, 0x53, 0xbc, 0xc8, 0xc7, 0xd9, 0x4c, 0x91, 0x4d, 0xa0, 0xf7, 0xbd, 0xa7, 0x4a, 0x57, 0x87,
+	0x48, 0x3c, 0x1a, 0x1f, 0x9c, 0xef, 0x50, 0xfc, 0xeb, 0x78, 0x21, 0xd3, 0xc6, 0xa6, 0xb1, 0x9c,
+	0x3b, 0x01, 0x32, 0xc7, 0x9f, 0x2e, 0x9d, 0x9d, 0x25, 0xd4, 0x84, 0x24, 0x23, 0xe5, 0x02, 0x62,
+	0x93, 0x47, 0xff, 0x28, 0xe7, 0xbc, 0x76, 0x6e, 0xce, 0x73, 0x21, 0xe7, 0x08, 0x5c, 0x4e, 0x9e,
+	0x34, 0x75, 0x8b, 0xbd, 0xbf, 0x57, 0xf8, 0x98, 0x73, 0xc4, 0x07, 0x1d, 0x1c, 0xc7, 0xfe, 0x4b,
+	0x8e, 0xf8, 0xa0, 0x9b, 0x23, 0x3e, 0x88, 0x70, 0xbc, 0x0a, 0x40, 0x2c, 0x5c, 0x37, 0x88, 0x42,
+	0xf6, 0x4d, 0xfe, 0x52, 0x1d, 0x93, 0xc7, 0xbd, 0x99, 0xd2, 0xbe, 0x89, 0x76, 0x20, 0x13, 0xb1,
+	0x94, 0x68, 0x12, 0xea, 0x16, 0x25, 0xce, 0x3e, 0x36, 0xd2, 0x70, 0xce, 0x98, 0x4b, 0x87, 0x58,
+	0x5e, 0x6f, 0xb0, 0x2c, 0x90, 0xd0, 0x1d, 0x98, 0xe5, 0x0f, 0x25, 0xc5, 0x0b, 0x91, 0x76, 0x10,
+	0x39, 0x09, 0x1e, 0x39, 0x17, 0x9b, 0x5d, 0x8f, 0x1f, 0x16, 0x3b, 0xab, 0x30, 0xdf, 0x19, 0xb2,
+	0x0a, 0x75, 0xb0, 0xe5, 0xea, 0x3c, 0xe5, 0xbb, 0x94, 0x34, 0xf9, 0xe3, 0x72, 0x52, 0xbe, 0xd2,
+	0x11, 0xc2, 0xb5, 0x80, 0xa6, 0x4a, 0x49, 0x13, 0x11, 0x08, 0x53, 0x99, 0x62, 0x62, 0xea, 0x35,
+	0x91, 0x4c, 0x5b, 0x23, 0xe9, 0x49, 0xde, 0x36, 0xb9, 0x75, 0xea, 0x05, 0x65, 0x5d, 0xec, 0x5a,
+	0xb7, 0x35, 0x22, 0xcf, 0xb6, 0x4e, 0x9a, 0x46, 0xef, 0xc3, 0x6b, 0x4c, 0x56, 0xde, 0x08, 0x51,
+	0x4c, 0x7b, 0x9f, 0x98, 0xc4, 0xa2, 0x4a, 0x93, 0x38, 0x4a, 0xdd, 0xb0, 0xd5, 0xbd, 0xf4, 0xd4,
+	0x00, 0x0a, 0x49, 0xda, 0xc4, 0x07, 0x35, 0xc6, 0x60, 0x5d, 0xe0, 0x57, 0x88, 0x53, 0x60, 0xe8,
+	0x48, 0x85, 0x29, 0x16, 0x79, 0x91, 0xc7, 0x7e, 0x72, 0x00, 0xfc, 0x58, 0xec, 0x46, 0x2e, 0x9a,
+	0x15, 0x78, 0xa3, 0x93, 0x89, 0x42, 0x0e, 0x88, 0xd9, 0xa4, 0x3d, 0x8d, 0x86, 0x14, 0x0f, 0xb2,
+	0x6b, 0x1d, 0xbb, 0x4b, 0x9c, 0xb4, 0xab, 0x75, 0xc0, 0x1a, 0x15, 0xac, 0x35, 0xdd, 0x75, 0xfa,
+	0xa7, 0x03, 0xd9, 0xa5, 0x57, 0x2e, 0x89, 0xd3, 0xac, 0x3d, 0xdd, 0x79, 0xda, 0xdf, 0x81, 0x19,
+	0xd6, 0x39, 0xeb, 0x89, 0x40, 0xc4, 0x83, 0x08, 0x3d, 0xea, 0x7c, 0xd2, 0xb2, 0xec, 0xbc, 0xe8,
+	0xd7, 0xba, 0xc3, 0xcf, 0x3f, 0xbe, 0x79, 0x25, 0xc2, 0xf5, 0x20, 0xf8, 0xb6, 0xe3, 0xa5, 0xeb,
+	0xdc, 0xcf, 0x25, 0x40, 0xa1, 0x62, 0x32, 0x71, 0x9b, 0xb6, 0xe5, 0xf2, 0xc6, 0x4e, 0xc4, 0x2e,
+	0xd2, 0xcb, 0x1b, 0x3b, 0xe1, 0xfe, 0x8e, 0xc6, 0x4e, 0xa4, 0xc0, 0x7e, 0x33, 0xbc, 0xf6, 0xc5,
+	0xc4, 0xc9, 0x14, 0x58, 0xec, 0xfb, 0x4c, 0xa4, 0x43, 0xa4, 0x77, 0x40, 0xf8, 0x9b, 0x82, 0xda,
+	0x3d, 0x94, 0x3b, 0x96, 0xe0, 0x72, 0x4f, 0x85, 0x0a, 0xc4, 0x56, 0x01, 0x39, 0x24, 0xea, 0x67,
+	0xb6, 0x2a, 0xc4, 0x7f, 0xb5, 0x82, 0x37, 0xed, 0x74, 0xaf, 0xfe, 0xaf, 0xee, 0xb0, 0xcb, 0x23,
+	0xfc, 0x72, 0xf2, 0x7b, 0x09, 0x66, 0xa2, 0x12, 0x05, 0xba, 0x55, 0x61, 0x22, 0x2a, 0x8b, 0xd0,
+	0xea, 0xf5, 0xb3, 0x68, 0x15, 0x55, 0xa8, 0x03, 0x84, 0xe9, 0xe2, 0x47, 0x93, 0xf7, 0xa5, 0xe9,
+	0xf6, 0x99, 0xad, 0xe4, 0x0b, 0x76, 0xe2, 0xf5, 0x60, 0x84, 0x3b, 0xeb, 0x47, 0x31, 0x18, 0xa9,
+	0xd8, 0xb6, 0x81, 0x7e, 0x28, 0xc1, 0xb4, 0x65, 0x53, 0x9e, 0xef, 0x89, 0xa6, 0x88, 0x0e, 0xae,
+	0x77, 0xc3, 0xda, 0x3e, 0x9f, 0xf5, 0xfe, 0x71, 0xbc, 0xd0, 0x0b, 0xd5, 0x69, 0x52, 0xf1, 0xb5,
+	0xc5, 0xb2, 0x69, 0x81, 0x13, 0xf1, 0xd4, 0xe3, 0xa2, 0xc7, 0x30, 0xd9, 0xc9, 0xdf, 0xbb, 0x96,
+	0xc9, 0xe7, 0xe6, 0x3f, 0x79, 0x2a, 0xef, 0x89, 0x7a, 0x84, 0xf1, 0xf2, 0x18, 0x73, 0xec, 0x3f,
+	0x99, 0x73, 0x1f, 0x42, 0x2a, 0xc8, 0xf7, 0x5b, 0xfc, 0xdb, 0x0d, 0x7b, 0xbf, 0x8e, 0x7a, 0x9f,
+	0x71, 0xfc, 0x4e, 0x43, 0x36, 0xfa, 0xa5, 0x90, 0x7d, 0x6a, 0xcc, 0x77, 0xed, 0xe9, 0xb0, 0xb8,
+	0xd8, 0x7b, 0xf3, 0x17, 0x12, 0x40, 0xd8, 0x2f, 0x47, 0x6f, 0xc3, 0xa5, 0xc2, 0xe6, 0x46, 0x51,
+	0xa9, 0xd6, 0x56, 0x6a, 0x5b, 0x55, 0x65, 0x6b, 0xa3, 0x5a, 0x29, 0xad, 0x96, 0xef, 0x96, 0x4b,
+	0xc5, 0xd4, 0x50, 0x26, 0x79, 0x78, 0x94, 0x4d, 0x6c, 0x59, 0x6e, 0x93, 0xa8, 0xfa, 0x8e, 0x4e,
+	0x34, 0xf4, 0x26, 0xcc, 0x74, 0x52, 0xb3, 0x51, 0xa9, 0x98, 0x92, 0x32, 0x13, 0x87, 0x47, 0xd9,
+	0x31, 0xaf, 0xa0, 0x10, 0x0d, 0x2d, 0xc2, 0x6c, 0x2f, 0x5d, 0x79, 0xe3, 0x5e, 0x2a, 0x96, 0x99,
+	0x3c, 0x3c, 0xca, 0x8e, 0x07, 0x95, 0x07, 0xe5, 0x00, 0x45, 0x29, 0x05, 0xde, 0x70, 0x06, 0x0e,
+	0x8f, 0xb2, 0x71, 0xcf, 0x2d, 0x99, 0x91, 0x27, 0x3f, 0x99, 0x1f, 0xba, 0xf9, 0x85, 0x04, 0xb3,
+	0x27, 0x56, 0x2c, 0xb4, 0x09, 0x37, 0x02, 0x0e, 0xca, 0xfa, 0x4a, 0x6d, 0x4b, 0x2e, 0xd7, 0x1e,
+	0x2a, 0xeb, 0x9b, 0xc5, 0x92, 0xb2, 0x56, 0x2a, 0xdf, 0x5b, 0xab, 0x29, 0x2b, 0x1b, 0x45, 0xa5,
+	0x56, 0x5e, 0x2f, 0xa5, 0x86, 0x32, 0xb9, 0xc3, 0xa3, 0xec, 0x7c, 0x0f, 0x8e, 0xf7, 0xe8, 0x5a,
+	0xb1, 0x34, 0x7e, 0x95, 0x2c, 0xc2, 0xb5, 0x7e, 0x80, 0x0c, 0x45, 0xd9, 0xdc, 0x78, 0xf0, 0x30,
+	0x25, 0x65, 0xae, 0x1e, 0x1e, 0x65, 0x2f, 0xf7, 0x40, 0x31, 0x84, 0x4d, 0xcb, 0x68, 0xa3, 0x35,
+	0xb8, 0x7e, 0x8a, 0x58, 0x1c, 0x27, 0x96, 0x59, 0x38, 0x3c, 0xca, 0x5e, 0xe9, 0x23, 0x12, 0x43,
+	0x12, 0x06, 0xf8, 0x3e, 0x40, 0xd9, 0xda, 0x71, 0xb0, 0xca, 0x4f, 0x64, 0x06, 0xe6, 0xca, 0x1b,
+	0x77, 0xe5, 0x95, 0xd5, 0x5a, 0x79, 0x73, 0xa3, 0xd3, 0x6f, 0x5d, 0x6b, 0xc5, 0xcd, 0xad, 0xc2,
+	0x83, 0x92, 0x52, 0x2d, 0xdf, 0xdb, 0x48, 0x49, 0xe8, 0x12, 0x5c, 0xec, 0x58, 0x7b, 0x6f, 0x83,
+	0x1b, 0x26, 0x56, 0xb8, 0xfb, 0xe9, 0xf3, 0x79, 0xe9, 0xd9, 0xf3, 0x79, 0xe9, 0x6f, 0xcf, 0xe7,
+	0xa5, 0x0f, 0x5e, 0xcc, 0x0f, 0x3d, 0x7b, 0x31, 0x3f, 0xf4, 0xa7, 0x17, 0xf3, 0x43, 0xdf, 0x7d,
+	0xfb, 0xa5, 0x11, 0x1f, 0x96, 0x0a, 0x1e, 0xfb, 0xf5, 0x38, 0xbf, 0x44, 0x7d, 0xed, 0x3f, 0x03,
+	0x00, 0x1a, 0x68, 0x0d, 0x55, 0x25, 0x20, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1409,694 +1847,827 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 10983 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0xbd, 0x7b, 0x90, 0x64, 0xd7,
-		0x59, 0x18, 0x3e, 0xfd, 0xee, 0xfe, 0xfa, 0x75, 0xe7, 0xcc, 0xec, 0xee, 0x6c, 0xaf, 0x34, 0x33,
-		0xba, 0xb2, 0xb4, 0x0f, 0x49, 0xb3, 0xd2, 0x4a, 0xbb, 0x92, 0x66, 0x2d, 0x8b, 0xee, 0x9e, 0xde,
-		0xd9, 0xde, 0x9d, 0x97, 0x6f, 0xf7, 0xac, 0x1e, 0xfc, 0xf0, 0xe5, 0xce, 0xed, 0x33, 0x33, 0x57,
-		0xdb, 0x7d, 0x6f, 0xbb, 0xef, 0xed, 0xd9, 0x19, 0x15, 0xf5, 0x2b, 0x81, 0x81, 0x98, 0x0d, 0x21,
-		0x26, 0xa4, 0x40, 0x18, 0xd6, 0xb1, 0x31, 0xd8, 0x86, 0x98, 0xc4, 0x60, 0xc7, 0x98, 0xb8, 0x42,
-		0x20, 0x15, 0x12, 0x43, 0xa5, 0x12, 0x43, 0xa5, 0x12, 0x8a, 0x22, 0x0a, 0xc8, 0x54, 0xec, 0xd8,
-		0x26, 0x80, 0x23, 0x2a, 0x49, 0xb9, 0x20, 0xa9, 0xf3, 0xba, 0x8f, 0x7e, 0x4c, 0xf7, 0xac, 0x25,
-		0xc5, 0x29, 0xff, 0xb3, 0xdb, 0xe7, 0x9c, 0xef, 0xfb, 0xce, 0x39, 0xdf, 0xf9, 0xce, 0xf7, 0x3a,
-		0xe7, 0x9e, 0x81, 0x3f, 0x29, 0xc1, 0xfc, 0x8e, 0x65, 0xed, 0x34, 0xf1, 0xf9, 0x76, 0xc7, 0x72,
-		0xac, 0xad, 0xee, 0xf6, 0xf9, 0x06, 0xb6, 0xf5, 0x8e, 0xd1, 0x76, 0xac, 0xce, 0x02, 0xad, 0x43,
-		0x79, 0x06, 0xb1, 0x20, 0x20, 0xe4, 0x55, 0x98, 0xbc, 0x62, 0x34, 0xf1, 0x92, 0x0b, 0x58, 0xc3,
-		0x0e, 0x7a, 0x0a, 0xa2, 0xdb, 0x46, 0x13, 0xcf, 0x84, 0xe6, 0x23, 0x67, 0xd2, 0x17, 0xde, 0xb1,
-		0xd0, 0x83, 0xb4, 0x10, 0xc4, 0xd8, 0x20, 0xd5, 0x0a, 0xc5, 0x90, 0xff, 0x26, 0x0a, 0x53, 0x03,
-		0x5a, 0x11, 0x82, 0xa8, 0xa9, 0xb5, 0x08, 0xc5, 0xd0, 0x99, 0x94, 0x42, 0x7f, 0xa3, 0x19, 0x48,
-		0xb4, 0x35, 0xfd, 0xa6, 0xb6, 0x83, 0x67, 0xc2, 0xb4, 0x5a, 0x14, 0xd1, 0x2c, 0x40, 0x03, 0xb7,
-		0xb1, 0xd9, 0xc0, 0xa6, 0x7e, 0x30, 0x13, 0x99, 0x8f, 0x9c, 0x49, 0x29, 0xbe, 0x1a, 0xf4, 0x10,
-		0x4c, 0xb6, 0xbb, 0x5b, 0x4d, 0x43, 0x57, 0x7d, 0x60, 0x30, 0x1f, 0x39, 0x13, 0x53, 0x24, 0xd6,
-		0xb0, 0xe4, 0x01, 0x9f, 0x86, 0xfc, 0x2d, 0xac, 0xdd, 0xf4, 0x83, 0xa6, 0x29, 0x68, 0x8e, 0x54,
-		0xfb, 0x00, 0xcb, 0x90, 0x69, 0x61, 0xdb, 0xd6, 0x76, 0xb0, 0xea, 0x1c, 0xb4, 0xf1, 0x4c, 0x94,
-		0xce, 0x7e, 0xbe, 0x6f, 0xf6, 0xbd, 0x33, 0x4f, 0x73, 0xac, 0xfa, 0x41, 0x1b, 0xa3, 0x22, 0xa4,
-		0xb0, 0xd9, 0x6d, 0x31, 0x0a, 0xb1, 0x21, 0xfc, 0xab, 0x98, 0xdd, 0x56, 0x2f, 0x95, 0x24, 0x41,
-		0xe3, 0x24, 0x12, 0x36, 0xee, 0xec, 0x19, 0x3a, 0x9e, 0x89, 0x53, 0x02, 0xa7, 0xfb, 0x08, 0xd4,
-		0x58, 0x7b, 0x2f, 0x0d, 0x81, 0x87, 0xca, 0x90, 0xc2, 0xfb, 0x0e, 0x36, 0x6d, 0xc3, 0x32, 0x67,
-		0x12, 0x94, 0xc8, 0x03, 0x03, 0x56, 0x11, 0x37, 0x1b, 0xbd, 0x24, 0x3c, 0x3c, 0x74, 0x09, 0x12,
-		0x56, 0xdb, 0x31, 0x2c, 0xd3, 0x9e, 0x49, 0xce, 0x87, 0xce, 0xa4, 0x2f, 0xdc, 0x33, 0x50, 0x10,
-		0xd6, 0x19, 0x8c, 0x22, 0x80, 0x51, 0x15, 0x24, 0xdb, 0xea, 0x76, 0x74, 0xac, 0xea, 0x56, 0x03,
-		0xab, 0x86, 0xb9, 0x6d, 0xcd, 0xa4, 0x28, 0x81, 0xb9, 0xfe, 0x89, 0x50, 0xc0, 0xb2, 0xd5, 0xc0,
-		0x55, 0x73, 0xdb, 0x52, 0x72, 0x76, 0xa0, 0x8c, 0x8e, 0x43, 0xdc, 0x3e, 0x30, 0x1d, 0x6d, 0x7f,
-		0x26, 0x43, 0x25, 0x84, 0x97, 0x88, 0xe8, 0xe0, 0x86, 0x41, 0xba, 0x9b, 0xc9, 0x32, 0xd1, 0xe1,
-		0x45, 0xf9, 0xd7, 0xe3, 0x90, 0x1f, 0x47, 0xf8, 0x2e, 0x43, 0x6c, 0x9b, 0xcc, 0x7f, 0x26, 0x7c,
-		0x14, 0xee, 0x30, 0x9c, 0x20, 0x7b, 0xe3, 0x77, 0xc9, 0xde, 0x22, 0xa4, 0x4d, 0x6c, 0x3b, 0xb8,
-		0xc1, 0x64, 0x25, 0x32, 0xa6, 0xb4, 0x01, 0x43, 0xea, 0x17, 0xb6, 0xe8, 0x5d, 0x09, 0xdb, 0xf3,
-		0x90, 0x77, 0x87, 0xa4, 0x76, 0x34, 0x73, 0x47, 0x48, 0xed, 0xf9, 0x51, 0x23, 0x59, 0xa8, 0x08,
-		0x3c, 0x85, 0xa0, 0x29, 0x39, 0x1c, 0x28, 0xa3, 0x25, 0x00, 0xcb, 0xc4, 0xd6, 0xb6, 0xda, 0xc0,
-		0x7a, 0x73, 0x26, 0x39, 0x84, 0x4b, 0xeb, 0x04, 0xa4, 0x8f, 0x4b, 0x16, 0xab, 0xd5, 0x9b, 0xe8,
-		0x69, 0x4f, 0x08, 0x13, 0x43, 0x64, 0x68, 0x95, 0x6d, 0xbf, 0x3e, 0x39, 0xdc, 0x84, 0x5c, 0x07,
-		0x93, 0x1d, 0x81, 0x1b, 0x7c, 0x66, 0x29, 0x3a, 0x88, 0x85, 0x91, 0x33, 0x53, 0x38, 0x1a, 0x9b,
-		0x58, 0xb6, 0xe3, 0x2f, 0xa2, 0xfb, 0xc1, 0xad, 0x50, 0xa9, 0x58, 0x01, 0xd5, 0x4f, 0x19, 0x51,
-		0xb9, 0xa6, 0xb5, 0x70, 0xe1, 0x65, 0xc8, 0x05, 0xd9, 0x83, 0xa6, 0x21, 0x66, 0x3b, 0x5a, 0xc7,
-		0xa1, 0x52, 0x18, 0x53, 0x58, 0x01, 0x49, 0x10, 0xc1, 0x66, 0x83, 0xea, 0xbf, 0x98, 0x42, 0x7e,
-		0xa2, 0xef, 0xf2, 0x26, 0x1c, 0xa1, 0x13, 0x7e, 0xb0, 0x7f, 0x45, 0x03, 0x94, 0x7b, 0xe7, 0x5d,
-		0x78, 0x12, 0xb2, 0x81, 0x09, 0x8c, 0xdb, 0xb5, 0xfc, 0x7d, 0x70, 0x6c, 0x20, 0x69, 0xf4, 0x3c,
-		0x4c, 0x77, 0x4d, 0xc3, 0x74, 0x70, 0xa7, 0xdd, 0xc1, 0x44, 0x62, 0x59, 0x57, 0x33, 0x5f, 0x4e,
-		0x0c, 0x91, 0xb9, 0x4d, 0x3f, 0x34, 0xa3, 0xa2, 0x4c, 0x75, 0xfb, 0x2b, 0xcf, 0xa5, 0x92, 0x5f,
-		0x49, 0x48, 0xaf, 0xbc, 0xf2, 0xca, 0x2b, 0x61, 0xf9, 0xb7, 0xe2, 0x30, 0x3d, 0x68, 0xcf, 0x0c,
-		0xdc, 0xbe, 0xc7, 0x21, 0x6e, 0x76, 0x5b, 0x5b, 0xb8, 0x43, 0x99, 0x14, 0x53, 0x78, 0x09, 0x15,
-		0x21, 0xd6, 0xd4, 0xb6, 0x70, 0x73, 0x26, 0x3a, 0x1f, 0x3a, 0x93, 0xbb, 0xf0, 0xd0, 0x58, 0xbb,
-		0x72, 0x61, 0x85, 0xa0, 0x28, 0x0c, 0x13, 0xbd, 0x0b, 0xa2, 0x5c, 0x79, 0x13, 0x0a, 0xe7, 0xc6,
-		0xa3, 0x40, 0xf6, 0x92, 0x42, 0xf1, 0xd0, 0x29, 0x48, 0x91, 0xff, 0x99, 0x6c, 0xc4, 0xe9, 0x98,
-		0x93, 0xa4, 0x82, 0xc8, 0x05, 0x2a, 0x40, 0x92, 0x6e, 0x93, 0x06, 0x16, 0x46, 0xcf, 0x2d, 0x13,
-		0xc1, 0x6a, 0xe0, 0x6d, 0xad, 0xdb, 0x74, 0xd4, 0x3d, 0xad, 0xd9, 0xc5, 0x54, 0xe0, 0x53, 0x4a,
-		0x86, 0x57, 0xde, 0x20, 0x75, 0x68, 0x0e, 0xd2, 0x6c, 0x57, 0x19, 0x66, 0x03, 0xef, 0x53, 0xbd,
-		0x1a, 0x53, 0xd8, 0x46, 0xab, 0x92, 0x1a, 0xd2, 0xfd, 0x4b, 0xb6, 0x65, 0x0a, 0xd1, 0xa4, 0x5d,
-		0x90, 0x0a, 0xda, 0xfd, 0x93, 0xbd, 0x2a, 0xfd, 0xde, 0xc1, 0xd3, 0xeb, 0xdb, 0x4b, 0xa7, 0x21,
-		0x4f, 0x21, 0x1e, 0xe7, 0x4b, 0xaf, 0x35, 0x67, 0x26, 0xe7, 0x43, 0x67, 0x92, 0x4a, 0x8e, 0x55,
-		0xaf, 0xf3, 0x5a, 0xf9, 0xb3, 0x61, 0x88, 0x52, 0xc5, 0x92, 0x87, 0x74, 0xfd, 0x85, 0x8d, 0x8a,
-		0xba, 0xb4, 0xbe, 0x59, 0x5a, 0xa9, 0x48, 0x21, 0x94, 0x03, 0xa0, 0x15, 0x57, 0x56, 0xd6, 0x8b,
-		0x75, 0x29, 0xec, 0x96, 0xab, 0x6b, 0xf5, 0x4b, 0x4f, 0x48, 0x11, 0x17, 0x61, 0x93, 0x55, 0x44,
-		0xfd, 0x00, 0x8f, 0x5f, 0x90, 0x62, 0x48, 0x82, 0x0c, 0x23, 0x50, 0x7d, 0xbe, 0xb2, 0x74, 0xe9,
-		0x09, 0x29, 0x1e, 0xac, 0x79, 0xfc, 0x82, 0x94, 0x40, 0x59, 0x48, 0xd1, 0x9a, 0xd2, 0xfa, 0xfa,
-		0x8a, 0x94, 0x74, 0x69, 0xd6, 0xea, 0x4a, 0x75, 0x6d, 0x59, 0x4a, 0xb9, 0x34, 0x97, 0x95, 0xf5,
-		0xcd, 0x0d, 0x09, 0x5c, 0x0a, 0xab, 0x95, 0x5a, 0xad, 0xb8, 0x5c, 0x91, 0xd2, 0x2e, 0x44, 0xe9,
-		0x85, 0x7a, 0xa5, 0x26, 0x65, 0x02, 0xc3, 0x7a, 0xfc, 0x82, 0x94, 0x75, 0xbb, 0xa8, 0xac, 0x6d,
-		0xae, 0x4a, 0x39, 0x34, 0x09, 0x59, 0xd6, 0x85, 0x18, 0x44, 0xbe, 0xa7, 0xea, 0xd2, 0x13, 0x92,
-		0xe4, 0x0d, 0x84, 0x51, 0x99, 0x0c, 0x54, 0x5c, 0x7a, 0x42, 0x42, 0x72, 0x19, 0x62, 0x54, 0x0c,
-		0x11, 0x82, 0xdc, 0x4a, 0xb1, 0x54, 0x59, 0x51, 0xd7, 0x37, 0xea, 0xd5, 0xf5, 0xb5, 0xe2, 0x8a,
-		0x14, 0xf2, 0xea, 0x94, 0xca, 0xbb, 0x37, 0xab, 0x4a, 0x65, 0x49, 0x0a, 0xfb, 0xeb, 0x36, 0x2a,
-		0xc5, 0x7a, 0x65, 0x49, 0x8a, 0xc8, 0x3a, 0x4c, 0x0f, 0x52, 0xa8, 0x03, 0xb7, 0x90, 0x4f, 0x16,
-		0xc2, 0x43, 0x64, 0x81, 0xd2, 0xea, 0x95, 0x05, 0xf9, 0x4b, 0x61, 0x98, 0x1a, 0x60, 0x54, 0x06,
-		0x76, 0xf2, 0x2c, 0xc4, 0x98, 0x2c, 0x33, 0x33, 0x7b, 0x76, 0xa0, 0x75, 0xa2, 0x92, 0xdd, 0x67,
-		0x6a, 0x29, 0x9e, 0xdf, 0x09, 0x89, 0x0c, 0x71, 0x42, 0x08, 0x89, 0x3e, 0x81, 0xfd, 0x9e, 0x3e,
-		0xe5, 0xcf, 0xec, 0xe3, 0xa5, 0x71, 0xec, 0x23, 0xad, 0x3b, 0x9a, 0x11, 0x88, 0x0d, 0x30, 0x02,
-		0x97, 0x61, 0xb2, 0x8f, 0xd0, 0xd8, 0xca, 0xf8, 0x7d, 0x21, 0x98, 0x19, 0xc6, 0x9c, 0x11, 0x2a,
-		0x31, 0x1c, 0x50, 0x89, 0x97, 0x7b, 0x39, 0x78, 0xdf, 0xf0, 0x45, 0xe8, 0x5b, 0xeb, 0x8f, 0x87,
-		0xe0, 0xf8, 0x60, 0x67, 0x73, 0xe0, 0x18, 0xde, 0x05, 0xf1, 0x16, 0x76, 0x76, 0x2d, 0xe1, 0x56,
-		0x3d, 0x38, 0xc0, 0x58, 0x93, 0xe6, 0xde, 0xc5, 0xe6, 0x58, 0x7e, 0x6b, 0x1f, 0x19, 0xe6, 0x31,
-		0xb2, 0xd1, 0xf4, 0x8d, 0xf4, 0x47, 0xc2, 0x70, 0x6c, 0x20, 0xf1, 0x81, 0x03, 0xbd, 0x17, 0xc0,
-		0x30, 0xdb, 0x5d, 0x87, 0xb9, 0x4e, 0x4c, 0x13, 0xa7, 0x68, 0x0d, 0x55, 0x5e, 0x44, 0xcb, 0x76,
-		0x1d, 0xb7, 0x3d, 0x42, 0xdb, 0x81, 0x55, 0x51, 0x80, 0xa7, 0xbc, 0x81, 0x46, 0xe9, 0x40, 0x67,
-		0x87, 0xcc, 0xb4, 0x4f, 0x30, 0x1f, 0x05, 0x49, 0x6f, 0x1a, 0xd8, 0x74, 0x54, 0xdb, 0xe9, 0x60,
-		0xad, 0x65, 0x98, 0x3b, 0xd4, 0xd4, 0x24, 0x17, 0x63, 0xdb, 0x5a, 0xd3, 0xc6, 0x4a, 0x9e, 0x35,
-		0xd7, 0x44, 0x2b, 0xc1, 0xa0, 0x02, 0xd4, 0xf1, 0x61, 0xc4, 0x03, 0x18, 0xac, 0xd9, 0xc5, 0x90,
-		0x7f, 0x3c, 0x05, 0x69, 0x9f, 0x6b, 0x8e, 0xee, 0x83, 0xcc, 0x4b, 0xda, 0x9e, 0xa6, 0x8a, 0x70,
-		0x8b, 0x71, 0x22, 0x4d, 0xea, 0x36, 0x78, 0xc8, 0xf5, 0x28, 0x4c, 0x53, 0x10, 0xab, 0xeb, 0xe0,
-		0x8e, 0xaa, 0x37, 0x35, 0xdb, 0xa6, 0x4c, 0x4b, 0x52, 0x50, 0x44, 0xda, 0xd6, 0x49, 0x53, 0x59,
-		0xb4, 0xa0, 0x8b, 0x30, 0x45, 0x31, 0x5a, 0xdd, 0xa6, 0x63, 0xb4, 0x9b, 0x58, 0x25, 0x01, 0xa0,
-		0x4d, 0x4d, 0x8e, 0x3b, 0xb2, 0x49, 0x02, 0xb1, 0xca, 0x01, 0xc8, 0x88, 0x6c, 0xb4, 0x04, 0xf7,
-		0x52, 0xb4, 0x1d, 0x6c, 0xe2, 0x8e, 0xe6, 0x60, 0x15, 0xbf, 0xb7, 0xab, 0x35, 0x6d, 0x55, 0x33,
-		0x1b, 0xea, 0xae, 0x66, 0xef, 0xce, 0x4c, 0x13, 0x02, 0xa5, 0xf0, 0x4c, 0x48, 0x39, 0x49, 0x00,
-		0x97, 0x39, 0x5c, 0x85, 0x82, 0x15, 0xcd, 0xc6, 0x55, 0xcd, 0xde, 0x45, 0x8b, 0x70, 0x9c, 0x52,
-		0xb1, 0x9d, 0x8e, 0x61, 0xee, 0xa8, 0xfa, 0x2e, 0xd6, 0x6f, 0xaa, 0x5d, 0x67, 0xfb, 0xa9, 0x99,
-		0x53, 0xfe, 0xfe, 0xe9, 0x08, 0x6b, 0x14, 0xa6, 0x4c, 0x40, 0x36, 0x9d, 0xed, 0xa7, 0x50, 0x0d,
-		0x32, 0x64, 0x31, 0x5a, 0xc6, 0xcb, 0x58, 0xdd, 0xb6, 0x3a, 0xd4, 0x86, 0xe6, 0x06, 0xa8, 0x26,
-		0x1f, 0x07, 0x17, 0xd6, 0x39, 0xc2, 0xaa, 0xd5, 0xc0, 0x8b, 0xb1, 0xda, 0x46, 0xa5, 0xb2, 0xa4,
-		0xa4, 0x05, 0x95, 0x2b, 0x56, 0x87, 0x08, 0xd4, 0x8e, 0xe5, 0x32, 0x38, 0xcd, 0x04, 0x6a, 0xc7,
-		0x12, 0xec, 0xbd, 0x08, 0x53, 0xba, 0xce, 0xe6, 0x6c, 0xe8, 0x2a, 0x0f, 0xd3, 0xec, 0x19, 0x29,
-		0xc0, 0x2c, 0x5d, 0x5f, 0x66, 0x00, 0x5c, 0xc6, 0x6d, 0xf4, 0x34, 0x1c, 0xf3, 0x98, 0xe5, 0x47,
-		0x9c, 0xec, 0x9b, 0x65, 0x2f, 0xea, 0x45, 0x98, 0x6a, 0x1f, 0xf4, 0x23, 0xa2, 0x40, 0x8f, 0xed,
-		0x83, 0x5e, 0xb4, 0x27, 0x61, 0xba, 0xbd, 0xdb, 0xee, 0xc7, 0x3b, 0xe7, 0xc7, 0x43, 0xed, 0xdd,
-		0x76, 0x2f, 0xe2, 0x03, 0x34, 0x66, 0xef, 0x60, 0x5d, 0x73, 0x70, 0x63, 0xe6, 0x84, 0x1f, 0xdc,
-		0xd7, 0x80, 0x16, 0x40, 0xd2, 0x75, 0x15, 0x9b, 0xda, 0x56, 0x13, 0xab, 0x5a, 0x07, 0x9b, 0x9a,
-		0x3d, 0x33, 0x47, 0x81, 0xa3, 0x4e, 0xa7, 0x8b, 0x95, 0x9c, 0xae, 0x57, 0x68, 0x63, 0x91, 0xb6,
-		0xa1, 0x73, 0x30, 0x69, 0x6d, 0xbd, 0xa4, 0x33, 0x89, 0x54, 0xdb, 0x1d, 0xbc, 0x6d, 0xec, 0xcf,
-		0xbc, 0x83, 0xb2, 0x37, 0x4f, 0x1a, 0xa8, 0x3c, 0x6e, 0xd0, 0x6a, 0x74, 0x16, 0x24, 0xdd, 0xde,
-		0xd5, 0x3a, 0x6d, 0xaa, 0x92, 0xed, 0xb6, 0xa6, 0xe3, 0x99, 0x07, 0x18, 0x28, 0xab, 0x5f, 0x13,
-		0xd5, 0x64, 0x47, 0xd8, 0xb7, 0x8c, 0x6d, 0x47, 0x50, 0x3c, 0xcd, 0x76, 0x04, 0xad, 0xe3, 0xd4,
-		0xce, 0x80, 0x44, 0x38, 0x11, 0xe8, 0xf8, 0x0c, 0x05, 0xcb, 0xb5, 0x77, 0xdb, 0xfe, 0x7e, 0xef,
-		0x87, 0x2c, 0x81, 0xf4, 0x3a, 0x3d, 0xcb, 0x1c, 0xb7, 0xf6, 0xae, 0xaf, 0xc7, 0x27, 0xe0, 0x38,
-		0x01, 0x6a, 0x61, 0x47, 0x6b, 0x68, 0x8e, 0xe6, 0x83, 0x7e, 0x98, 0x42, 0x13, 0xb6, 0xaf, 0xf2,
-		0xc6, 0xc0, 0x38, 0x3b, 0xdd, 0xad, 0x03, 0x57, 0xb0, 0x1e, 0x61, 0xe3, 0x24, 0x75, 0x42, 0xb4,
-		0xde, 0x32, 0xe7, 0x5c, 0x5e, 0x84, 0x8c, 0x5f, 0xee, 0x51, 0x0a, 0x98, 0xe4, 0x4b, 0x21, 0xe2,
-		0x04, 0x95, 0xd7, 0x97, 0x88, 0xfb, 0xf2, 0x62, 0x45, 0x0a, 0x13, 0x37, 0x6a, 0xa5, 0x5a, 0xaf,
-		0xa8, 0xca, 0xe6, 0x5a, 0xbd, 0xba, 0x5a, 0x91, 0x22, 0x3e, 0xc7, 0xfe, 0x5a, 0x34, 0xf9, 0xa0,
-		0x74, 0x5a, 0xfe, 0x7c, 0x04, 0x72, 0xc1, 0x48, 0x0d, 0xbd, 0x13, 0x4e, 0x88, 0x84, 0x8b, 0x8d,
-		0x1d, 0xf5, 0x96, 0xd1, 0xa1, 0x1b, 0xb2, 0xa5, 0x31, 0xe3, 0xe8, 0xca, 0xcf, 0x34, 0x87, 0xaa,
-		0x61, 0xe7, 0x39, 0xa3, 0x43, 0xb6, 0x5b, 0x4b, 0x73, 0xd0, 0x0a, 0xcc, 0x99, 0x96, 0x6a, 0x3b,
-		0x9a, 0xd9, 0xd0, 0x3a, 0x0d, 0xd5, 0x4b, 0x75, 0xa9, 0x9a, 0xae, 0x63, 0xdb, 0xb6, 0x98, 0x21,
-		0x74, 0xa9, 0xdc, 0x63, 0x5a, 0x35, 0x0e, 0xec, 0x59, 0x88, 0x22, 0x07, 0xed, 0x11, 0xdf, 0xc8,
-		0x30, 0xf1, 0x3d, 0x05, 0xa9, 0x96, 0xd6, 0x56, 0xb1, 0xe9, 0x74, 0x0e, 0xa8, 0x7f, 0x9e, 0x54,
-		0x92, 0x2d, 0xad, 0x5d, 0x21, 0x65, 0x74, 0x03, 0x1e, 0xf4, 0x40, 0xd5, 0x26, 0xde, 0xd1, 0xf4,
-		0x03, 0x95, 0x3a, 0xe3, 0x34, 0x6d, 0xa0, 0xea, 0x96, 0xb9, 0xdd, 0x34, 0x74, 0xc7, 0xa6, 0xfa,
-		0x81, 0xe9, 0x38, 0xd9, 0xc3, 0x58, 0xa1, 0x08, 0xd7, 0x6c, 0xcb, 0xa4, 0x3e, 0x78, 0x59, 0x40,
-		0xbf, 0x2d, 0xe1, 0xd7, 0xb5, 0x68, 0x32, 0x2a, 0xc5, 0xae, 0x45, 0x93, 0x31, 0x29, 0x7e, 0x2d,
-		0x9a, 0x8c, 0x4b, 0x89, 0x6b, 0xd1, 0x64, 0x52, 0x4a, 0x5d, 0x8b, 0x26, 0x53, 0x12, 0xc8, 0xbf,
-		0x96, 0x84, 0x8c, 0x3f, 0x32, 0x20, 0x81, 0x96, 0x4e, 0x6d, 0x63, 0x88, 0x6a, 0xcf, 0xfb, 0x0f,
-		0x8d, 0x23, 0x16, 0xca, 0xc4, 0x68, 0x2e, 0xc6, 0x99, 0x1b, 0xae, 0x30, 0x4c, 0xe2, 0xb0, 0x10,
-		0xb1, 0xc6, 0xcc, 0xed, 0x49, 0x2a, 0xbc, 0x84, 0x96, 0x21, 0xfe, 0x92, 0x4d, 0x69, 0xc7, 0x29,
-		0xed, 0x77, 0x1c, 0x4e, 0xfb, 0x5a, 0x8d, 0x12, 0x4f, 0x5d, 0xab, 0xa9, 0x6b, 0xeb, 0xca, 0x6a,
-		0x71, 0x45, 0xe1, 0xe8, 0xe8, 0x24, 0x44, 0x9b, 0xda, 0xcb, 0x07, 0x41, 0xf3, 0x4a, 0xab, 0xd0,
-		0x02, 0xe4, 0xbb, 0xe6, 0x1e, 0xee, 0x18, 0xdb, 0x06, 0x59, 0x2a, 0x02, 0x95, 0xf7, 0x43, 0xe5,
-		0xbc, 0xd6, 0x15, 0x02, 0x3f, 0xa6, 0x78, 0x9c, 0x84, 0xe8, 0x2d, 0xac, 0xdd, 0x0c, 0x1a, 0x41,
-		0x5a, 0x85, 0xce, 0x40, 0xa6, 0x81, 0xb7, 0xba, 0x3b, 0x6a, 0x07, 0x37, 0x34, 0xdd, 0x09, 0xaa,
-		0xfe, 0x34, 0x6d, 0x52, 0x68, 0x0b, 0xba, 0x0e, 0x29, 0xb2, 0x46, 0x26, 0x5d, 0xe3, 0x49, 0xca,
-		0x82, 0x47, 0x0e, 0x67, 0x01, 0x5f, 0x62, 0x81, 0xa4, 0x78, 0xf8, 0xe8, 0x0a, 0xc4, 0x1d, 0xad,
-		0xb3, 0x83, 0x1d, 0xaa, 0xf9, 0x73, 0x03, 0x92, 0x1f, 0x03, 0x28, 0xd5, 0x29, 0x06, 0x8d, 0x69,
-		0x39, 0xf6, 0x5b, 0xa8, 0x65, 0xce, 0x43, 0x8c, 0x8a, 0x07, 0x02, 0xe0, 0x02, 0x22, 0x4d, 0xa0,
-		0x24, 0x44, 0xcb, 0xeb, 0x0a, 0xd1, 0x34, 0x12, 0x64, 0x58, 0xad, 0xba, 0x51, 0xad, 0x94, 0x2b,
-		0x52, 0x58, 0xbe, 0x08, 0x71, 0xb6, 0xe6, 0x44, 0x0b, 0xb9, 0xab, 0x2e, 0x4d, 0xf0, 0x22, 0xa7,
-		0x11, 0x12, 0xad, 0x9b, 0xab, 0xa5, 0x8a, 0x22, 0x85, 0xe5, 0x4d, 0xc8, 0xf7, 0xf0, 0x09, 0x1d,
-		0x83, 0x49, 0xa5, 0x52, 0xaf, 0xac, 0x91, 0x38, 0x4b, 0xdd, 0x5c, 0xbb, 0xbe, 0xb6, 0xfe, 0xdc,
-		0x9a, 0x34, 0x11, 0xac, 0x16, 0x2a, 0x2d, 0x84, 0xa6, 0x41, 0xf2, 0xaa, 0x6b, 0xeb, 0x9b, 0x0a,
-		0x1d, 0xcd, 0x8f, 0x86, 0x41, 0xea, 0xe5, 0x1a, 0x3a, 0x01, 0x53, 0xf5, 0xa2, 0xb2, 0x5c, 0xa9,
-		0xab, 0x2c, 0x76, 0x74, 0x49, 0x4f, 0x83, 0xe4, 0x6f, 0xb8, 0x52, 0xa5, 0xa1, 0xf1, 0x1c, 0x9c,
-		0xf2, 0xd7, 0x56, 0x9e, 0xaf, 0x57, 0xd6, 0x6a, 0xb4, 0xf3, 0xe2, 0xda, 0x32, 0xd1, 0xaf, 0x3d,
-		0xf4, 0x44, 0xb4, 0x1a, 0x21, 0x43, 0x0d, 0xd2, 0xab, 0xac, 0x2c, 0x49, 0xd1, 0xde, 0xea, 0xf5,
-		0xb5, 0xca, 0xfa, 0x15, 0x29, 0xd6, 0xdb, 0x3b, 0x8d, 0x60, 0xe3, 0xa8, 0x00, 0xc7, 0x7b, 0x6b,
-		0xd5, 0xca, 0x5a, 0x5d, 0x79, 0x41, 0x4a, 0xf4, 0x76, 0x5c, 0xab, 0x28, 0x37, 0xaa, 0xe5, 0x8a,
-		0x94, 0x44, 0xc7, 0x01, 0x05, 0x47, 0x54, 0xbf, 0xba, 0xbe, 0x24, 0xa5, 0xfa, 0x34, 0x8a, 0x6c,
-		0x43, 0xc6, 0x1f, 0x46, 0xbe, 0x3d, 0xb9, 0xa4, 0x57, 0xc3, 0x90, 0xf6, 0x85, 0x85, 0xc4, 0x9f,
-		0xd7, 0x9a, 0x4d, 0xeb, 0x96, 0xaa, 0x35, 0x0d, 0xcd, 0xe6, 0xfa, 0x06, 0x68, 0x55, 0x91, 0xd4,
-		0x8c, 0xbb, 0xbf, 0xc7, 0xd7, 0xf0, 0xf1, 0x6f, 0x47, 0x0d, 0x1f, 0x93, 0xe2, 0xf2, 0x87, 0x42,
-		0x20, 0xf5, 0xc6, 0x7b, 0x3d, 0xd3, 0x0f, 0x0d, 0x9b, 0xfe, 0xdb, 0xb2, 0x76, 0x3f, 0x1b, 0x82,
-		0x5c, 0x30, 0xc8, 0xeb, 0x19, 0xde, 0x7d, 0xff, 0x57, 0x87, 0xf7, 0xc7, 0x61, 0xc8, 0x06, 0x42,
-		0xbb, 0x71, 0x47, 0xf7, 0x5e, 0x98, 0x34, 0x1a, 0xb8, 0xd5, 0xb6, 0x1c, 0x6c, 0xea, 0x07, 0x6a,
-		0x13, 0xef, 0xe1, 0xe6, 0x8c, 0x4c, 0x95, 0xf2, 0xf9, 0xc3, 0x83, 0xc7, 0x85, 0xaa, 0x87, 0xb7,
-		0x42, 0xd0, 0x16, 0xa7, 0xaa, 0x4b, 0x95, 0xd5, 0x8d, 0xf5, 0x7a, 0x65, 0xad, 0xfc, 0x82, 0xd0,
-		0x2e, 0x8a, 0x64, 0xf4, 0x80, 0xbd, 0x85, 0x4a, 0x7b, 0x03, 0xa4, 0xde, 0x41, 0x11, 0x5d, 0x31,
-		0x60, 0x58, 0xd2, 0x04, 0x9a, 0x82, 0xfc, 0xda, 0xba, 0x5a, 0xab, 0x2e, 0x55, 0xd4, 0xca, 0x95,
-		0x2b, 0x95, 0x72, 0xbd, 0xc6, 0xd2, 0x81, 0x2e, 0x74, 0x5d, 0x0a, 0xfb, 0x59, 0xfc, 0xc1, 0x08,
-		0x4c, 0x0d, 0x18, 0x09, 0x2a, 0xf2, 0x40, 0x9e, 0xe5, 0x16, 0x1e, 0x19, 0x67, 0xf4, 0x0b, 0xc4,
-		0x95, 0xde, 0xd0, 0x3a, 0x0e, 0x8f, 0xfb, 0xcf, 0x02, 0xe1, 0x92, 0xe9, 0x10, 0xcb, 0xde, 0xe1,
-		0x69, 0x56, 0x16, 0xdd, 0xe7, 0xbd, 0x7a, 0x96, 0x69, 0x7d, 0x18, 0x50, 0xdb, 0xb2, 0x0d, 0xc7,
-		0xd8, 0xc3, 0xaa, 0x61, 0x8a, 0x9c, 0x2c, 0x89, 0xf6, 0xa3, 0x8a, 0x24, 0x5a, 0xaa, 0xa6, 0xe3,
-		0x42, 0x9b, 0x78, 0x47, 0xeb, 0x81, 0x26, 0x9e, 0x47, 0x44, 0x91, 0x44, 0x8b, 0x0b, 0x7d, 0x1f,
-		0x64, 0x1a, 0x56, 0x97, 0x84, 0x40, 0x0c, 0x8e, 0x68, 0x8b, 0x90, 0x92, 0x66, 0x75, 0x2e, 0x08,
-		0x0f, 0x6e, 0xbd, 0x64, 0x70, 0x46, 0x49, 0xb3, 0x3a, 0x06, 0x72, 0x1a, 0xf2, 0xda, 0xce, 0x4e,
-		0x87, 0x10, 0x17, 0x84, 0x58, 0xb8, 0x9e, 0x73, 0xab, 0x29, 0x60, 0xe1, 0x1a, 0x24, 0x05, 0x1f,
-		0x88, 0x07, 0x4b, 0x38, 0xa1, 0xb6, 0x59, 0x0e, 0x2a, 0x7c, 0x26, 0xa5, 0x24, 0x4d, 0xd1, 0x78,
-		0x1f, 0x64, 0x0c, 0x5b, 0xf5, 0xce, 0xb6, 0xc2, 0xf3, 0xe1, 0x33, 0x49, 0x25, 0x6d, 0xd8, 0xee,
-		0xb9, 0x80, 0xfc, 0xf1, 0x30, 0xe4, 0x82, 0xa7, 0x76, 0x68, 0x09, 0x92, 0x4d, 0x4b, 0xd7, 0xa8,
-		0x68, 0xb1, 0x23, 0xe3, 0x33, 0x23, 0x0e, 0xfa, 0x16, 0x56, 0x38, 0xbc, 0xe2, 0x62, 0x16, 0xfe,
-		0x6d, 0x08, 0x92, 0xa2, 0x1a, 0x1d, 0x87, 0x68, 0x5b, 0x73, 0x76, 0x29, 0xb9, 0x58, 0x29, 0x2c,
-		0x85, 0x14, 0x5a, 0x26, 0xf5, 0x76, 0x5b, 0x33, 0xa9, 0x08, 0xf0, 0x7a, 0x52, 0x26, 0xeb, 0xda,
-		0xc4, 0x5a, 0x83, 0xe6, 0x02, 0xac, 0x56, 0x0b, 0x9b, 0x8e, 0x2d, 0xd6, 0x95, 0xd7, 0x97, 0x79,
-		0x35, 0x7a, 0x08, 0x26, 0x9d, 0x8e, 0x66, 0x34, 0x03, 0xb0, 0x51, 0x0a, 0x2b, 0x89, 0x06, 0x17,
-		0x78, 0x11, 0x4e, 0x0a, 0xba, 0x0d, 0xec, 0x68, 0xfa, 0x2e, 0x6e, 0x78, 0x48, 0x71, 0x9a, 0xf3,
-		0x3b, 0xc1, 0x01, 0x96, 0x78, 0xbb, 0xc0, 0x95, 0xbf, 0x18, 0x86, 0x49, 0x91, 0xbd, 0x68, 0xb8,
-		0xcc, 0x5a, 0x05, 0xd0, 0x4c, 0xd3, 0x72, 0xfc, 0xec, 0xea, 0x17, 0xe5, 0x3e, 0xbc, 0x85, 0xa2,
-		0x8b, 0xa4, 0xf8, 0x08, 0x14, 0xbe, 0x16, 0x02, 0xf0, 0x9a, 0x86, 0xf2, 0x6d, 0x0e, 0xd2, 0xfc,
-		0x4c, 0x96, 0x1e, 0xec, 0xb3, 0x84, 0x17, 0xb0, 0xaa, 0x2b, 0x46, 0x93, 0xa6, 0x25, 0xb7, 0xf0,
-		0x8e, 0x61, 0xf2, 0xf3, 0x14, 0x56, 0x10, 0x69, 0xc9, 0xa8, 0x77, 0x3c, 0xa5, 0x40, 0xd2, 0xc6,
-		0x2d, 0xcd, 0x74, 0x0c, 0x9d, 0x9f, 0x90, 0x5c, 0x3a, 0xd2, 0xe0, 0x17, 0x6a, 0x1c, 0x5b, 0x71,
-		0xe9, 0xc8, 0x67, 0x20, 0x29, 0x6a, 0x89, 0xe3, 0xb7, 0xb6, 0xbe, 0x56, 0x91, 0x26, 0x50, 0x02,
-		0x22, 0xb5, 0x4a, 0x5d, 0x0a, 0x91, 0xb0, 0xb3, 0xb8, 0x52, 0x2d, 0xd6, 0xa4, 0x70, 0xe9, 0xff,
-		0x87, 0x29, 0xdd, 0x6a, 0xf5, 0x76, 0x58, 0x92, 0x7a, 0x52, 0x7e, 0xf6, 0xd5, 0xd0, 0x8b, 0x8f,
-		0x70, 0xa0, 0x1d, 0xab, 0xa9, 0x99, 0x3b, 0x0b, 0x56, 0x67, 0xc7, 0xbb, 0x16, 0x41, 0xa2, 0x03,
-		0xdb, 0x77, 0x39, 0xa2, 0xbd, 0xf5, 0x3f, 0x43, 0xa1, 0x9f, 0x0b, 0x47, 0x96, 0x37, 0x4a, 0xbf,
-		0x14, 0x2e, 0x2c, 0x33, 0xc4, 0x0d, 0x31, 0x1d, 0x05, 0x6f, 0x37, 0xb1, 0x4e, 0x06, 0x0f, 0x7f,
-		0xf6, 0x10, 0x4c, 0xef, 0x58, 0x3b, 0x16, 0xa5, 0x74, 0x9e, 0xfc, 0xe2, 0xf7, 0x2a, 0x52, 0x6e,
-		0x6d, 0x61, 0xe4, 0x25, 0x8c, 0xc5, 0x35, 0x98, 0xe2, 0xc0, 0x2a, 0x3d, 0xbe, 0x65, 0xc9, 0x05,
-		0x74, 0x68, 0x66, 0x7b, 0xe6, 0x57, 0xfe, 0x94, 0x7a, 0x25, 0xca, 0x24, 0x47, 0x25, 0x6d, 0x2c,
-		0xff, 0xb0, 0xa8, 0xc0, 0xb1, 0x00, 0x3d, 0xa6, 0x23, 0x70, 0x67, 0x04, 0xc5, 0xdf, 0xe6, 0x14,
-		0xa7, 0x7c, 0x14, 0x6b, 0x1c, 0x75, 0xb1, 0x0c, 0xd9, 0xa3, 0xd0, 0xfa, 0x57, 0x9c, 0x56, 0x06,
-		0xfb, 0x89, 0x2c, 0x43, 0x9e, 0x12, 0xd1, 0xbb, 0xb6, 0x63, 0xb5, 0xa8, 0x02, 0x3e, 0x9c, 0xcc,
-		0xbf, 0xfe, 0x53, 0xb6, 0x69, 0x73, 0x04, 0xad, 0xec, 0x62, 0x2d, 0x2e, 0x02, 0x3d, 0xb1, 0x6e,
-		0x60, 0xbd, 0x39, 0x82, 0xc2, 0x17, 0xf8, 0x40, 0x5c, 0xf8, 0xc5, 0x1b, 0x30, 0x4d, 0x7e, 0x53,
-		0xfd, 0xe8, 0x1f, 0xc9, 0xe8, 0x34, 0xf8, 0xcc, 0xef, 0xbd, 0x8f, 0xe9, 0x85, 0x29, 0x97, 0x80,
-		0x6f, 0x4c, 0xbe, 0x55, 0xdc, 0xc1, 0x8e, 0x83, 0x3b, 0xb6, 0xaa, 0x35, 0x07, 0x0d, 0xcf, 0x97,
-		0x47, 0x9c, 0xf9, 0xe9, 0xaf, 0x07, 0x57, 0x71, 0x99, 0x61, 0x16, 0x9b, 0xcd, 0xc5, 0x4d, 0x38,
-		0x31, 0x40, 0x2a, 0xc6, 0xa0, 0xf9, 0x41, 0x4e, 0x73, 0xba, 0x4f, 0x32, 0x08, 0xd9, 0x0d, 0x10,
-		0xf5, 0xee, 0x5a, 0x8e, 0x41, 0xf3, 0x67, 0x38, 0x4d, 0xc4, 0x71, 0xc5, 0x92, 0x12, 0x8a, 0xd7,
-		0x60, 0x72, 0x0f, 0x77, 0xb6, 0x2c, 0x9b, 0xe7, 0x6e, 0xc7, 0x20, 0xf7, 0xb3, 0x9c, 0x5c, 0x9e,
-		0x23, 0xd2, 0x64, 0x2e, 0xa1, 0xf5, 0x34, 0x24, 0xb7, 0x35, 0x1d, 0x8f, 0x41, 0xe2, 0x0e, 0x27,
-		0x91, 0x20, 0xf0, 0x04, 0xb5, 0x08, 0x99, 0x1d, 0x8b, 0x9b, 0xc8, 0xd1, 0xe8, 0x1f, 0xe2, 0xe8,
-		0x69, 0x81, 0xc3, 0x49, 0xb4, 0xad, 0x76, 0xb7, 0x49, 0xec, 0xe7, 0x68, 0x12, 0xff, 0x40, 0x90,
-		0x10, 0x38, 0x9c, 0xc4, 0x11, 0xd8, 0xfa, 0x61, 0x41, 0xc2, 0xf6, 0xf1, 0xf3, 0x59, 0x48, 0x5b,
-		0x66, 0xf3, 0xc0, 0x32, 0xc7, 0x19, 0xc4, 0x47, 0x38, 0x05, 0xe0, 0x28, 0x84, 0xc0, 0x65, 0x48,
-		0x8d, 0xbb, 0x10, 0xbf, 0xf0, 0x75, 0xb1, 0x3d, 0xc4, 0x0a, 0x2c, 0x43, 0x5e, 0x28, 0x28, 0xc3,
-		0x32, 0xc7, 0x20, 0xf1, 0x31, 0x4e, 0x22, 0xe7, 0x43, 0xe3, 0xd3, 0x70, 0xb0, 0xed, 0xec, 0xe0,
-		0x71, 0x88, 0x7c, 0x5c, 0x4c, 0x83, 0xa3, 0x70, 0x56, 0x6e, 0x61, 0x53, 0xdf, 0x1d, 0x8f, 0xc2,
-		0x27, 0x04, 0x2b, 0x05, 0x0e, 0x21, 0x51, 0x86, 0x6c, 0x4b, 0xeb, 0xd8, 0xbb, 0x5a, 0x73, 0xac,
-		0xe5, 0xf8, 0x45, 0x4e, 0x23, 0xe3, 0x22, 0x71, 0x8e, 0x74, 0xcd, 0xa3, 0x90, 0xf9, 0x25, 0xc1,
-		0x11, 0x1f, 0x1a, 0xdf, 0x7a, 0xb6, 0x43, 0x13, 0xdd, 0x47, 0xa1, 0xf6, 0x0f, 0xc5, 0xd6, 0x63,
-		0xb8, 0xab, 0x7e, 0x8a, 0x97, 0x21, 0x65, 0x1b, 0x2f, 0x8f, 0x45, 0xe6, 0x93, 0x62, 0xa5, 0x29,
-		0x02, 0x41, 0x7e, 0x01, 0x4e, 0x0e, 0x34, 0x13, 0x63, 0x10, 0xfb, 0x65, 0x4e, 0xec, 0xf8, 0x00,
-		0x53, 0xc1, 0x55, 0xc2, 0x51, 0x49, 0xfe, 0x23, 0xa1, 0x12, 0x70, 0x0f, 0xad, 0x0d, 0x12, 0xb4,
-		0xd8, 0xda, 0xf6, 0xd1, 0xb8, 0xf6, 0x8f, 0x05, 0xd7, 0x18, 0x6e, 0x80, 0x6b, 0x75, 0x38, 0xce,
-		0x29, 0x1e, 0x6d, 0x5d, 0x3f, 0x25, 0x14, 0x2b, 0xc3, 0xde, 0x0c, 0xae, 0xee, 0x77, 0x43, 0xc1,
-		0x65, 0xa7, 0xf0, 0x8e, 0x6d, 0xb5, 0xa5, 0xb5, 0xc7, 0xa0, 0xfc, 0x2b, 0x9c, 0xb2, 0xd0, 0xf8,
-		0xae, 0x7b, 0x6d, 0xaf, 0x6a, 0x6d, 0x42, 0xfc, 0x79, 0x98, 0x11, 0xc4, 0xbb, 0x66, 0x07, 0xeb,
-		0xd6, 0x8e, 0x69, 0xbc, 0x8c, 0x1b, 0x63, 0x90, 0xfe, 0xd5, 0x9e, 0xa5, 0xda, 0xf4, 0xa1, 0x13,
-		0xca, 0x55, 0x90, 0x5c, 0x5f, 0x45, 0x35, 0x5a, 0x6d, 0xab, 0xe3, 0x8c, 0xa0, 0xf8, 0x69, 0xb1,
-		0x52, 0x2e, 0x5e, 0x95, 0xa2, 0x2d, 0x56, 0x80, 0xdd, 0xfe, 0x18, 0x57, 0x24, 0x3f, 0xc3, 0x09,
-		0x65, 0x3d, 0x2c, 0xae, 0x38, 0x74, 0xab, 0xd5, 0xd6, 0x3a, 0xe3, 0xe8, 0xbf, 0x7f, 0x22, 0x14,
-		0x07, 0x47, 0xe1, 0x8a, 0x83, 0x78, 0x74, 0xc4, 0xda, 0x8f, 0x41, 0xe1, 0xb3, 0x42, 0x71, 0x08,
-		0x1c, 0x4e, 0x42, 0x38, 0x0c, 0x63, 0x90, 0xf8, 0x35, 0x41, 0x42, 0xe0, 0x10, 0x12, 0xef, 0xf6,
-		0x0c, 0x6d, 0x07, 0xef, 0x18, 0xb6, 0xd3, 0x61, 0x2e, 0xf9, 0xe1, 0xa4, 0x3e, 0xf7, 0xf5, 0xa0,
-		0x13, 0xa6, 0xf8, 0x50, 0x89, 0x26, 0xe2, 0x47, 0x1f, 0x34, 0x64, 0x1b, 0x3d, 0xb0, 0x5f, 0x17,
-		0x9a, 0xc8, 0x87, 0x46, 0xc6, 0xe6, 0xf3, 0x10, 0x09, 0xdb, 0x75, 0x12, 0xa8, 0x8c, 0x41, 0xee,
-		0x9f, 0xf6, 0x0c, 0xae, 0x26, 0x70, 0x09, 0x4d, 0x9f, 0xff, 0xd3, 0x35, 0x6f, 0xe2, 0x83, 0xb1,
-		0xa4, 0xf3, 0xf3, 0x3d, 0xfe, 0xcf, 0x26, 0xc3, 0x64, 0x3a, 0x24, 0xdf, 0xe3, 0x4f, 0xa1, 0x51,
-		0x77, 0xfd, 0x66, 0xbe, 0xff, 0x0d, 0x3e, 0xdf, 0xa0, 0x3b, 0xb5, 0xb8, 0x42, 0x84, 0x3c, 0xe8,
-		0xf4, 0x8c, 0x26, 0xf6, 0xbe, 0x37, 0x5c, 0x39, 0x0f, 0xf8, 0x3c, 0x8b, 0x57, 0x20, 0x1b, 0x70,
-		0x78, 0x46, 0x93, 0xfa, 0x41, 0x4e, 0x2a, 0xe3, 0xf7, 0x77, 0x16, 0x2f, 0x42, 0x94, 0x38, 0x2f,
-		0xa3, 0xd1, 0x7f, 0x88, 0xa3, 0x53, 0xf0, 0xc5, 0x67, 0x20, 0x29, 0x9c, 0x96, 0xd1, 0xa8, 0x3f,
-		0xcc, 0x51, 0x5d, 0x14, 0x82, 0x2e, 0x1c, 0x96, 0xd1, 0xe8, 0x7f, 0x4b, 0xa0, 0x0b, 0x14, 0x82,
-		0x3e, 0x3e, 0x0b, 0x7f, 0xf3, 0x6f, 0x47, 0xb9, 0xd1, 0x11, 0xbc, 0xbb, 0x0c, 0x09, 0xee, 0xa9,
-		0x8c, 0xc6, 0xfe, 0x11, 0xde, 0xb9, 0xc0, 0x58, 0x7c, 0x12, 0x62, 0x63, 0x32, 0xfc, 0xef, 0x70,
-		0x54, 0x06, 0xbf, 0x58, 0x86, 0xb4, 0xcf, 0x3b, 0x19, 0x8d, 0xfe, 0x63, 0x1c, 0xdd, 0x8f, 0x45,
-		0x86, 0xce, 0xbd, 0x93, 0xd1, 0x04, 0xfe, 0xae, 0x18, 0x3a, 0xc7, 0x20, 0x6c, 0x13, 0x8e, 0xc9,
-		0x68, 0xec, 0x0f, 0x08, 0xae, 0x0b, 0x94, 0xc5, 0x67, 0x21, 0xe5, 0x1a, 0x9b, 0xd1, 0xf8, 0x3f,
-		0xce, 0xf1, 0x3d, 0x1c, 0xc2, 0x01, 0x9f, 0xb1, 0x1b, 0x4d, 0xe2, 0xef, 0x09, 0x0e, 0xf8, 0xb0,
-		0xc8, 0x36, 0xea, 0x75, 0x60, 0x46, 0x53, 0xfa, 0x09, 0xb1, 0x8d, 0x7a, 0xfc, 0x17, 0xb2, 0x9a,
-		0x54, 0xe7, 0x8f, 0x26, 0xf1, 0xf7, 0xc5, 0x6a, 0x52, 0x78, 0x32, 0x8c, 0x5e, 0x8f, 0x60, 0x34,
-		0x8d, 0x9f, 0x12, 0xc3, 0xe8, 0x71, 0x08, 0x16, 0x37, 0x00, 0xf5, 0x7b, 0x03, 0xa3, 0xe9, 0xbd,
-		0xca, 0xe9, 0x4d, 0xf6, 0x39, 0x03, 0x8b, 0xcf, 0xc1, 0xf1, 0xc1, 0x9e, 0xc0, 0x68, 0xaa, 0x3f,
-		0xfd, 0x46, 0x4f, 0xec, 0xe6, 0x77, 0x04, 0x16, 0xeb, 0x9e, 0x49, 0xf1, 0x7b, 0x01, 0xa3, 0xc9,
-		0x7e, 0xf0, 0x8d, 0xa0, 0xe2, 0xf6, 0x3b, 0x01, 0x8b, 0x45, 0x00, 0xcf, 0x00, 0x8f, 0xa6, 0xf5,
-		0xb3, 0x9c, 0x96, 0x0f, 0x89, 0x6c, 0x0d, 0x6e, 0x7f, 0x47, 0xe3, 0xdf, 0x11, 0x5b, 0x83, 0x63,
-		0x90, 0xad, 0x21, 0x4c, 0xef, 0x68, 0xec, 0x0f, 0x89, 0xad, 0x21, 0x50, 0x88, 0x64, 0xfb, 0xac,
-		0xdb, 0x68, 0x0a, 0x1f, 0x11, 0x92, 0xed, 0xc3, 0x5a, 0x5c, 0x83, 0xc9, 0x3e, 0x83, 0x38, 0x9a,
-		0xd4, 0xcf, 0x71, 0x52, 0x52, 0xaf, 0x3d, 0xf4, 0x1b, 0x2f, 0x6e, 0x0c, 0x47, 0x53, 0xfb, 0x68,
-		0x8f, 0xf1, 0xe2, 0xb6, 0x70, 0xf1, 0x32, 0x24, 0xcd, 0x6e, 0xb3, 0x49, 0x36, 0x0f, 0x3a, 0xfc,
-		0x7e, 0xee, 0xcc, 0x7f, 0xfd, 0x26, 0xe7, 0x8e, 0x40, 0x58, 0xbc, 0x08, 0x31, 0xdc, 0xda, 0xc2,
-		0x8d, 0x51, 0x98, 0x5f, 0xfd, 0xa6, 0x50, 0x98, 0x04, 0x7a, 0xf1, 0x59, 0x00, 0x96, 0x1a, 0xa1,
-		0x07, 0xe7, 0x23, 0x70, 0xbf, 0xf6, 0x4d, 0x7e, 0x21, 0xce, 0x43, 0xf1, 0x08, 0xb0, 0xeb, 0x75,
-		0x87, 0x13, 0xf8, 0x7a, 0x90, 0x00, 0x5d, 0x91, 0xa7, 0x21, 0xf1, 0x92, 0x6d, 0x99, 0x8e, 0xb6,
-		0x33, 0x0a, 0xfb, 0xcf, 0x38, 0xb6, 0x80, 0x27, 0x0c, 0x6b, 0x59, 0x1d, 0xec, 0x68, 0x3b, 0xf6,
-		0x28, 0xdc, 0xff, 0xc6, 0x71, 0x5d, 0x04, 0x82, 0xac, 0x6b, 0xb6, 0x33, 0xce, 0xbc, 0xff, 0x5c,
-		0x20, 0x0b, 0x04, 0x32, 0x68, 0xf2, 0xfb, 0x26, 0x3e, 0x18, 0x85, 0xfb, 0x17, 0x62, 0xd0, 0x1c,
-		0x7e, 0xf1, 0x19, 0x48, 0x91, 0x9f, 0xec, 0x96, 0xeb, 0x08, 0xe4, 0xbf, 0xe4, 0xc8, 0x1e, 0x06,
-		0xe9, 0xd9, 0x76, 0x1a, 0x8e, 0x31, 0x9a, 0xd9, 0xdf, 0xe0, 0x2b, 0x2d, 0xe0, 0x17, 0x8b, 0x90,
-		0xb6, 0x9d, 0x46, 0xa3, 0xcb, 0xfd, 0xd3, 0x11, 0xe8, 0xff, 0xfd, 0x9b, 0x6e, 0xca, 0xc2, 0xc5,
-		0x21, 0xab, 0x7d, 0xeb, 0xa6, 0xd3, 0xb6, 0xe8, 0x79, 0xcb, 0x28, 0x0a, 0x6f, 0x70, 0x0a, 0x3e,
-		0x94, 0xc5, 0x32, 0x64, 0xc8, 0x5c, 0x3a, 0xb8, 0x8d, 0xe9, 0xe1, 0xd8, 0x08, 0x12, 0x7f, 0xc5,
-		0x19, 0x10, 0x40, 0x2a, 0x7d, 0xef, 0x17, 0x5e, 0x9f, 0x0d, 0x7d, 0xf1, 0xf5, 0xd9, 0xd0, 0x1f,
-		0xbf, 0x3e, 0x1b, 0xfa, 0xc0, 0x97, 0x66, 0x27, 0xbe, 0xf8, 0xa5, 0xd9, 0x89, 0x3f, 0xf8, 0xd2,
-		0xec, 0xc4, 0xe0, 0x2c, 0x31, 0x2c, 0x5b, 0xcb, 0x16, 0xcb, 0x0f, 0xbf, 0xf8, 0xc0, 0x8e, 0xe1,
-		0xec, 0x76, 0xb7, 0x16, 0x74, 0xab, 0x75, 0x5e, 0xb7, 0xec, 0x96, 0x65, 0x9f, 0x0f, 0xe6, 0x75,
-		0xe9, 0x2f, 0xf8, 0xeb, 0x10, 0x89, 0x99, 0x83, 0xe9, 0x5c, 0xcd, 0x3c, 0x18, 0xf6, 0x31, 0xdd,
-		0x25, 0x88, 0x14, 0xcd, 0x03, 0x74, 0x92, 0x29, 0x38, 0xb5, 0xdb, 0x69, 0xf2, 0xab, 0x96, 0x09,
-		0x52, 0xde, 0xec, 0x34, 0xd1, 0xb4, 0x77, 0x1f, 0x3a, 0x74, 0x26, 0xc3, 0x2f, 0x39, 0x97, 0x7e,
-		0x2c, 0x74, 0xb4, 0x99, 0x24, 0x8b, 0xe6, 0x01, 0x9d, 0xc8, 0x46, 0xe8, 0xc5, 0x87, 0x47, 0xe6,
-		0xb9, 0x6f, 0x9a, 0xd6, 0x2d, 0x93, 0x0c, 0xbb, 0xbd, 0x25, 0x72, 0xdc, 0xb3, 0xbd, 0x39, 0xee,
-		0xe7, 0x70, 0xb3, 0x79, 0x9d, 0xc0, 0xd5, 0x09, 0xca, 0x56, 0x9c, 0xdd, 0xea, 0x87, 0x9f, 0x08,
-		0xc3, 0x6c, 0x5f, 0x3a, 0x9b, 0x0b, 0xc1, 0x30, 0x26, 0x2c, 0x42, 0x72, 0x49, 0xc8, 0xd6, 0x0c,
-		0x24, 0x6c, 0xac, 0x5b, 0x66, 0xc3, 0xa6, 0x8c, 0x88, 0x28, 0xa2, 0x48, 0x18, 0x61, 0x6a, 0xa6,
-		0x65, 0xf3, 0xcb, 0xca, 0xac, 0x50, 0xfa, 0x99, 0x23, 0x32, 0x22, 0x2b, 0x7a, 0x12, 0xdc, 0x78,
-		0x6c, 0x4c, 0x6e, 0x88, 0x49, 0x04, 0x32, 0xff, 0xe3, 0x72, 0xe5, 0xa7, 0xc2, 0x30, 0xd7, 0xcb,
-		0x15, 0xb2, 0xb3, 0x6c, 0x47, 0x6b, 0xb5, 0x87, 0xb1, 0xe5, 0x32, 0xa4, 0xea, 0x02, 0xe6, 0xc8,
-		0x7c, 0xb9, 0x73, 0x44, 0xbe, 0xe4, 0xdc, 0xae, 0x04, 0x63, 0x2e, 0x8c, 0xc9, 0x18, 0x77, 0x1e,
-		0x77, 0xc5, 0x99, 0xff, 0x15, 0x87, 0x93, 0x6c, 0x3b, 0xa9, 0x6c, 0x2b, 0xb1, 0x02, 0xe7, 0x49,
-		0xc6, 0xdf, 0x34, 0xfa, 0x9c, 0x44, 0xbe, 0x0e, 0x53, 0x55, 0xa2, 0x2d, 0x48, 0x14, 0xe4, 0x9d,
-		0xf0, 0x0c, 0xbc, 0xcf, 0x3d, 0x1f, 0x70, 0xf8, 0xf9, 0xf9, 0x96, 0xbf, 0x4a, 0xfe, 0xfe, 0x10,
-		0x48, 0x35, 0x5d, 0x6b, 0x6a, 0x9d, 0x6f, 0x95, 0x14, 0x7a, 0x12, 0x80, 0x5d, 0xf7, 0x70, 0x3f,
-		0xdc, 0xcb, 0x5d, 0x98, 0x59, 0xf0, 0x4f, 0x6e, 0x81, 0xf5, 0x44, 0x6f, 0x50, 0xa5, 0x28, 0x2c,
-		0xf9, 0x79, 0xee, 0x79, 0x00, 0xaf, 0x01, 0x9d, 0x82, 0x13, 0xb5, 0x72, 0x71, 0xa5, 0xa8, 0x88,
-		0x4b, 0x42, 0xb5, 0x8d, 0x4a, 0xb9, 0x7a, 0xa5, 0x5a, 0x59, 0x92, 0x26, 0xd0, 0x71, 0x40, 0xfe,
-		0x46, 0xf7, 0x52, 0xd3, 0x31, 0x98, 0xf4, 0xd7, 0xb3, 0xaf, 0x54, 0xc2, 0xc4, 0x53, 0x34, 0x5a,
-		0xed, 0x26, 0xa6, 0x27, 0x8f, 0xaa, 0x21, 0xb8, 0x36, 0xda, 0x09, 0xf9, 0x9d, 0xff, 0xc0, 0xbe,
-		0x5c, 0x98, 0xf2, 0xd0, 0x5d, 0x9e, 0x2f, 0xae, 0xc0, 0xa4, 0xa6, 0xeb, 0xb8, 0x1d, 0x20, 0x39,
-		0x42, 0x55, 0x13, 0x82, 0xf4, 0x2c, 0x95, 0x63, 0x7a, 0xd4, 0x9e, 0x84, 0xb8, 0x4d, 0x67, 0x3f,
-		0x8a, 0xc4, 0xef, 0x72, 0x12, 0x1c, 0x7c, 0xd1, 0x84, 0x49, 0xe2, 0xf9, 0x69, 0x1d, 0xec, 0x1b,
-		0xc6, 0xe1, 0x79, 0x86, 0x7f, 0xf6, 0xe9, 0x47, 0xe9, 0xc9, 0xea, 0x7d, 0xc1, 0x65, 0x19, 0x20,
-		0x4e, 0x8a, 0xc4, 0x69, 0x7b, 0x03, 0xc5, 0x90, 0x13, 0xfd, 0xf1, 0x01, 0x1f, 0xde, 0xd9, 0x6f,
-		0xf0, 0xce, 0x66, 0x07, 0xc9, 0x80, 0xaf, 0xa7, 0x2c, 0xa7, 0xca, 0x1a, 0x4a, 0x95, 0x61, 0x7b,
-		0xfa, 0xc5, 0x87, 0xfa, 0xad, 0x13, 0xfb, 0xef, 0x11, 0x4a, 0xf9, 0xb2, 0xbf, 0x1b, 0x77, 0xef,
-		0xfd, 0x61, 0x04, 0x26, 0xb5, 0x96, 0x61, 0x5a, 0xe7, 0xe9, 0xbf, 0x7c, 0xcf, 0xc5, 0x68, 0x61,
-		0x8c, 0x43, 0xc9, 0x4b, 0x6c, 0x2b, 0x8c, 0x96, 0x98, 0xbf, 0xfc, 0xd1, 0x8f, 0xc5, 0xbc, 0xed,
-		0xb2, 0xb8, 0x0a, 0x92, 0xb8, 0xc4, 0x8b, 0x4d, 0xdd, 0x6a, 0x8c, 0x95, 0xa5, 0xf8, 0x86, 0xa0,
-		0x21, 0xf2, 0x5b, 0x15, 0x8e, 0xba, 0xf8, 0x4e, 0x48, 0xba, 0x64, 0x46, 0x79, 0x26, 0x82, 0x88,
-		0x8b, 0x41, 0xfc, 0x12, 0xb6, 0x33, 0xc7, 0xf1, 0x42, 0xdf, 0x10, 0xf8, 0x6c, 0x87, 0xae, 0x91,
-		0xd9, 0x2c, 0x43, 0xae, 0x61, 0x99, 0x8e, 0x6a, 0xb5, 0x0c, 0x07, 0xb7, 0xda, 0xce, 0x48, 0xbf,
-		0xee, 0xaf, 0x18, 0x91, 0xa4, 0x92, 0x25, 0x78, 0xeb, 0x02, 0xed, 0xae, 0x16, 0xd7, 0x6e, 0xdc,
-		0xe4, 0xba, 0xd8, 0xd9, 0x67, 0x8b, 0xe8, 0x2e, 0xee, 0x9f, 0x47, 0x60, 0x96, 0x03, 0x6f, 0x69,
-		0x36, 0x3e, 0xbf, 0xf7, 0xd8, 0x16, 0x76, 0xb4, 0xc7, 0xce, 0xeb, 0x96, 0x21, 0x0c, 0xf1, 0x14,
-		0xd7, 0xb5, 0xa4, 0x7d, 0x81, 0xb7, 0x17, 0x06, 0x9e, 0x56, 0x17, 0x86, 0xeb, 0xe8, 0x42, 0xbf,
-		0x08, 0xc9, 0x2f, 0x42, 0xb4, 0x6c, 0x19, 0x26, 0x31, 0x4d, 0x0d, 0x6c, 0x5a, 0x2d, 0xae, 0x2d,
-		0x59, 0x01, 0x5d, 0x82, 0xb8, 0xd6, 0xb2, 0xba, 0xa6, 0xc3, 0x34, 0x65, 0x69, 0xf6, 0x0b, 0xaf,
-		0xcd, 0x4d, 0xfc, 0xe1, 0x6b, 0x73, 0x91, 0xaa, 0xe9, 0xfc, 0xfe, 0x67, 0x1e, 0x01, 0x4e, 0xbd,
-		0x6a, 0x3a, 0x9f, 0xf8, 0xf2, 0xa7, 0xce, 0x85, 0x14, 0x0e, 0xbd, 0x18, 0xfd, 0xca, 0x87, 0xe7,
-		0x42, 0xf2, 0xf3, 0x90, 0x58, 0xc2, 0xfa, 0x21, 0xe4, 0x1f, 0xeb, 0x21, 0x7f, 0x52, 0x90, 0x5f,
-		0xc2, 0xba, 0x8f, 0xfc, 0x12, 0xd6, 0x7b, 0x28, 0x3f, 0x09, 0xc9, 0xaa, 0xe9, 0xb0, 0x2f, 0x80,
-		0x1e, 0x82, 0x88, 0x61, 0xb2, 0x4b, 0xe5, 0x3e, 0x0a, 0x7d, 0x03, 0x54, 0x08, 0x14, 0x41, 0x5c,
-		0xc2, 0xba, 0x8b, 0xd8, 0xc0, 0x7a, 0x2f, 0x62, 0x7f, 0xd7, 0x04, 0xaa, 0xb4, 0xf4, 0x07, 0x7f,
-		0x32, 0x3b, 0xf1, 0xca, 0xeb, 0xb3, 0x13, 0x43, 0x97, 0x5e, 0x1e, 0xbd, 0xf4, 0xee, 0x8a, 0x7f,
-		0x2c, 0x0a, 0xf7, 0xd2, 0x0f, 0x43, 0x3b, 0x2d, 0xc3, 0x74, 0xce, 0xeb, 0x9d, 0x83, 0xb6, 0x63,
-		0x91, 0xfd, 0x6b, 0x6d, 0xf3, 0x05, 0x9f, 0xf4, 0x9a, 0x17, 0x58, 0xf3, 0xe0, 0xe5, 0x96, 0xb7,
-		0x21, 0xb6, 0x41, 0xf0, 0x08, 0x8b, 0x1d, 0xcb, 0xd1, 0x9a, 0xdc, 0xe9, 0x60, 0x05, 0x52, 0xcb,
-		0x3e, 0x26, 0x0d, 0xb3, 0x5a, 0x43, 0x7c, 0x47, 0xda, 0xc4, 0xda, 0x36, 0xfb, 0x26, 0x27, 0x42,
-		0xbd, 0xd5, 0x24, 0xa9, 0xa0, 0x9f, 0xdf, 0x4c, 0x43, 0x4c, 0xeb, 0xb2, 0x7b, 0x33, 0x11, 0xe2,
-		0xc6, 0xd2, 0x82, 0x7c, 0x1d, 0x12, 0xfc, 0xf8, 0x1c, 0x49, 0x10, 0xb9, 0x89, 0x0f, 0x68, 0x3f,
-		0x19, 0x85, 0xfc, 0x44, 0x0b, 0x10, 0xa3, 0x83, 0xe7, 0x1f, 0x1b, 0xce, 0x2c, 0xf4, 0x8d, 0x7e,
-		0x81, 0x0e, 0x52, 0x61, 0x60, 0xf2, 0x35, 0x48, 0x2e, 0x59, 0x44, 0x0a, 0x83, 0xd4, 0x52, 0x8c,
-		0x1a, 0x1d, 0x73, 0xbb, 0xcb, 0xa5, 0x42, 0x61, 0x05, 0x74, 0x1c, 0xe2, 0xec, 0x1b, 0x2d, 0x7e,
-		0xf7, 0x87, 0x97, 0xe4, 0x32, 0x24, 0x28, 0xed, 0xf5, 0x36, 0xb1, 0xf8, 0xee, 0xb5, 0xf5, 0x14,
-		0xff, 0x62, 0x97, 0x93, 0x0f, 0x7b, 0x83, 0x45, 0x10, 0x6d, 0x68, 0x8e, 0xc6, 0xe7, 0x4d, 0x7f,
-		0xcb, 0xef, 0x82, 0x24, 0x27, 0x62, 0xa3, 0x0b, 0x10, 0xb1, 0xda, 0x36, 0xbf, 0xbd, 0x53, 0x18,
-		0x36, 0x95, 0xf5, 0x76, 0x29, 0x4a, 0x64, 0x46, 0x21, 0xc0, 0xa5, 0xb5, 0xa1, 0x62, 0xf1, 0x44,
-		0x40, 0x2c, 0x5a, 0xd8, 0xd9, 0xda, 0x76, 0xbc, 0x1f, 0x6c, 0x39, 0xfb, 0x44, 0xc1, 0x15, 0x94,
-		0x3b, 0x61, 0x98, 0xf5, 0xb5, 0xee, 0xe1, 0x8e, 0x6d, 0x58, 0x26, 0x93, 0x26, 0x2e, 0x29, 0xc8,
-		0x37, 0x40, 0xde, 0x3e, 0x44, 0x54, 0x9e, 0x81, 0x48, 0xb1, 0xdd, 0x46, 0x05, 0x48, 0xd2, 0xb2,
-		0x6e, 0x31, 0x59, 0x89, 0x2a, 0x6e, 0x99, 0xb4, 0xd9, 0xd6, 0xb6, 0x73, 0x4b, 0xeb, 0xb8, 0x9f,
-		0x30, 0x8b, 0xb2, 0xfc, 0x34, 0xa4, 0xca, 0x96, 0x69, 0x63, 0xd3, 0xee, 0x52, 0x57, 0x76, 0xab,
-		0x69, 0xe9, 0x37, 0x39, 0x05, 0x56, 0x20, 0xcc, 0xd6, 0xda, 0x6d, 0x8a, 0x19, 0x55, 0xc8, 0x4f,
-		0xb6, 0x5f, 0x4b, 0xeb, 0x43, 0xd9, 0x73, 0xf1, 0x68, 0xec, 0xe1, 0x13, 0xf4, 0x7c, 0xd2, 0x10,
-		0xdc, 0xd3, 0xbf, 0x91, 0x6e, 0xe2, 0x03, 0xfb, 0xa8, 0xfb, 0xe8, 0x79, 0x48, 0x6d, 0xd0, 0xd7,
-		0x45, 0xae, 0xe3, 0x03, 0x54, 0x80, 0x04, 0x6e, 0x5c, 0xb8, 0x78, 0xf1, 0xb1, 0xa7, 0x99, 0x94,
-		0x5f, 0x9d, 0x50, 0x44, 0x05, 0x9a, 0x85, 0x94, 0x8d, 0xf5, 0xf6, 0x85, 0x8b, 0x97, 0x6e, 0x3e,
-		0xc6, 0xc4, 0xea, 0xea, 0x84, 0xe2, 0x55, 0x2d, 0x26, 0xc9, 0x8c, 0xbf, 0xf2, 0x91, 0xb9, 0x50,
-		0x29, 0x06, 0x11, 0xbb, 0xdb, 0x7a, 0xcb, 0x64, 0xe3, 0x27, 0x63, 0x30, 0xef, 0x6b, 0x65, 0xc6,
-		0x65, 0x4f, 0x6b, 0x1a, 0x0d, 0xcd, 0x7b, 0x13, 0x46, 0xf2, 0xcd, 0x9f, 0x42, 0x0c, 0xb1, 0x1a,
-		0x87, 0x72, 0x51, 0xfe, 0xd5, 0x10, 0x64, 0x6e, 0x08, 0xca, 0x35, 0xec, 0xa0, 0xcb, 0x00, 0x6e,
-		0x4f, 0x62, 0xab, 0x9c, 0x5a, 0xe8, 0xed, 0x6b, 0xc1, 0xc5, 0x51, 0x7c, 0xe0, 0xe8, 0x49, 0x2a,
-		0x80, 0x6d, 0xcb, 0xe6, 0x9f, 0xb3, 0x8e, 0x40, 0x75, 0x81, 0xd1, 0xc3, 0x80, 0xa8, 0x56, 0x53,
-		0xf7, 0x2c, 0xc7, 0x30, 0x77, 0xd4, 0xb6, 0x75, 0x8b, 0x3f, 0x12, 0x10, 0x51, 0x24, 0xda, 0x72,
-		0x83, 0x36, 0x6c, 0x90, 0x7a, 0x32, 0xe8, 0x94, 0x4b, 0x85, 0x44, 0x65, 0x5a, 0xa3, 0xd1, 0xc1,
-		0xb6, 0xcd, 0x15, 0x97, 0x28, 0xa2, 0xcb, 0x90, 0x68, 0x77, 0xb7, 0x54, 0xa1, 0x25, 0xd2, 0x17,
-		0xee, 0x19, 0xb4, 0xe7, 0x85, 0x6c, 0xf0, 0x5d, 0x1f, 0x6f, 0x77, 0xb7, 0x88, 0xa4, 0xdc, 0x07,
-		0x99, 0x01, 0x83, 0x49, 0xef, 0x79, 0xe3, 0xa0, 0x0f, 0xda, 0xf0, 0x19, 0xa8, 0xed, 0x8e, 0x61,
-		0x75, 0x0c, 0xe7, 0x80, 0xde, 0xba, 0x8b, 0x28, 0x92, 0x68, 0xd8, 0xe0, 0xf5, 0xf2, 0x4d, 0xc8,
-		0xd7, 0xa8, 0xb7, 0xee, 0x8d, 0xfc, 0xa2, 0x37, 0xbe, 0xd0, 0xe8, 0xf1, 0x0d, 0x1d, 0x59, 0xb8,
-		0x6f, 0x64, 0xa5, 0xd5, 0xa1, 0x92, 0xf9, 0xf8, 0xd1, 0x24, 0x33, 0x68, 0xdd, 0xbe, 0x76, 0x32,
-		0xb0, 0x29, 0xb9, 0xd7, 0xe3, 0x53, 0x59, 0xe3, 0x0a, 0xe5, 0xa8, 0x40, 0xbc, 0x70, 0xb8, 0x11,
-		0x2d, 0x8c, 0x50, 0x9d, 0x85, 0x91, 0xdb, 0x47, 0x7e, 0x1a, 0xb2, 0x1b, 0x5a, 0xc7, 0xa9, 0x61,
-		0xe7, 0x2a, 0xd6, 0x1a, 0xb8, 0x13, 0xb4, 0xb2, 0x59, 0x61, 0x65, 0x11, 0x44, 0xa9, 0x29, 0x65,
-		0x56, 0x86, 0xfe, 0x96, 0x77, 0x21, 0x4a, 0xaf, 0xdd, 0xba, 0x16, 0x98, 0x63, 0x30, 0x0b, 0x4c,
-		0xf4, 0xe7, 0x81, 0x83, 0x6d, 0x91, 0x2b, 0xa2, 0x05, 0xf4, 0x84, 0xb0, 0xa3, 0x91, 0xc3, 0xed,
-		0x28, 0x17, 0x42, 0x6e, 0x4d, 0x9b, 0x90, 0x28, 0x11, 0xf5, 0x5b, 0x5d, 0x72, 0x07, 0x12, 0xf2,
-		0x06, 0x82, 0x56, 0x21, 0xdf, 0xd6, 0x3a, 0x0e, 0xfd, 0x0c, 0x6f, 0x97, 0xce, 0x82, 0xcb, 0xf9,
-		0x5c, 0xff, 0xae, 0x0b, 0x4c, 0x96, 0xf7, 0x92, 0x6d, 0xfb, 0x2b, 0xe5, 0xff, 0x12, 0x85, 0x38,
-		0x67, 0xc6, 0x33, 0x90, 0xe0, 0x6c, 0xe5, 0x92, 0x79, 0xef, 0x42, 0xbf, 0x31, 0x5a, 0x70, 0x8d,
-		0x06, 0xa7, 0x27, 0x70, 0xd0, 0x83, 0x90, 0xd4, 0x77, 0x35, 0xc3, 0x54, 0x8d, 0x06, 0x77, 0x00,
-		0xd3, 0xaf, 0xbf, 0x36, 0x97, 0x28, 0x93, 0xba, 0xea, 0x92, 0x92, 0xa0, 0x8d, 0xd5, 0x06, 0xb1,
-		0xfc, 0xbb, 0xd8, 0xd8, 0xd9, 0x75, 0xf8, 0xee, 0xe2, 0x25, 0xf4, 0x14, 0x44, 0x89, 0x40, 0xf0,
-		0x8f, 0xb4, 0x0b, 0x7d, 0xbe, 0xbc, 0x9b, 0x27, 0x29, 0x25, 0x49, 0xc7, 0x1f, 0xf8, 0xcf, 0x73,
-		0x21, 0x85, 0x62, 0xa0, 0x32, 0x64, 0x9b, 0x9a, 0xed, 0xa8, 0xd4, 0x6a, 0x91, 0xee, 0x63, 0x94,
-		0xc4, 0xc9, 0x7e, 0x86, 0x70, 0xc6, 0xf2, 0xa1, 0xa7, 0x09, 0x16, 0xab, 0x6a, 0xa0, 0x33, 0x20,
-		0x51, 0x22, 0xba, 0xd5, 0x6a, 0x19, 0x0e, 0xf3, 0xa5, 0xe2, 0x94, 0xef, 0x39, 0x52, 0x5f, 0xa6,
-		0xd5, 0xd4, 0xa3, 0x3a, 0x05, 0x29, 0xfa, 0x59, 0x28, 0x05, 0x61, 0x77, 0xbd, 0x93, 0xa4, 0x82,
-		0x36, 0x9e, 0x86, 0xbc, 0xa7, 0x1b, 0x19, 0x48, 0x92, 0x51, 0xf1, 0xaa, 0x29, 0xe0, 0xa3, 0x30,
-		0x6d, 0xe2, 0x7d, 0x7a, 0xfb, 0x3c, 0x00, 0x9d, 0xa2, 0xd0, 0x88, 0xb4, 0xdd, 0x08, 0x62, 0x3c,
-		0x00, 0x39, 0x5d, 0x30, 0x9f, 0xc1, 0x02, 0x85, 0xcd, 0xba, 0xb5, 0x14, 0xec, 0x24, 0x24, 0xb5,
-		0x76, 0x9b, 0x01, 0xa4, 0xb9, 0x6e, 0x6c, 0xb7, 0x69, 0xd3, 0x39, 0x98, 0xa4, 0x73, 0xec, 0x60,
-		0xbb, 0xdb, 0x74, 0x38, 0x91, 0x0c, 0x85, 0xc9, 0x93, 0x06, 0x85, 0xd5, 0x53, 0xd8, 0xfb, 0x21,
-		0x8b, 0xf7, 0x8c, 0x06, 0x36, 0x75, 0xcc, 0xe0, 0xb2, 0x14, 0x2e, 0x23, 0x2a, 0x29, 0xd0, 0x59,
-		0x70, 0x75, 0x9e, 0x2a, 0xf4, 0x71, 0x8e, 0xd1, 0x13, 0xf5, 0x45, 0x56, 0x2d, 0xcf, 0x40, 0x74,
-		0x49, 0x73, 0x34, 0xe2, 0x54, 0x38, 0xfb, 0xcc, 0xc8, 0x64, 0x14, 0xf2, 0x53, 0xfe, 0x4a, 0x18,
-		0xa2, 0x37, 0x2c, 0x07, 0xa3, 0xc7, 0x7d, 0x0e, 0x5f, 0x6e, 0x90, 0x3c, 0xd7, 0x8c, 0x1d, 0x13,
-		0x37, 0x56, 0xed, 0x1d, 0xdf, 0x1b, 0x2e, 0x9e, 0x38, 0x85, 0x03, 0xe2, 0x34, 0x0d, 0xb1, 0x8e,
-		0xd5, 0x35, 0x1b, 0xe2, 0x96, 0x34, 0x2d, 0xa0, 0x0a, 0x24, 0x5d, 0x29, 0x89, 0x8e, 0x92, 0x92,
-		0x3c, 0x91, 0x12, 0x22, 0xc3, 0xbc, 0x42, 0x49, 0x6c, 0x71, 0x61, 0x29, 0x41, 0xca, 0x55, 0x5e,
-		0x5c, 0xda, 0xc6, 0x13, 0x58, 0x0f, 0x8d, 0x18, 0x12, 0x77, 0xed, 0x5d, 0xe6, 0x31, 0x89, 0x93,
-		0xdc, 0x06, 0xce, 0xbd, 0x80, 0x58, 0xf1, 0xf7, 0x64, 0x12, 0x74, 0x5e, 0x9e, 0x58, 0xb1, 0x37,
-		0x65, 0xee, 0x81, 0x94, 0x6d, 0xec, 0x98, 0x9a, 0xd3, 0xed, 0x60, 0x2e, 0x79, 0x5e, 0x85, 0xfc,
-		0x9b, 0x21, 0x88, 0x33, 0x49, 0xf6, 0xf1, 0x2d, 0x34, 0x98, 0x6f, 0xe1, 0x61, 0x7c, 0x8b, 0xdc,
-		0x3d, 0xdf, 0x8a, 0x00, 0xee, 0x60, 0x6c, 0xfe, 0xcc, 0xc7, 0x00, 0x6f, 0x81, 0x0d, 0xb1, 0x66,
-		0xec, 0xf0, 0x8d, 0xea, 0x43, 0x92, 0xff, 0x53, 0x88, 0x38, 0xae, 0xbc, 0x1d, 0x15, 0x21, 0x2b,
-		0xc6, 0xa5, 0x6e, 0x37, 0xb5, 0x1d, 0x2e, 0x3b, 0xf7, 0x0e, 0x1d, 0xdc, 0x95, 0xa6, 0xb6, 0xa3,
-		0xa4, 0xf9, 0x78, 0x48, 0x61, 0xf0, 0x3a, 0x84, 0x87, 0xac, 0x43, 0x60, 0xe1, 0x23, 0x77, 0xb7,
-		0xf0, 0x81, 0x25, 0x8a, 0xf6, 0x2e, 0xd1, 0xa7, 0xc3, 0x34, 0x78, 0x69, 0x5b, 0xb6, 0xd6, 0x7c,
-		0x3b, 0x76, 0xc4, 0x29, 0x48, 0xb5, 0xad, 0xa6, 0xca, 0x5a, 0xd8, 0xd7, 0x03, 0xc9, 0xb6, 0xd5,
-		0x54, 0xfa, 0x96, 0x3d, 0xf6, 0x26, 0x6d, 0x97, 0xf8, 0x9b, 0xc0, 0xb5, 0x44, 0x2f, 0xd7, 0x3a,
-		0x90, 0x61, 0xac, 0xe0, 0xb6, 0xec, 0x51, 0xc2, 0x03, 0x6a, 0x1c, 0x43, 0xfd, 0xb6, 0x97, 0x0d,
-		0x9b, 0x41, 0x2a, 0x1c, 0x8e, 0x60, 0x30, 0xd5, 0x3f, 0x28, 0xea, 0xf5, 0x8b, 0xa5, 0xc2, 0xe1,
-		0xe4, 0x9f, 0x0c, 0x01, 0xac, 0x10, 0xce, 0xd2, 0xf9, 0x12, 0x2b, 0x64, 0xd3, 0x21, 0xa8, 0x81,
-		0x9e, 0x67, 0x87, 0x2d, 0x1a, 0xef, 0x3f, 0x63, 0xfb, 0xc7, 0x5d, 0x86, 0xac, 0x27, 0x8c, 0x36,
-		0x16, 0x83, 0x99, 0x3d, 0xc4, 0xa3, 0xae, 0x61, 0x47, 0xc9, 0xec, 0xf9, 0x4a, 0xf2, 0xbf, 0x08,
-		0x41, 0x8a, 0x8e, 0x69, 0x15, 0x3b, 0x5a, 0x60, 0x0d, 0x43, 0x77, 0xbf, 0x86, 0xf7, 0x02, 0x30,
-		0x32, 0xb6, 0xf1, 0x32, 0xe6, 0x92, 0x95, 0xa2, 0x35, 0x35, 0xe3, 0x65, 0x8c, 0x2e, 0xb9, 0x0c,
-		0x8f, 0x1c, 0xce, 0x70, 0xe1, 0x71, 0x73, 0xb6, 0x9f, 0x80, 0x04, 0x7d, 0x16, 0x6f, 0xdf, 0xe6,
-		0x4e, 0x74, 0xdc, 0xec, 0xb6, 0xea, 0xfb, 0xb6, 0xfc, 0x12, 0x24, 0xea, 0xfb, 0x2c, 0x17, 0x72,
-		0x0a, 0x52, 0x1d, 0xcb, 0xe2, 0x36, 0x99, 0xf9, 0x42, 0x49, 0x52, 0x41, 0x4d, 0x90, 0x88, 0xff,
-		0xc3, 0x5e, 0xfc, 0xef, 0x25, 0x30, 0x22, 0x63, 0x25, 0x30, 0xce, 0xfd, 0xc7, 0x10, 0xa4, 0x7d,
-		0xfa, 0x01, 0x3d, 0x06, 0xc7, 0x4a, 0x2b, 0xeb, 0xe5, 0xeb, 0x6a, 0x75, 0x49, 0xbd, 0xb2, 0x52,
-		0x5c, 0xf6, 0x3e, 0x90, 0x2b, 0x1c, 0xbf, 0x7d, 0x67, 0x1e, 0xf9, 0x60, 0x37, 0x4d, 0x7a, 0x18,
-		0x83, 0xce, 0xc3, 0x74, 0x10, 0xa5, 0x58, 0xaa, 0x55, 0xd6, 0xea, 0x52, 0xa8, 0x70, 0xec, 0xf6,
-		0x9d, 0xf9, 0x49, 0x1f, 0x46, 0x71, 0xcb, 0xc6, 0xa6, 0xd3, 0x8f, 0x50, 0x5e, 0x5f, 0x5d, 0xad,
-		0xd6, 0xa5, 0x70, 0x1f, 0x02, 0x57, 0xd8, 0x67, 0x61, 0x32, 0x88, 0xb0, 0x56, 0x5d, 0x91, 0x22,
-		0x05, 0x74, 0xfb, 0xce, 0x7c, 0xce, 0x07, 0xbd, 0x66, 0x34, 0x0b, 0xc9, 0xf7, 0x7f, 0x74, 0x76,
-		0xe2, 0x13, 0x3f, 0x3f, 0x1b, 0x22, 0x33, 0xcb, 0x06, 0x74, 0x04, 0x7a, 0x18, 0x4e, 0xd4, 0xaa,
-		0xcb, 0x6b, 0x95, 0x25, 0x75, 0xb5, 0xb6, 0xdc, 0xf3, 0xcd, 0x73, 0x21, 0x7f, 0xfb, 0xce, 0x7c,
-		0x9a, 0x4f, 0x69, 0x18, 0xf4, 0x86, 0x52, 0xb9, 0xb1, 0x5e, 0xaf, 0x48, 0x21, 0x06, 0xbd, 0xd1,
-		0xc1, 0x7b, 0x96, 0xc3, 0x5e, 0xd4, 0x7c, 0x14, 0x4e, 0x0e, 0x80, 0x76, 0x27, 0x36, 0x79, 0xfb,
-		0xce, 0x7c, 0x76, 0xa3, 0x83, 0xd9, 0xfe, 0xa1, 0x18, 0x0b, 0x30, 0xd3, 0x8f, 0xb1, 0xbe, 0xb1,
-		0x5e, 0x2b, 0xae, 0x48, 0xf3, 0x05, 0xe9, 0xf6, 0x9d, 0xf9, 0x8c, 0x50, 0x86, 0x04, 0xde, 0x9b,
-		0xd9, 0x5b, 0x15, 0xed, 0xfc, 0x70, 0x22, 0x90, 0xcb, 0x63, 0x71, 0x44, 0x5b, 0xeb, 0x68, 0xad,
-		0xa3, 0x86, 0x3b, 0x23, 0x4e, 0x63, 0xe5, 0x57, 0xc2, 0x90, 0x77, 0x9d, 0xe9, 0x0d, 0xda, 0x03,
-		0x7a, 0xdc, 0x9f, 0x87, 0x49, 0x0f, 0x35, 0x63, 0x0c, 0x5a, 0xa4, 0x69, 0xde, 0x09, 0x49, 0xe1,
-		0x94, 0x71, 0x75, 0x31, 0xdf, 0x8f, 0x57, 0xe1, 0x10, 0x1c, 0xd5, 0xc5, 0x40, 0xcf, 0x42, 0xca,
-		0x55, 0x1e, 0xee, 0xab, 0x53, 0xc3, 0xb5, 0x0d, 0xc7, 0xf7, 0x70, 0xd0, 0xd3, 0x5e, 0xd8, 0x10,
-		0x1d, 0x16, 0x88, 0xdc, 0x60, 0x00, 0x1c, 0x59, 0xc0, 0xcb, 0x55, 0xbe, 0xed, 0xf8, 0xec, 0xe9,
-		0x8b, 0x1d, 0xfb, 0x2a, 0x8b, 0xa4, 0x98, 0x57, 0x92, 0x6c, 0x69, 0xfb, 0x25, 0x1a, 0x4c, 0x9d,
-		0x80, 0x04, 0x69, 0xdc, 0xe1, 0xdf, 0x84, 0x47, 0x94, 0x78, 0x4b, 0xdb, 0x5f, 0xd6, 0xec, 0x6b,
-		0xd1, 0x64, 0x44, 0x8a, 0xca, 0xbf, 0x18, 0x82, 0x5c, 0x70, 0x8e, 0xe8, 0x21, 0x40, 0x04, 0x43,
-		0xdb, 0xc1, 0x2a, 0x51, 0x31, 0x94, 0x59, 0x82, 0x6e, 0xbe, 0xa5, 0xed, 0x17, 0x77, 0xf0, 0x5a,
-		0xb7, 0x45, 0x07, 0x60, 0xa3, 0x55, 0x90, 0x04, 0xb0, 0x58, 0x27, 0xce, 0xcc, 0x93, 0xfd, 0x6f,
-		0x50, 0x72, 0x00, 0x66, 0xa8, 0x5e, 0x25, 0x86, 0x2a, 0xc7, 0xe8, 0xb9, 0xa7, 0xe9, 0x81, 0xa9,
-		0x44, 0x82, 0x53, 0x91, 0x9f, 0x85, 0x7c, 0x0f, 0x3f, 0x91, 0x0c, 0x59, 0x9e, 0x15, 0xa0, 0x27,
-		0x95, 0xcc, 0x3f, 0x4e, 0x29, 0x69, 0x16, 0xfd, 0xd3, 0x93, 0xdb, 0xc5, 0xe4, 0xe7, 0x3e, 0x3c,
-		0x17, 0xa2, 0x09, 0xf3, 0x87, 0x20, 0x1b, 0xe0, 0xa8, 0xc8, 0xd4, 0x85, 0xbc, 0x4c, 0x9d, 0x07,
-		0xfc, 0x22, 0x64, 0x88, 0xa2, 0xc4, 0x0d, 0x0e, 0xfb, 0x20, 0xe4, 0x99, 0x22, 0xef, 0xe5, 0x35,
-		0xf3, 0xa4, 0x56, 0x05, 0xc3, 0x65, 0xe1, 0x5a, 0x05, 0xd9, 0x9e, 0x16, 0x50, 0xcb, 0x9a, 0x5d,
-		0x7a, 0xf7, 0x27, 0x5e, 0x9f, 0x0d, 0xbd, 0x35, 0x1b, 0xf1, 0xf7, 0xde, 0x0d, 0xa7, 0x7c, 0x8d,
-		0xda, 0x96, 0x6e, 0x04, 0xb2, 0x0e, 0x79, 0x9f, 0x90, 0x91, 0xc6, 0x51, 0xd9, 0x83, 0x43, 0x73,
-		0x18, 0x87, 0x27, 0xcc, 0x0a, 0x87, 0x6b, 0x84, 0xd1, 0x89, 0x8d, 0xc1, 0x39, 0xca, 0xff, 0x9d,
-		0x84, 0x84, 0x82, 0xdf, 0xdb, 0xc5, 0xb6, 0x83, 0x2e, 0x40, 0x14, 0xeb, 0xbb, 0xd6, 0xa0, 0x94,
-		0x10, 0x99, 0xdc, 0x02, 0x87, 0xab, 0xe8, 0xbb, 0xd6, 0xd5, 0x09, 0x85, 0xc2, 0xa2, 0x8b, 0x10,
-		0xdb, 0x6e, 0x76, 0x79, 0x9e, 0xa2, 0x47, 0x59, 0xf8, 0x91, 0xae, 0x10, 0xa0, 0xab, 0x13, 0x0a,
-		0x83, 0x26, 0x5d, 0xd1, 0x77, 0x7e, 0x23, 0x87, 0x77, 0x55, 0x35, 0xb7, 0x69, 0x57, 0x04, 0x16,
-		0x95, 0x00, 0x0c, 0xd3, 0x70, 0x54, 0x1a, 0xc3, 0x73, 0x4f, 0xf0, 0xbe, 0xe1, 0x98, 0x86, 0x43,
-		0xa3, 0xfe, 0xab, 0x13, 0x4a, 0xca, 0x10, 0x05, 0x32, 0xdc, 0xf7, 0x76, 0x71, 0xe7, 0x80, 0x3b,
-		0x80, 0x43, 0x87, 0xfb, 0x6e, 0x02, 0x44, 0x86, 0x4b, 0xa1, 0x51, 0x05, 0xd2, 0xf4, 0x03, 0x57,
-		0xb6, 0x7f, 0xf9, 0xcb, 0xb2, 0xf2, 0x30, 0xe4, 0x12, 0x01, 0xa5, 0x5b, 0xfa, 0xea, 0x84, 0x02,
-		0x5b, 0x6e, 0x89, 0x28, 0x49, 0xf6, 0xf2, 0x98, 0xb3, 0xcf, 0xdf, 0xd3, 0x9c, 0x1b, 0x46, 0x83,
-		0x3e, 0x3f, 0x56, 0xdf, 0xbf, 0x3a, 0xa1, 0x24, 0x74, 0xf6, 0x93, 0xcc, 0xbf, 0x81, 0x9b, 0xc6,
-		0x1e, 0xee, 0x10, 0xfc, 0xd4, 0xe1, 0xf3, 0x5f, 0x62, 0x90, 0x94, 0x42, 0xaa, 0x21, 0x0a, 0x44,
-		0xd1, 0x62, 0xb3, 0xc1, 0xa7, 0x01, 0xfd, 0x7a, 0x3a, 0xb0, 0xce, 0x66, 0x43, 0x4c, 0x22, 0x89,
-		0xf9, 0x6f, 0xf4, 0x94, 0xeb, 0xa1, 0xa6, 0xfb, 0x9d, 0xc2, 0xc0, 0x04, 0x58, 0xae, 0x62, 0x42,
-		0x78, 0xaa, 0x68, 0x0d, 0x72, 0x4d, 0xc3, 0x76, 0x54, 0xdb, 0xd4, 0xda, 0xf6, 0xae, 0xe5, 0xd8,
-		0x34, 0xe8, 0x4f, 0x5f, 0x78, 0x60, 0x18, 0x85, 0x15, 0xc3, 0x76, 0x6a, 0x02, 0xf8, 0xea, 0x84,
-		0x92, 0x6d, 0xfa, 0x2b, 0x08, 0x3d, 0x6b, 0x7b, 0x1b, 0x77, 0x5c, 0x82, 0x34, 0x39, 0x70, 0x08,
-		0xbd, 0x75, 0x02, 0x2d, 0xf0, 0x09, 0x3d, 0xcb, 0x5f, 0x81, 0xbe, 0x1b, 0xa6, 0x9a, 0x96, 0xd6,
-		0x70, 0xc9, 0xa9, 0xfa, 0x6e, 0xd7, 0xbc, 0x49, 0x33, 0x09, 0xe9, 0x0b, 0x67, 0x87, 0x0e, 0xd2,
-		0xd2, 0x1a, 0x82, 0x44, 0x99, 0x20, 0x5c, 0x9d, 0x50, 0x26, 0x9b, 0xbd, 0x95, 0xe8, 0x3d, 0x30,
-		0xad, 0xb5, 0xdb, 0xcd, 0x83, 0x5e, 0xea, 0x79, 0x4a, 0xfd, 0xdc, 0x30, 0xea, 0x45, 0x82, 0xd3,
-		0x4b, 0x1e, 0x69, 0x7d, 0xb5, 0xa8, 0x0e, 0x52, 0xbb, 0x83, 0xe9, 0x37, 0x31, 0x6d, 0xee, 0xaa,
-		0xd0, 0x17, 0x83, 0xd2, 0x17, 0x4e, 0x0f, 0xa3, 0xbd, 0xc1, 0xe0, 0x85, 0x67, 0x73, 0x75, 0x42,
-		0xc9, 0xb7, 0x83, 0x55, 0x8c, 0xaa, 0xa5, 0x63, 0xfa, 0xa0, 0x19, 0xa7, 0x3a, 0x39, 0x8a, 0x2a,
-		0x85, 0x0f, 0x52, 0x0d, 0x54, 0x95, 0x12, 0xfc, 0x4e, 0x1b, 0x7f, 0xf0, 0xe5, 0x34, 0xa4, 0x7d,
-		0x8a, 0x05, 0xcd, 0x40, 0x82, 0x9f, 0xf1, 0x8b, 0xbb, 0x70, 0xbc, 0x28, 0xe7, 0x20, 0xe3, 0x57,
-		0x26, 0xf2, 0x07, 0x42, 0x2e, 0x26, 0xfd, 0x52, 0x7e, 0x26, 0x98, 0x3a, 0x4c, 0x79, 0x59, 0xc1,
-		0xfb, 0x85, 0x15, 0x11, 0xed, 0xec, 0x34, 0x29, 0x43, 0x2b, 0xb9, 0x11, 0x43, 0x73, 0x90, 0x6e,
-		0x5f, 0x68, 0xbb, 0x20, 0x11, 0x0a, 0x02, 0xed, 0x0b, 0x6d, 0x01, 0x70, 0x1f, 0x64, 0xc8, 0x4c,
-		0x55, 0xbf, 0xa3, 0x91, 0x52, 0xd2, 0xa4, 0x8e, 0x83, 0xc8, 0xff, 0x26, 0x0c, 0x52, 0xaf, 0x02,
-		0x72, 0x73, 0x8a, 0xa1, 0x23, 0xe7, 0x14, 0x4f, 0xf6, 0x66, 0x33, 0xbd, 0x04, 0xe6, 0x0a, 0x48,
-		0x5e, 0x1e, 0x8e, 0x19, 0x82, 0xe1, 0x8e, 0x53, 0x8f, 0x87, 0xa7, 0xe4, 0xf5, 0x1e, 0x97, 0xef,
-		0x4a, 0xe0, 0xec, 0x45, 0x3c, 0x64, 0xdf, 0xbb, 0xc4, 0xae, 0xbf, 0xb0, 0xd9, 0x6e, 0x68, 0x0e,
-		0x16, 0x79, 0x11, 0xdf, 0x31, 0xcc, 0x83, 0x90, 0xd7, 0xda, 0x6d, 0xd5, 0x76, 0x34, 0x07, 0x73,
-		0xb3, 0x1e, 0x63, 0xe9, 0x41, 0xad, 0xdd, 0xae, 0x91, 0x5a, 0x66, 0xd6, 0x1f, 0x80, 0x1c, 0xd1,
-		0xc9, 0x86, 0xd6, 0x54, 0x79, 0x96, 0x20, 0xce, 0xac, 0x3f, 0xaf, 0xbd, 0x4a, 0x2b, 0xe5, 0x86,
-		0xbb, 0xe2, 0x54, 0x1f, 0xbb, 0x61, 0x56, 0xc8, 0x17, 0x66, 0x21, 0xfe, 0x82, 0x01, 0xe3, 0x8f,
-		0x78, 0xf5, 0x61, 0x70, 0x76, 0x77, 0x9a, 0x86, 0x64, 0x7b, 0x2c, 0xe1, 0x91, 0x54, 0x58, 0x41,
-		0x7e, 0x5f, 0x18, 0x26, 0xfb, 0x34, 0xf7, 0xc0, 0xb4, 0xb7, 0x17, 0x5f, 0x86, 0x8f, 0x14, 0x5f,
-		0x5e, 0x0f, 0xa6, 0x75, 0x7d, 0x96, 0xef, 0x54, 0x1f, 0x93, 0x99, 0xde, 0x24, 0x02, 0xcd, 0x89,
-		0xf8, 0x32, 0xbf, 0x54, 0xcc, 0x37, 0x61, 0x7a, 0xeb, 0xe0, 0x65, 0xcd, 0x74, 0x0c, 0x13, 0xab,
-		0x7d, 0xab, 0xd6, 0x6f, 0x4a, 0x57, 0x0d, 0x7b, 0x0b, 0xef, 0x6a, 0x7b, 0x86, 0x25, 0x86, 0x35,
-		0xe5, 0xe2, 0x7b, 0xc9, 0x5d, 0x59, 0x81, 0x5c, 0xd0, 0xf4, 0xa0, 0x1c, 0x84, 0x9d, 0x7d, 0x3e,
-		0xff, 0xb0, 0xb3, 0x8f, 0x1e, 0xe5, 0x79, 0xa0, 0x30, 0xcd, 0x03, 0xf5, 0x77, 0xc4, 0xf1, 0xbc,
-		0x24, 0x90, 0x2c, 0xbb, 0xbb, 0xc1, 0x35, 0x47, 0xbd, 0x54, 0xe5, 0xb3, 0x90, 0xef, 0xb1, 0x37,
-		0xc3, 0xb2, 0x82, 0x72, 0x1e, 0xb2, 0x01, 0xe3, 0x22, 0x1f, 0x87, 0xe9, 0x41, 0xb6, 0x42, 0xde,
-		0x75, 0xeb, 0x03, 0x3a, 0x1f, 0x5d, 0x84, 0xa4, 0x6b, 0x2c, 0x06, 0x64, 0x21, 0xe8, 0x2c, 0x04,
-		0xb0, 0xe2, 0x82, 0x06, 0x92, 0xd9, 0xe1, 0x40, 0x32, 0x5b, 0xfe, 0x5e, 0x98, 0x19, 0x66, 0x08,
-		0x7a, 0xa6, 0x11, 0x75, 0xa5, 0xf0, 0x38, 0xc4, 0xf9, 0xab, 0x85, 0x61, 0x7a, 0x7c, 0xc3, 0x4b,
-		0x44, 0x3a, 0x99, 0x51, 0x88, 0xb0, 0x53, 0x1d, 0x5a, 0x90, 0x55, 0x38, 0x39, 0xd4, 0x18, 0x0c,
-		0x3f, 0x08, 0x62, 0x84, 0xf8, 0x41, 0x90, 0x2e, 0x86, 0x63, 0xd3, 0xb9, 0x8a, 0xcb, 0x0e, 0xac,
-		0x24, 0xbf, 0x1a, 0x81, 0xe3, 0x83, 0x4d, 0x02, 0x9a, 0x87, 0x0c, 0xf1, 0xbb, 0x9d, 0xa0, 0x8b,
-		0x0e, 0x2d, 0x6d, 0xbf, 0xce, 0xfd, 0x73, 0x9e, 0x48, 0x0f, 0xbb, 0x89, 0x74, 0xb4, 0x09, 0x93,
-		0x4d, 0x4b, 0xd7, 0x9a, 0xaa, 0x4f, 0xe2, 0xb9, 0xb0, 0xdf, 0xdf, 0xc7, 0xec, 0x0a, 0x7b, 0xcb,
-		0xbc, 0xd1, 0x27, 0xf4, 0x79, 0x4a, 0x63, 0xc5, 0x95, 0x7c, 0xb4, 0x04, 0xe9, 0x96, 0x27, 0xc8,
-		0x47, 0x10, 0x76, 0x3f, 0x9a, 0x6f, 0x49, 0x62, 0x03, 0x8f, 0x7d, 0xe2, 0x47, 0x56, 0xd1, 0xc3,
-		0x4e, 0x50, 0x12, 0x43, 0x4f, 0x50, 0x06, 0x1d, 0x57, 0x24, 0x07, 0x1f, 0x57, 0xbc, 0xdf, 0xbf,
-		0x34, 0x01, 0x23, 0xda, 0x7f, 0x82, 0x81, 0x6a, 0x30, 0xcd, 0xf1, 0x1b, 0x01, 0xde, 0x87, 0xc7,
-		0x55, 0x34, 0x48, 0xa0, 0x0f, 0x67, 0x7b, 0xe4, 0xee, 0xd8, 0x2e, 0x74, 0x69, 0xd4, 0xa7, 0x4b,
-		0xff, 0x1f, 0x5b, 0x8a, 0x7f, 0x9f, 0x82, 0xa4, 0x82, 0xed, 0x36, 0x31, 0x9c, 0xa8, 0x04, 0x29,
-		0xbc, 0xaf, 0xe3, 0xb6, 0xe3, 0x1d, 0x53, 0x0e, 0x0a, 0x06, 0x18, 0x74, 0x45, 0x40, 0x12, 0x4f,
-		0xdc, 0x45, 0x43, 0x8f, 0xf3, 0x60, 0x6b, 0x78, 0xdc, 0xc4, 0xd1, 0xfd, 0xd1, 0xd6, 0x25, 0x11,
-		0x6d, 0x45, 0x86, 0x3a, 0xdf, 0x0c, 0xab, 0x27, 0xdc, 0x7a, 0x9c, 0x87, 0x5b, 0xd1, 0x11, 0x9d,
-		0x05, 0xe2, 0xad, 0x72, 0x20, 0xde, 0x8a, 0x8f, 0x98, 0xe6, 0x90, 0x80, 0xeb, 0x92, 0x08, 0xb8,
-		0x12, 0x23, 0x46, 0xdc, 0x13, 0x71, 0x5d, 0x09, 0x46, 0x5c, 0xc9, 0x21, 0x0a, 0x44, 0x60, 0x0f,
-		0x0d, 0xb9, 0x9e, 0xf1, 0x85, 0x5c, 0xa9, 0xa1, 0xf1, 0x0e, 0x23, 0x32, 0x20, 0xe6, 0x2a, 0x07,
-		0x62, 0x2e, 0x18, 0xc1, 0x83, 0x21, 0x41, 0xd7, 0x77, 0xf9, 0x83, 0xae, 0xf4, 0xd0, 0xb8, 0x8d,
-		0xaf, 0xf7, 0xa0, 0xa8, 0xeb, 0x69, 0x37, 0xea, 0xca, 0x0c, 0x0d, 0x1b, 0xf9, 0x1c, 0x7a, 0xc3,
-		0xae, 0xf5, 0xbe, 0xb0, 0x2b, 0xcb, 0xff, 0x4c, 0xc8, 0x30, 0x12, 0x23, 0xe2, 0xae, 0xf5, 0xbe,
-		0xb8, 0x2b, 0x37, 0x82, 0xe0, 0x88, 0xc0, 0xeb, 0xff, 0x1b, 0x1c, 0x78, 0x0d, 0x0f, 0x8d, 0xf8,
-		0x30, 0xc7, 0x8b, 0xbc, 0xd4, 0x21, 0x91, 0x17, 0x8b, 0x8e, 0x1e, 0x1a, 0x4a, 0x7e, 0xec, 0xd0,
-		0x6b, 0x73, 0x40, 0xe8, 0xc5, 0x82, 0xa4, 0x33, 0x43, 0x89, 0x8f, 0x11, 0x7b, 0x6d, 0x0e, 0x88,
-		0xbd, 0xd0, 0x48, 0xb2, 0x47, 0x09, 0xbe, 0x62, 0x52, 0x5c, 0x3e, 0x4b, 0x5c, 0xdf, 0x1e, 0x3d,
-		0x45, 0xfc, 0x07, 0xdc, 0xe9, 0x58, 0x1d, 0x71, 0xb3, 0x96, 0x16, 0xe4, 0x33, 0xc4, 0x19, 0xf7,
-		0x74, 0xd2, 0x21, 0x81, 0x1a, 0xf5, 0xd3, 0x7c, 0x7a, 0x48, 0xfe, 0x5c, 0xc8, 0xc3, 0xa5, 0x3e,
-		0xac, 0xdf, 0x91, 0x4f, 0x71, 0x47, 0xde, 0x17, 0xbe, 0x85, 0x83, 0xe1, 0xdb, 0x1c, 0xa4, 0x89,
-		0xff, 0xd5, 0x13, 0x99, 0x69, 0x6d, 0x37, 0x32, 0x13, 0x57, 0x0a, 0x58, 0x90, 0xc7, 0xcd, 0x0a,
-		0x3b, 0xc9, 0xc9, 0xbb, 0xd7, 0x2b, 0x58, 0x4c, 0x81, 0x1e, 0x81, 0x29, 0x1f, 0xac, 0xeb, 0xd7,
-		0xb1, 0x30, 0x45, 0x72, 0xa1, 0x8b, 0xdc, 0xc1, 0xfb, 0x97, 0x21, 0x8f, 0x43, 0x5e, 0x48, 0x37,
-		0x28, 0xfa, 0x0a, 0xbd, 0x49, 0xd1, 0x57, 0xf8, 0xae, 0xa3, 0x2f, 0xbf, 0x9f, 0x1a, 0x09, 0xfa,
-		0xa9, 0xff, 0x23, 0xe4, 0xad, 0x89, 0x1b, 0x4b, 0xe9, 0x56, 0x03, 0x73, 0xcf, 0x91, 0xfe, 0x26,
-		0x4e, 0x45, 0xd3, 0xda, 0xe1, 0xfe, 0x21, 0xf9, 0x49, 0xa0, 0x5c, 0xc3, 0x91, 0xe2, 0x76, 0xc1,
-		0x75, 0x3a, 0x63, 0xfe, 0xfb, 0xbf, 0xfc, 0x52, 0x6c, 0xdc, 0xbb, 0x14, 0xeb, 0x7e, 0xbb, 0x96,
-		0xf0, 0x7d, 0xbb, 0x86, 0x9e, 0x82, 0x14, 0xcd, 0x88, 0xaa, 0x56, 0x5b, 0xfc, 0x51, 0x99, 0x53,
-		0xc3, 0x2f, 0xc4, 0xda, 0xf4, 0xaa, 0x1e, 0xbb, 0x44, 0xeb, 0x79, 0x0c, 0xa9, 0x80, 0xc7, 0x70,
-		0x0f, 0xa4, 0xc8, 0xe8, 0xd9, 0xe3, 0xe8, 0xc0, 0x3f, 0x7c, 0x14, 0x15, 0xf2, 0x7b, 0x00, 0xf5,
-		0x1b, 0x09, 0x74, 0x15, 0xe2, 0x78, 0x8f, 0x3e, 0xca, 0xc7, 0x2e, 0x1a, 0x1e, 0xef, 0x77, 0x4d,
-		0x49, 0x73, 0x69, 0x86, 0x30, 0xf9, 0xab, 0xaf, 0xcd, 0x49, 0x0c, 0xfa, 0x61, 0xf7, 0x9e, 0xbf,
-		0xc2, 0xf1, 0xe5, 0x3f, 0x0a, 0x93, 0x00, 0x26, 0x60, 0x40, 0x06, 0xf2, 0x76, 0xd0, 0x11, 0xe1,
-		0x78, 0xfc, 0x9e, 0x05, 0xd8, 0xd1, 0x6c, 0xf5, 0x96, 0x66, 0x3a, 0xb8, 0xc1, 0x99, 0xee, 0xab,
-		0x41, 0x05, 0x48, 0x92, 0x52, 0xd7, 0xc6, 0x0d, 0x1e, 0x46, 0xbb, 0x65, 0xdf, 0x3c, 0x13, 0xdf,
-		0xda, 0x3c, 0x83, 0x5c, 0x4e, 0xf6, 0x70, 0xd9, 0x17, 0x5c, 0xa4, 0xfc, 0xc1, 0x05, 0xbb, 0x18,
-		0xcc, 0xef, 0x27, 0x02, 0x1b, 0x9b, 0x28, 0xa3, 0xfb, 0x21, 0xdb, 0xc2, 0xad, 0xb6, 0x65, 0x35,
-		0x55, 0xa6, 0x6e, 0xd8, 0x5f, 0x41, 0xc8, 0xf0, 0xca, 0x0a, 0xd5, 0x3a, 0x3f, 0x14, 0xf6, 0xf6,
-		0x9f, 0x17, 0x44, 0x7e, 0xc7, 0x31, 0x98, 0x3e, 0x1b, 0xdd, 0xeb, 0x22, 0xa0, 0x9a, 0xff, 0xd6,
-		0x48, 0x97, 0xaa, 0x05, 0x21, 0xd0, 0xe3, 0xea, 0x0f, 0xef, 0x76, 0x09, 0xab, 0xb6, 0xd1, 0x0b,
-		0x70, 0xa2, 0x47, 0xb7, 0xb9, 0xa4, 0xc3, 0xe3, 0xaa, 0xb8, 0x63, 0x41, 0x15, 0x27, 0x48, 0x7b,
-		0xcc, 0x8a, 0x7c, 0x8b, 0xbb, 0xae, 0x0a, 0xb9, 0xa0, 0xc7, 0x33, 0x70, 0xf9, 0xe9, 0x5f, 0xdd,
-		0x71, 0x34, 0xc3, 0x54, 0x03, 0xe9, 0xa0, 0x0c, 0xab, 0xe4, 0x49, 0xa6, 0x0d, 0x38, 0x36, 0xd0,
-		0xf3, 0x41, 0x4f, 0x42, 0xca, 0x73, 0x9a, 0x18, 0x57, 0x0f, 0x49, 0x17, 0x78, 0xb0, 0xf2, 0x6f,
-		0x84, 0x3c, 0x92, 0xc1, 0x04, 0x44, 0x05, 0xe2, 0xec, 0xda, 0x1b, 0xbf, 0x4c, 0xf3, 0xc8, 0x78,
-		0x3e, 0xd3, 0x02, 0xbb, 0x13, 0xa7, 0x70, 0x64, 0xf9, 0x3d, 0x10, 0x67, 0x35, 0x28, 0x0d, 0x09,
-		0xef, 0xdd, 0x5c, 0x80, 0x78, 0xb1, 0x5c, 0xae, 0x6c, 0x88, 0x47, 0x30, 0x4b, 0xeb, 0x4a, 0x5d,
-		0x0a, 0x93, 0x6a, 0xa5, 0x72, 0xad, 0x52, 0xae, 0x4b, 0x11, 0x34, 0x09, 0x59, 0xf6, 0x5b, 0xbd,
-		0xb2, 0xae, 0xac, 0x16, 0xeb, 0x52, 0xd4, 0x57, 0x55, 0xab, 0xac, 0x2d, 0x55, 0x14, 0x29, 0x26,
-		0x3f, 0x06, 0x27, 0x87, 0x7a, 0x59, 0x5e, 0x76, 0x21, 0xe4, 0xcb, 0x2e, 0xc8, 0xaf, 0x86, 0xa1,
-		0x30, 0xdc, 0x75, 0x42, 0xd7, 0x7a, 0x26, 0x7e, 0xe1, 0x08, 0x7e, 0x57, 0xcf, 0xec, 0xd1, 0x03,
-		0x90, 0xeb, 0xe0, 0x6d, 0xec, 0xe8, 0xbb, 0xcc, 0x95, 0x63, 0x26, 0x33, 0xab, 0x64, 0x79, 0x2d,
-		0x45, 0xb2, 0x19, 0xd8, 0x4b, 0x58, 0x77, 0x54, 0xa6, 0x8b, 0x6c, 0xfe, 0x87, 0x41, 0xb3, 0xac,
-		0xb6, 0xc6, 0x2a, 0xe5, 0xef, 0x3d, 0x12, 0x2f, 0x53, 0x10, 0x53, 0x2a, 0x75, 0xe5, 0x05, 0x29,
-		0x82, 0x10, 0xe4, 0xe8, 0x4f, 0xb5, 0xb6, 0x56, 0xdc, 0xa8, 0x5d, 0x5d, 0x27, 0xbc, 0x9c, 0x82,
-		0xbc, 0xe0, 0xa5, 0xa8, 0x8c, 0xc9, 0x0f, 0xc1, 0x89, 0x21, 0x7e, 0xdf, 0x80, 0x7b, 0x88, 0x1f,
-		0x09, 0xf9, 0xa1, 0x83, 0x31, 0xff, 0x3a, 0xc4, 0x6d, 0x47, 0x73, 0xba, 0x36, 0x67, 0xe2, 0x93,
-		0xe3, 0x3a, 0x82, 0x0b, 0xe2, 0x47, 0x8d, 0xa2, 0x2b, 0x9c, 0x8c, 0x7c, 0x11, 0x72, 0xc1, 0x96,
-		0xe1, 0x3c, 0xf0, 0x84, 0x28, 0x2c, 0xbf, 0x00, 0xe0, 0xcb, 0x47, 0xba, 0x37, 0xba, 0x42, 0xfe,
-		0x1b, 0x5d, 0x17, 0x21, 0xb6, 0x67, 0x31, 0x9d, 0x31, 0x78, 0xe3, 0xdc, 0xb0, 0x1c, 0xec, 0x4b,
-		0x3e, 0x30, 0x68, 0xd9, 0x00, 0xd4, 0x9f, 0x13, 0x1a, 0xd2, 0xc5, 0x33, 0xc1, 0x2e, 0xee, 0x1b,
-		0x9a, 0x5d, 0x1a, 0xdc, 0xd5, 0xcb, 0x10, 0xa3, 0xda, 0x66, 0xe0, 0x27, 0x3e, 0xdf, 0x03, 0xa0,
-		0x39, 0x4e, 0xc7, 0xd8, 0xea, 0x7a, 0x1d, 0xcc, 0x0d, 0xd6, 0x56, 0x45, 0x01, 0x57, 0xba, 0x87,
-		0xab, 0xad, 0x69, 0x0f, 0xd5, 0xa7, 0xba, 0x7c, 0x04, 0xe5, 0x35, 0xc8, 0x05, 0x71, 0x07, 0x7f,
-		0xb2, 0xe4, 0x7d, 0xfa, 0x9f, 0x12, 0xee, 0x93, 0xeb, 0x7c, 0xf1, 0xe7, 0x38, 0x68, 0x41, 0xbe,
-		0x1d, 0x82, 0x64, 0x7d, 0x9f, 0xcb, 0xf1, 0x21, 0x97, 0x2a, 0xbd, 0xef, 0xb6, 0xdc, 0x64, 0x21,
-		0xcb, 0xc7, 0x46, 0xdc, 0x2c, 0xef, 0x77, 0xb9, 0x3b, 0x35, 0x3a, 0x6e, 0xb4, 0x2b, 0xb2, 0xdd,
-		0x5c, 0x3b, 0x5d, 0x1e, 0xef, 0x1b, 0x89, 0x69, 0x88, 0xf9, 0xbf, 0x6f, 0x60, 0x05, 0xb9, 0xe1,
-		0xbb, 0x96, 0xc0, 0xcc, 0x86, 0xff, 0x63, 0x8a, 0xd0, 0x91, 0x3f, 0xa6, 0x70, 0x7b, 0x09, 0xfb,
-		0x7b, 0xd9, 0x83, 0xa4, 0x10, 0x0a, 0xf4, 0x2e, 0xff, 0xdd, 0x13, 0x71, 0x46, 0x33, 0xd4, 0x78,
-		0x72, 0xf2, 0xbe, 0xab, 0x27, 0xe7, 0x60, 0x92, 0x5f, 0xb9, 0xf3, 0xe2, 0x0a, 0xfe, 0x17, 0x03,
-		0xf2, 0xac, 0x61, 0x45, 0x04, 0x15, 0xf2, 0x2f, 0x84, 0x40, 0xea, 0x95, 0xca, 0xb7, 0x73, 0x00,
-		0x44, 0x29, 0x12, 0xe9, 0xf7, 0x3d, 0xd9, 0xcd, 0x56, 0x3e, 0x4b, 0x6a, 0xbd, 0x47, 0xbb, 0xdf,
-		0x17, 0x86, 0xb4, 0x2f, 0xa7, 0x87, 0x9e, 0x08, 0x5c, 0x01, 0x9d, 0x3f, 0x2c, 0xff, 0xe7, 0xbb,
-		0x03, 0x1a, 0x98, 0x58, 0xf8, 0xe8, 0x13, 0x7b, 0xf3, 0x2f, 0xe9, 0x0f, 0xfe, 0xda, 0x27, 0x36,
-		0xe4, 0x6b, 0x9f, 0x1f, 0x08, 0x41, 0xd2, 0x35, 0xdd, 0x47, 0xcd, 0xe6, 0x1f, 0x87, 0x38, 0xb7,
-		0x4e, 0x2c, 0x9d, 0xcf, 0x4b, 0x03, 0x73, 0xa1, 0x05, 0x48, 0x8a, 0xbf, 0xf3, 0xc4, 0x03, 0x51,
-		0xb7, 0x7c, 0xee, 0x69, 0x48, 0xfb, 0x0e, 0x56, 0x88, 0x9e, 0x58, 0xab, 0x3c, 0x27, 0x4d, 0x14,
-		0x12, 0xb7, 0xef, 0xcc, 0x47, 0xd6, 0xf0, 0x2d, 0xb2, 0xc3, 0x94, 0x4a, 0xf9, 0x6a, 0xa5, 0x7c,
-		0x5d, 0x0a, 0x15, 0xd2, 0xb7, 0xef, 0xcc, 0x27, 0x14, 0x4c, 0xd3, 0x57, 0xe7, 0xae, 0x43, 0xbe,
-		0x67, 0x61, 0x82, 0xfa, 0x1d, 0x41, 0x6e, 0x69, 0x73, 0x63, 0xa5, 0x5a, 0x2e, 0xd6, 0x2b, 0x2a,
-		0xbb, 0x4e, 0x87, 0x4e, 0xc0, 0xd4, 0x4a, 0x75, 0xf9, 0x6a, 0x5d, 0x2d, 0xaf, 0x54, 0x2b, 0x6b,
-		0x75, 0xb5, 0x58, 0xaf, 0x17, 0xcb, 0xd7, 0xa5, 0xf0, 0x85, 0x6f, 0x02, 0xe4, 0x8b, 0xa5, 0x72,
-		0x95, 0xd8, 0x67, 0x83, 0xbf, 0xba, 0x5e, 0x86, 0x28, 0x4d, 0x05, 0x1c, 0x7a, 0x55, 0xa4, 0x70,
-		0x78, 0x6e, 0x13, 0x5d, 0x81, 0x18, 0xcd, 0x12, 0xa0, 0xc3, 0xef, 0x8e, 0x14, 0x46, 0x24, 0x3b,
-		0xc9, 0x60, 0xe8, 0x76, 0x3a, 0xf4, 0x32, 0x49, 0xe1, 0xf0, 0xdc, 0x27, 0x52, 0x20, 0xe5, 0x45,
-		0x19, 0xa3, 0x2f, 0x57, 0x14, 0xc6, 0xd0, 0x8e, 0x68, 0x05, 0x12, 0x22, 0x30, 0x1c, 0x75, 0xdd,
-		0xa3, 0x30, 0x32, 0x39, 0x49, 0xd8, 0xc5, 0x02, 0xf8, 0xc3, 0xef, 0xae, 0x14, 0x46, 0x64, 0x5a,
-		0x51, 0xd5, 0xbd, 0x84, 0x3f, 0xe2, 0x0a, 0x47, 0x61, 0x54, 0xb2, 0x91, 0x30, 0xcd, 0x4b, 0x8d,
-		0x8c, 0xbe, 0x91, 0x53, 0x18, 0x23, 0x89, 0x8c, 0x36, 0x01, 0x7c, 0xe1, 0xfa, 0x18, 0x57, 0x6d,
-		0x0a, 0xe3, 0x24, 0x87, 0xd1, 0x3a, 0x24, 0xdd, 0xe8, 0x69, 0xe4, 0xc5, 0x97, 0xc2, 0xe8, 0x2c,
-		0x2d, 0x7a, 0x0f, 0x64, 0x83, 0x51, 0xc3, 0x78, 0xd7, 0x59, 0x0a, 0x63, 0xa6, 0x5f, 0x09, 0xfd,
-		0x60, 0x08, 0x31, 0xde, 0xf5, 0x96, 0xc2, 0x98, 0xd9, 0x58, 0xf4, 0x12, 0x4c, 0xf6, 0xbb, 0xf8,
-		0xe3, 0xdf, 0x76, 0x29, 0x1c, 0x21, 0x3f, 0x8b, 0x5a, 0x80, 0x06, 0x84, 0x06, 0x47, 0xb8, 0xfc,
-		0x52, 0x38, 0x4a, 0xba, 0x16, 0x35, 0x20, 0xdf, 0xeb, 0x6f, 0x8f, 0x7b, 0x19, 0xa6, 0x30, 0x76,
-		0xea, 0x96, 0xf5, 0x12, 0xf4, 0xd3, 0xc7, 0xbd, 0x1c, 0x53, 0x18, 0x3b, 0x93, 0x5b, 0x2a, 0x0e,
-		0xbd, 0xdf, 0x78, 0xfa, 0xd0, 0xfb, 0x8d, 0xde, 0x8d, 0x45, 0xf7, 0x4e, 0xe3, 0x0f, 0x3e, 0x0e,
-		0xef, 0xe0, 0x8f, 0x09, 0xd8, 0x8e, 0x76, 0xd3, 0x30, 0x77, 0xdc, 0xd7, 0x21, 0x78, 0x99, 0x5f,
-		0x6e, 0x3c, 0xce, 0x1f, 0x2c, 0x10, 0xb5, 0x23, 0xde, 0x88, 0x18, 0xfa, 0xee, 0xd5, 0xa8, 0x4b,
-		0xc8, 0xa3, 0xaf, 0x2e, 0x1e, 0xf2, 0xfe, 0xc4, 0x88, 0x57, 0x2e, 0x06, 0xbc, 0x4f, 0x31, 0xe2,
-		0x12, 0xe6, 0x61, 0xf7, 0x3d, 0xe5, 0x9f, 0x08, 0x41, 0xee, 0xaa, 0x61, 0x3b, 0x56, 0xc7, 0xd0,
-		0xb5, 0x26, 0xb5, 0x18, 0x97, 0xc7, 0xfd, 0xc8, 0xa3, 0x94, 0x22, 0xce, 0x08, 0x7f, 0xd4, 0x82,
-		0x5f, 0x0c, 0x59, 0x82, 0xf8, 0x9e, 0xd6, 0x64, 0x9f, 0x58, 0xf8, 0x9f, 0x9f, 0xe9, 0xe5, 0xb9,
-		0xcf, 0x4b, 0xf2, 0x53, 0x61, 0xb8, 0xf2, 0x27, 0xe9, 0x25, 0xee, 0x56, 0xcb, 0xb0, 0xd9, 0x5f,
-		0x62, 0x77, 0xb0, 0x8d, 0x36, 0x20, 0xda, 0xd1, 0x1c, 0x1e, 0xd4, 0x94, 0xde, 0xc9, 0x9f, 0xa2,
-		0x78, 0x70, 0xf4, 0x83, 0x12, 0x0b, 0xfd, 0xaf, 0x55, 0x50, 0x4a, 0xe8, 0x39, 0x48, 0xb6, 0xb4,
-		0x7d, 0x95, 0x52, 0x0d, 0xbf, 0x09, 0x54, 0x13, 0x2d, 0x6d, 0x9f, 0x8c, 0x95, 0xec, 0x20, 0x42,
-		0x58, 0xdf, 0xd5, 0xcc, 0x1d, 0xcc, 0xe8, 0x47, 0xde, 0x04, 0xfa, 0xd9, 0x96, 0xb6, 0x5f, 0xa6,
-		0x34, 0x49, 0x2f, 0x8b, 0xc9, 0x57, 0x3f, 0x3c, 0x37, 0x41, 0xef, 0x22, 0xff, 0x76, 0x88, 0xc7,
-		0xaf, 0x94, 0x5d, 0x48, 0x03, 0x49, 0x77, 0x4b, 0xb4, 0x7b, 0x91, 0xcb, 0x3f, 0x3d, 0x6c, 0x35,
-		0x7a, 0x98, 0x5d, 0xca, 0x92, 0x81, 0x7e, 0xf1, 0xb5, 0xb9, 0x10, 0x5b, 0x97, 0xbc, 0xde, 0xb3,
-		0x18, 0xd7, 0x20, 0xcd, 0x52, 0x68, 0x2a, 0xf5, 0x5b, 0xc3, 0x23, 0xfd, 0xd6, 0xac, 0xf0, 0x5b,
-		0x19, 0x41, 0x60, 0xd8, 0xa4, 0xdd, 0x37, 0x8f, 0x4f, 0x86, 0x20, 0xbd, 0xe4, 0x7b, 0x66, 0x6a,
-		0x06, 0x12, 0x2d, 0xcb, 0x34, 0x6e, 0xe2, 0x8e, 0x7b, 0x20, 0xc3, 0x8a, 0xc4, 0xbf, 0x64, 0x7f,
-		0xad, 0xc8, 0x39, 0x10, 0x4f, 0x30, 0x88, 0x32, 0xc1, 0xba, 0x85, 0xb7, 0x6c, 0x43, 0x70, 0x5d,
-		0x11, 0x45, 0x74, 0x16, 0x24, 0x1b, 0xeb, 0xdd, 0x8e, 0xe1, 0x1c, 0xa8, 0xba, 0x65, 0x3a, 0x9a,
-		0xee, 0xf0, 0xb4, 0x69, 0x5e, 0xd4, 0x97, 0x59, 0x35, 0x21, 0xd2, 0xc0, 0x8e, 0x66, 0x34, 0xd9,
-		0x7d, 0xaf, 0x94, 0x22, 0x8a, 0xbe, 0xe1, 0x7e, 0x3e, 0xe1, 0x8f, 0x0b, 0xcb, 0x20, 0x59, 0x6d,
-		0xdc, 0x09, 0x7c, 0xef, 0xc6, 0x64, 0x75, 0xe6, 0xf7, 0x3f, 0xf3, 0xc8, 0x34, 0x67, 0x3c, 0x3f,
-		0x7d, 0x67, 0x2f, 0x2b, 0x2b, 0x79, 0x81, 0x21, 0x3e, 0x84, 0x7b, 0x21, 0x70, 0x0c, 0xd3, 0xdd,
-		0xf2, 0xbe, 0xb7, 0x9f, 0xee, 0x63, 0x6e, 0xd1, 0x3c, 0x28, 0xcd, 0xfc, 0xae, 0x47, 0xda, 0x8b,
-		0x1b, 0xaf, 0xe3, 0x03, 0xff, 0x99, 0x0c, 0x25, 0x43, 0xdc, 0xf7, 0x97, 0x34, 0xa3, 0x29, 0xfe,
-		0xb8, 0x9b, 0xc2, 0x4b, 0x68, 0xd1, 0xcd, 0xc1, 0x44, 0x69, 0x2c, 0x24, 0x0f, 0x93, 0x91, 0x92,
-		0x65, 0x36, 0x82, 0xe9, 0x16, 0x54, 0x87, 0xb8, 0x63, 0xdd, 0xc4, 0x26, 0x67, 0xd2, 0x91, 0xe4,
-		0xbb, 0xff, 0xf1, 0x19, 0x4e, 0x0b, 0xed, 0x80, 0xd4, 0xc0, 0x4d, 0xbc, 0xc3, 0xbe, 0xd6, 0xda,
-		0xd5, 0x3a, 0x98, 0x7d, 0xc1, 0xf9, 0xad, 0xee, 0x9f, 0xbc, 0x4b, 0xb5, 0x46, 0x89, 0xa2, 0x8d,
-		0xe0, 0x43, 0x67, 0x09, 0x7e, 0x24, 0x3f, 0x64, 0xfe, 0x3e, 0xc9, 0xf4, 0xeb, 0xac, 0xc0, 0xc3,
-		0x68, 0x67, 0x41, 0xea, 0x9a, 0x5b, 0x96, 0x49, 0xff, 0x5e, 0x12, 0x8f, 0xae, 0x92, 0xec, 0xd8,
-		0xce, 0xad, 0xe7, 0xc7, 0x76, 0x1b, 0x90, 0xf3, 0x40, 0xe9, 0x2e, 0x4a, 0x1d, 0x75, 0x17, 0x65,
-		0x5d, 0x02, 0x04, 0x04, 0xad, 0x02, 0x78, 0xfb, 0xd4, 0x3d, 0xda, 0x1f, 0xb9, 0xe3, 0xfd, 0x93,
-		0xf1, 0x11, 0x40, 0x4d, 0x98, 0x6a, 0x19, 0xa6, 0x6a, 0xe3, 0xe6, 0xb6, 0xca, 0x39, 0x47, 0xe8,
-		0xa6, 0xdf, 0x84, 0x95, 0x9e, 0x6c, 0x19, 0x66, 0x0d, 0x37, 0xb7, 0x97, 0x5c, 0xb2, 0xe8, 0x9d,
-		0x70, 0xca, 0x63, 0x87, 0x65, 0xaa, 0xbb, 0x56, 0xb3, 0xa1, 0x76, 0xf0, 0xb6, 0xaa, 0xd3, 0xb7,
-		0x8f, 0x32, 0x94, 0x89, 0x27, 0x5c, 0x90, 0x75, 0xf3, 0xaa, 0xd5, 0x6c, 0x28, 0x78, 0xbb, 0x4c,
-		0x9a, 0xd1, 0xfd, 0xe0, 0xf1, 0x42, 0x35, 0x1a, 0xf6, 0x4c, 0x76, 0x3e, 0x72, 0x26, 0xaa, 0x64,
-		0xdc, 0xca, 0x6a, 0xc3, 0x5e, 0xcc, 0xbc, 0xff, 0xc3, 0x73, 0x13, 0x7c, 0xf7, 0x4e, 0xc8, 0x1b,
-		0xf4, 0xb5, 0x0e, 0xbe, 0xf1, 0xb0, 0x8d, 0x2e, 0x41, 0x4a, 0x13, 0x05, 0xf6, 0x9d, 0xc8, 0x21,
-		0x1b, 0xd7, 0x03, 0x65, 0xfa, 0xe0, 0x95, 0x3f, 0x9a, 0x0f, 0xc9, 0x3f, 0x1f, 0x82, 0xf8, 0xd2,
-		0x8d, 0x0d, 0xcd, 0xe8, 0xa0, 0x0a, 0x4c, 0x7a, 0x22, 0x3c, 0xae, 0x36, 0xf0, 0xa4, 0x5e, 0xa8,
-		0x83, 0xca, 0xb0, 0x8f, 0x68, 0x0f, 0x25, 0xd3, 0xfb, 0x79, 0x6d, 0xcf, 0xc4, 0xaf, 0x41, 0x82,
-		0x8d, 0xd2, 0x46, 0xcf, 0x42, 0xac, 0x4d, 0x7e, 0xf0, 0xc3, 0x80, 0xd9, 0xa1, 0xa2, 0x4f, 0xe1,
-		0xfd, 0x82, 0xc2, 0xf0, 0xe4, 0xbf, 0x0e, 0x01, 0x2c, 0xdd, 0xb8, 0x51, 0xef, 0x18, 0xed, 0x26,
-		0x76, 0xde, 0xac, 0x69, 0xaf, 0xc0, 0x31, 0xdf, 0xe7, 0x9a, 0x1d, 0x7d, 0xec, 0xa9, 0x4f, 0x79,
-		0x1f, 0x6c, 0x76, 0xf4, 0x81, 0xd4, 0x1a, 0xb6, 0xe3, 0x52, 0x8b, 0x8c, 0x4d, 0x6d, 0xc9, 0x76,
-		0x06, 0xf3, 0xf2, 0x79, 0x48, 0x7b, 0xd3, 0xb7, 0x51, 0x15, 0x92, 0x0e, 0xff, 0xcd, 0x59, 0x2a,
-		0x0f, 0x67, 0xa9, 0x40, 0xf3, 0xb3, 0xd5, 0x45, 0x97, 0xff, 0x86, 0x70, 0xd6, 0xdb, 0x1e, 0xdf,
-		0x56, 0x02, 0x45, 0xf4, 0x3e, 0xd7, 0xcb, 0x6f, 0x86, 0x5f, 0xc3, 0x69, 0xf5, 0xb0, 0xf6, 0xfd,
-		0x61, 0x98, 0xda, 0x14, 0xdb, 0xf7, 0xdb, 0x96, 0x13, 0x9b, 0x90, 0xc0, 0xa6, 0xd3, 0x31, 0xb0,
-		0x38, 0x01, 0x7c, 0x74, 0xd8, 0x82, 0x0f, 0x98, 0x0b, 0xfd, 0xa3, 0xd9, 0xfe, 0xe5, 0x17, 0xb4,
-		0x7a, 0x58, 0xf1, 0x5b, 0x11, 0x98, 0x19, 0x86, 0x8e, 0x4e, 0x43, 0x5e, 0xef, 0x60, 0x5a, 0xa1,
-		0x06, 0xb2, 0xe4, 0x39, 0x51, 0xcd, 0x0d, 0x8e, 0x02, 0xc4, 0x8d, 0x23, 0xd2, 0x45, 0x40, 0xef,
-		0xce, 0x6f, 0xcb, 0x79, 0x14, 0xa8, 0xc9, 0xc1, 0x90, 0x17, 0xd7, 0xde, 0xb7, 0xb4, 0xa6, 0x66,
-		0xea, 0x77, 0xe3, 0xe9, 0xf6, 0xdb, 0x07, 0x71, 0x97, 0xbe, 0xc4, 0x68, 0xa2, 0x1b, 0x90, 0x10,
-		0xe4, 0xa3, 0x6f, 0x02, 0x79, 0x41, 0x0c, 0xdd, 0x07, 0x19, 0xbf, 0xd9, 0xa0, 0x5e, 0x4c, 0x54,
-		0x49, 0xfb, 0xac, 0xc6, 0x28, 0xbb, 0x14, 0x3f, 0xd4, 0x2e, 0xf9, 0x9c, 0xc5, 0x7f, 0x1e, 0x81,
-		0x49, 0x05, 0x37, 0xbe, 0x03, 0x17, 0xef, 0xbb, 0x01, 0xd8, 0x06, 0x27, 0xca, 0xf7, 0x2e, 0xd6,
-		0xaf, 0x5f, 0x61, 0xa4, 0x18, 0xbd, 0x25, 0xdb, 0x79, 0x3b, 0x57, 0xf0, 0xdf, 0x85, 0x21, 0xe3,
-		0x5f, 0xc1, 0xef, 0x00, 0x6b, 0x87, 0xd6, 0x3c, 0xf5, 0xc6, 0x2e, 0xa8, 0x9f, 0x1d, 0xa6, 0xde,
-		0xfa, 0x64, 0x7b, 0x0c, 0xbd, 0xf6, 0xc1, 0x18, 0xc4, 0xf9, 0x8d, 0xb1, 0xf5, 0x3e, 0x6f, 0x38,
-		0x34, 0xea, 0x33, 0xe1, 0xac, 0xf8, 0x4c, 0x78, 0xa0, 0x33, 0xfc, 0x00, 0xe4, 0x48, 0x0c, 0x1e,
-		0xb8, 0x86, 0x16, 0x3a, 0x93, 0xa5, 0x41, 0xb4, 0x77, 0x69, 0x1a, 0xcd, 0x41, 0x9a, 0x80, 0x79,
-		0x3a, 0x9c, 0xc0, 0x40, 0x4b, 0xdb, 0xaf, 0xb0, 0x1a, 0xf4, 0x08, 0xa0, 0x5d, 0x37, 0x3f, 0xa2,
-		0x7a, 0xcc, 0x20, 0x70, 0x93, 0x5e, 0x8b, 0x00, 0xbf, 0x17, 0x80, 0x8c, 0x42, 0x65, 0x0f, 0x79,
-		0xf2, 0xd7, 0x55, 0x49, 0xcd, 0x12, 0x7d, 0xcc, 0xf3, 0xfb, 0x98, 0x4f, 0xdd, 0x13, 0x9e, 0xf3,
-		0xe8, 0x66, 0xe5, 0x68, 0x9b, 0xe2, 0x1b, 0xaf, 0xcd, 0x15, 0x0e, 0xb4, 0x56, 0x73, 0x51, 0x1e,
-		0x40, 0x52, 0xa6, 0x3e, 0x76, 0x30, 0xac, 0x47, 0x6d, 0xc8, 0x13, 0x50, 0x3a, 0x40, 0xfe, 0xa6,
-		0x68, 0x82, 0xf6, 0x7c, 0xf5, 0xc8, 0x3d, 0x1f, 0xf7, 0x7a, 0xf6, 0x91, 0x93, 0x95, 0x6c, 0xcb,
-		0x30, 0x49, 0xa0, 0x58, 0xa4, 0x65, 0xda, 0xa3, 0xb6, 0x1f, 0xe8, 0x31, 0xf9, 0x2d, 0xf6, 0x18,
-		0x24, 0x27, 0xd3, 0x05, 0xf5, 0xf5, 0x78, 0x2f, 0x00, 0x36, 0xe9, 0x5f, 0x6c, 0xc1, 0x7b, 0x2d,
-		0x1a, 0x52, 0x25, 0x95, 0x14, 0xab, 0xa9, 0xec, 0xb5, 0x16, 0xcf, 0x88, 0xed, 0x7c, 0xfb, 0xcb,
-		0x9f, 0x3a, 0x77, 0xca, 0xd7, 0xd5, 0xbe, 0x9b, 0x3b, 0x64, 0x12, 0x29, 0xff, 0x72, 0x08, 0x90,
-		0x67, 0x6b, 0xdd, 0x9b, 0xf2, 0xab, 0xf4, 0xfe, 0xb4, 0x08, 0x86, 0x42, 0x87, 0x07, 0x59, 0x1e,
-		0x7e, 0x20, 0xc8, 0xf2, 0xe9, 0x90, 0x77, 0x79, 0x96, 0x4d, 0x7c, 0x17, 0x3f, 0xe0, 0x15, 0xdb,
-		0x85, 0xb2, 0x65, 0x04, 0x48, 0x08, 0x24, 0x57, 0x3d, 0x4d, 0xc8, 0xaf, 0x85, 0xe0, 0x64, 0xdf,
-		0x26, 0x74, 0x87, 0xad, 0x03, 0xea, 0xf8, 0x1a, 0xa9, 0x20, 0x8b, 0xd3, 0xe7, 0xbb, 0xdb, 0xd3,
-		0x93, 0x9d, 0x3e, 0x6b, 0xf6, 0x16, 0x99, 0x69, 0xfe, 0x9e, 0xed, 0xef, 0x84, 0x60, 0xda, 0x3f,
-		0x22, 0x77, 0x6e, 0x35, 0xc8, 0xf8, 0xc7, 0xc2, 0x67, 0xf5, 0x8e, 0x71, 0x66, 0xe5, 0x9f, 0x50,
-		0x80, 0x08, 0x99, 0x8b, 0xd8, 0xec, 0x2c, 0x93, 0xf9, 0xd8, 0xd8, 0x5c, 0x72, 0x4f, 0x69, 0x06,
-		0x69, 0xc0, 0x28, 0x5d, 0xac, 0x1f, 0x0b, 0x43, 0x74, 0xc3, 0xb2, 0x9a, 0xe8, 0x07, 0x42, 0x30,
-		0x69, 0x5a, 0x0e, 0x15, 0x69, 0xdc, 0x50, 0x79, 0x36, 0x85, 0x19, 0x91, 0x1b, 0x47, 0xe3, 0xde,
-		0x57, 0x5f, 0x9b, 0xeb, 0x27, 0x35, 0xe8, 0x01, 0xe2, 0xbc, 0x69, 0x39, 0x25, 0x0a, 0x54, 0x67,
-		0x09, 0x97, 0x5b, 0x90, 0x0d, 0xf6, 0xcf, 0x2c, 0x8f, 0x72, 0xe4, 0xfe, 0xb3, 0x23, 0xfb, 0xce,
-		0x6c, 0xf9, 0x3a, 0x66, 0xcf, 0x80, 0xfe, 0x05, 0x59, 0xdc, 0x17, 0x40, 0xba, 0xd1, 0x7b, 0x6f,
-		0xaf, 0x02, 0x89, 0xa3, 0x5e, 0x01, 0xf4, 0x73, 0x9c, 0xe3, 0x9e, 0xfb, 0x6c, 0x08, 0xc0, 0xcb,
-		0x5d, 0xa1, 0x87, 0xe1, 0x44, 0x69, 0x7d, 0x6d, 0x49, 0xad, 0xd5, 0x8b, 0xf5, 0xcd, 0x5a, 0xf0,
-		0xd1, 0x79, 0xf1, 0x52, 0x8b, 0xdd, 0xc6, 0xba, 0xb1, 0x6d, 0xe0, 0x06, 0x7a, 0x10, 0xa6, 0x83,
-		0xd0, 0xa4, 0x54, 0x59, 0x92, 0x42, 0x85, 0xcc, 0xed, 0x3b, 0xf3, 0x49, 0xe6, 0x95, 0xe3, 0x06,
-		0x3a, 0x03, 0xc7, 0xfa, 0xe1, 0xaa, 0x6b, 0xcb, 0x52, 0xb8, 0x90, 0xbd, 0x7d, 0x67, 0x3e, 0xe5,
-		0xba, 0xef, 0x48, 0x06, 0xe4, 0x87, 0xe4, 0xf4, 0x22, 0x05, 0xb8, 0x7d, 0x67, 0x3e, 0xce, 0x96,
-		0xa5, 0x10, 0x7d, 0xff, 0x47, 0x67, 0x27, 0xce, 0x7d, 0x0f, 0x40, 0xd5, 0xdc, 0xee, 0x68, 0xf4,
-		0x2f, 0x2f, 0xa3, 0x02, 0x1c, 0xaf, 0xae, 0x5d, 0x51, 0x8a, 0xe5, 0x7a, 0x75, 0x7d, 0xad, 0xe7,
-		0xad, 0xfc, 0x60, 0xdb, 0xd2, 0xfa, 0x66, 0x69, 0xa5, 0xa2, 0xd6, 0xaa, 0xcb, 0x6b, 0xec, 0xfc,
-		0x3b, 0xd0, 0xf6, 0xdc, 0x5a, 0xbd, 0xba, 0x5a, 0x91, 0xc2, 0xa5, 0x2b, 0x43, 0x4f, 0x60, 0x1e,
-		0x3e, 0x74, 0xc1, 0x3d, 0x4d, 0x19, 0x38, 0x86, 0xf9, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x5c,
-		0x41, 0xea, 0x7b, 0x7f, 0x9b, 0x00, 0x00,
+		// 13107 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0xbd, 0x6b, 0x94, 0x63, 0xd7,
+		0x55, 0x20, 0xdc, 0x57, 0x52, 0xe9, 0xb1, 0xa5, 0x92, 0x6e, 0x9d, 0xaa, 0xee, 0x56, 0xab, 0xed,
+		0x6e, 0x59, 0x8e, 0xed, 0x76, 0xdb, 0xae, 0xb6, 0xdb, 0x76, 0xdb, 0x5d, 0x4e, 0xe2, 0xe8, 0xd5,
+		0xdd, 0x6a, 0x57, 0x49, 0x95, 0x2b, 0x55, 0xdb, 0xed, 0x40, 0x6e, 0x6e, 0x49, 0xb7, 0xaa, 0xe4,
+		0x96, 0xee, 0x55, 0x74, 0xaf, 0xca, 0x55, 0x59, 0x2c, 0x96, 0x21, 0x01, 0x42, 0xf3, 0x0a, 0x1f,
+		0x2c, 0x30, 0x8f, 0x86, 0x24, 0x40, 0xbe, 0xc0, 0x07, 0x7c, 0x10, 0xc2, 0xeb, 0xe3, 0x83, 0x81,
+		0x59, 0xc3, 0x0c, 0xb0, 0x66, 0x31, 0x81, 0xc5, 0x9a, 0x61, 0x58, 0x8c, 0x87, 0x09, 0x2c, 0xec,
+		0x84, 0x30, 0x3c, 0x26, 0xac, 0x81, 0x09, 0x90, 0x59, 0xfb, 0x3c, 0xee, 0x43, 0x8f, 0x92, 0xaa,
+		0xd3, 0xc9, 0xf2, 0x2c, 0xfe, 0x54, 0xe9, 0x9c, 0xb3, 0xf7, 0x3e, 0xe7, 0xec, 0xb3, 0xcf, 0x3e,
+		0x7b, 0xef, 0xf3, 0xb8, 0xf0, 0x4d, 0x2f, 0x41, 0x76, 0xdb, 0x34, 0xb7, 0x3b, 0xfa, 0xb9, 0x5e,
+		0xdf, 0xb4, 0xcd, 0xcd, 0xc1, 0xd6, 0xb9, 0x96, 0x6e, 0x35, 0xfb, 0xed, 0x9e, 0x6d, 0xf6, 0x97,
+		0x69, 0x1e, 0x49, 0x31, 0x88, 0x65, 0x01, 0x91, 0x7b, 0x17, 0x2c, 0x5c, 0x6a, 0x77, 0xf4, 0x92,
+		0x03, 0x58, 0xd7, 0x6d, 0xf2, 0x34, 0x84, 0xb6, 0xda, 0x1d, 0x3d, 0x2d, 0x65, 0x83, 0x67, 0xe2,
+		0xe7, 0xdf, 0xb2, 0x3c, 0x84, 0xb4, 0xec, 0xc7, 0x58, 0xc7, 0x6c, 0x85, 0x62, 0x9c, 0x4d, 0x44,
+		0x5f, 0xf9, 0xdc, 0xef, 0x7c, 0x51, 0x92, 0xbf, 0x0e, 0xff, 0xe6, 0x7e, 0x73, 0x0e, 0x16, 0xc7,
+		0xc0, 0x12, 0x02, 0x21, 0x43, 0xeb, 0x22, 0x7d, 0xe9, 0x4c, 0x4c, 0xa1, 0xbf, 0x49, 0x1a, 0x22,
+		0x3d, 0xad, 0x79, 0x43, 0xdb, 0xd6, 0xd3, 0x01, 0x9a, 0x2d, 0x92, 0xe4, 0x14, 0x40, 0x4b, 0xef,
+		0xe9, 0x46, 0x4b, 0x37, 0x9a, 0xfb, 0xe9, 0x60, 0x36, 0x78, 0x26, 0xa6, 0x78, 0x72, 0xc8, 0x43,
+		0xb0, 0xd0, 0x1b, 0x6c, 0x76, 0xda, 0x4d, 0xd5, 0x03, 0x06, 0xd9, 0xe0, 0x99, 0x39, 0x45, 0x66,
+		0x05, 0x25, 0x17, 0xf8, 0x01, 0x48, 0xbd, 0xac, 0x6b, 0x37, 0xbc, 0xa0, 0x71, 0x0a, 0x9a, 0xc4,
+		0xec, 0x92, 0x8f, 0xaa, 0xd9, 0xb3, 0xdb, 0xa6, 0xe1, 0x05, 0x4d, 0xd1, 0xca, 0x65, 0x56, 0xe0,
+		0x01, 0x2e, 0x42, 0xa2, 0xab, 0x5b, 0x96, 0xb6, 0xad, 0xab, 0xf6, 0x7e, 0x4f, 0x4f, 0x87, 0x28,
+		0xe3, 0xb2, 0x23, 0x8c, 0x1b, 0x66, 0x5a, 0x9c, 0x63, 0x35, 0xf6, 0x7b, 0x3a, 0xc9, 0x43, 0x4c,
+		0x37, 0x06, 0x5d, 0x46, 0x61, 0x6e, 0x02, 0xeb, 0xcb, 0xc6, 0xa0, 0x3b, 0x4c, 0x25, 0x8a, 0x68,
+		0x9c, 0x44, 0xc4, 0xd2, 0xfb, 0xbb, 0xed, 0xa6, 0x9e, 0x0e, 0x53, 0x02, 0x0f, 0x8c, 0x10, 0xa8,
+		0xb3, 0xf2, 0x61, 0x1a, 0x02, 0x8f, 0x14, 0x21, 0xa6, 0xef, 0xd9, 0xba, 0x61, 0xb5, 0x4d, 0x23,
+		0x1d, 0xa1, 0x44, 0xee, 0x1b, 0x23, 0x00, 0x7a, 0xa7, 0x35, 0x4c, 0xc2, 0xc5, 0x23, 0x17, 0x20,
+		0xc2, 0x78, 0x64, 0xa5, 0xa3, 0x59, 0xe9, 0x4c, 0xfc, 0xfc, 0x5d, 0x63, 0x65, 0xa8, 0xc6, 0x60,
+		0x14, 0x01, 0x4c, 0x2a, 0x20, 0x5b, 0xe6, 0xa0, 0xdf, 0xd4, 0xd5, 0xa6, 0xd9, 0xd2, 0xd5, 0xb6,
+		0xb1, 0x65, 0xa6, 0x63, 0x94, 0xc0, 0xe9, 0xd1, 0x8e, 0x50, 0xc0, 0xa2, 0xd9, 0xd2, 0x2b, 0xc6,
+		0x96, 0xa9, 0x24, 0x2d, 0x5f, 0x9a, 0x1c, 0x83, 0xb0, 0xb5, 0x6f, 0xd8, 0xda, 0x5e, 0x3a, 0x41,
+		0xc5, 0x89, 0xa7, 0xc8, 0x79, 0x88, 0xe8, 0xad, 0x36, 0x56, 0x97, 0x4e, 0x66, 0xa5, 0x33, 0xc9,
+		0xf3, 0xe9, 0x51, 0x1e, 0xb3, 0x72, 0x45, 0x00, 0xe6, 0xfe, 0x31, 0x0c, 0xa9, 0x59, 0x64, 0xf8,
+		0x19, 0x98, 0xdb, 0x42, 0xce, 0xa4, 0x03, 0x87, 0xe1, 0x1b, 0xc3, 0xf1, 0x33, 0x3e, 0x7c, 0x9b,
+		0x8c, 0xcf, 0x43, 0xdc, 0xd0, 0x2d, 0x5b, 0x6f, 0x31, 0x29, 0x0a, 0xce, 0x28, 0x87, 0xc0, 0x90,
+		0x46, 0xc5, 0x30, 0x74, 0x5b, 0x62, 0xf8, 0x02, 0xa4, 0x9c, 0x26, 0xa9, 0x7d, 0xcd, 0xd8, 0x16,
+		0xf2, 0x7c, 0x6e, 0x5a, 0x4b, 0x96, 0xcb, 0x02, 0x4f, 0x41, 0x34, 0x25, 0xa9, 0xfb, 0xd2, 0xa4,
+		0x04, 0x60, 0x1a, 0xba, 0xb9, 0xa5, 0xb6, 0xf4, 0x66, 0x27, 0x1d, 0x9d, 0xc0, 0xa5, 0x1a, 0x82,
+		0x8c, 0x70, 0xc9, 0x64, 0xb9, 0xcd, 0x0e, 0xb9, 0xe8, 0x8a, 0x67, 0x64, 0x82, 0x74, 0xad, 0xb1,
+		0x89, 0x39, 0x22, 0xa1, 0x1b, 0x90, 0xec, 0xeb, 0x38, 0x57, 0xf4, 0x16, 0xef, 0x59, 0x8c, 0x36,
+		0x62, 0x79, 0x6a, 0xcf, 0x14, 0x8e, 0xc6, 0x3a, 0x36, 0xdf, 0xf7, 0x26, 0xc9, 0xbd, 0xe0, 0x64,
+		0xa8, 0x54, 0xac, 0x80, 0x6a, 0x9a, 0x84, 0xc8, 0xac, 0xa2, 0x78, 0xe5, 0x01, 0x76, 0xdb, 0x56,
+		0x7b, 0xb3, 0xdd, 0x69, 0xdb, 0xa8, 0xb6, 0x50, 0x7a, 0xef, 0x19, 0x9d, 0x17, 0xfb, 0xdd, 0x4d,
+		0xb3, 0x73, 0xcd, 0x01, 0x54, 0x3c, 0x48, 0x99, 0xf7, 0x41, 0xd2, 0xcf, 0x61, 0xb2, 0x04, 0x73,
+		0x96, 0xad, 0xf5, 0x6d, 0x2a, 0xc8, 0x73, 0x0a, 0x4b, 0x10, 0x19, 0x82, 0xba, 0xd1, 0xa2, 0x9a,
+		0x78, 0x4e, 0xc1, 0x9f, 0xe4, 0x1d, 0x2e, 0xcf, 0x82, 0x94, 0x67, 0xf7, 0x8f, 0x0a, 0x85, 0x8f,
+		0xf2, 0x30, 0xeb, 0x32, 0x4f, 0xc1, 0xbc, 0x8f, 0x07, 0xb3, 0x56, 0x9d, 0xfb, 0x83, 0x10, 0x1c,
+		0x1d, 0x4b, 0x9b, 0xbc, 0x00, 0x4b, 0x03, 0xa3, 0x6d, 0xd8, 0x7a, 0xbf, 0xd7, 0xd7, 0x51, 0xea,
+		0x59, 0x5d, 0xe9, 0xd7, 0x23, 0x13, 0xe4, 0x76, 0xc3, 0x0b, 0xcd, 0xa8, 0x28, 0x8b, 0x83, 0xd1,
+		0x4c, 0x72, 0x1d, 0xe2, 0x28, 0x62, 0x5a, 0x5f, 0xa3, 0x04, 0xd9, 0x84, 0x3e, 0x3f, 0x5b, 0x97,
+		0x97, 0x4b, 0x2e, 0x66, 0x21, 0xf8, 0x41, 0x29, 0xa0, 0x78, 0x69, 0x91, 0xa7, 0x20, 0xba, 0xa5,
+		0x6b, 0xf6, 0xa0, 0xaf, 0x5b, 0xe9, 0xf3, 0x94, 0x95, 0x27, 0x47, 0xe7, 0x39, 0x03, 0xa8, 0xeb,
+		0xb6, 0xe2, 0x00, 0x93, 0x2e, 0x24, 0x76, 0xf5, 0x7e, 0x7b, 0xab, 0xdd, 0x64, 0x8d, 0x0a, 0x52,
+		0x09, 0x78, 0x7a, 0xc6, 0x46, 0x5d, 0xf3, 0xa0, 0xd6, 0x6d, 0xcd, 0xd6, 0x57, 0x60, 0xa3, 0x7a,
+		0xad, 0xac, 0x54, 0x2e, 0x55, 0xca, 0x25, 0xd6, 0x4c, 0x1f, 0xf9, 0xcc, 0x77, 0x4b, 0x10, 0xf7,
+		0xf4, 0x04, 0x35, 0xaa, 0x31, 0xe8, 0x6e, 0xea, 0x7d, 0x3e, 0x5e, 0x3c, 0x45, 0x4e, 0x42, 0x6c,
+		0x6b, 0xd0, 0xe9, 0x30, 0xb9, 0x65, 0x6b, 0x77, 0x14, 0x33, 0xa8, 0xcc, 0x12, 0x08, 0x71, 0x4d,
+		0x44, 0xd5, 0x24, 0xfe, 0x26, 0x19, 0x88, 0x0a, 0xb9, 0x4e, 0xcf, 0x65, 0xa5, 0x33, 0x51, 0xc5,
+		0x49, 0xb3, 0xb2, 0x9e, 0xae, 0xd9, 0x7a, 0x2b, 0x1d, 0x16, 0x65, 0x2c, 0x7d, 0x35, 0x14, 0x0d,
+		0xc9, 0x73, 0xb9, 0x27, 0x60, 0x61, 0xa4, 0x2b, 0x24, 0x05, 0xf1, 0x52, 0xb9, 0xb8, 0x9a, 0x57,
+		0xf2, 0x8d, 0x4a, 0xad, 0x2a, 0x1f, 0x21, 0x49, 0xf0, 0xf4, 0x4e, 0x96, 0xce, 0x86, 0xa3, 0xaf,
+		0x45, 0xe4, 0xd7, 0x23, 0x67, 0x63, 0xd1, 0x37, 0x22, 0xf2, 0x2b, 0xaf, 0xbc, 0xf2, 0x4a, 0x20,
+		0xf7, 0x1b, 0x61, 0x58, 0x1a, 0xa7, 0x4f, 0xc7, 0xaa, 0x76, 0xb7, 0xf3, 0x41, 0x5f, 0xe7, 0xf3,
+		0x30, 0xd7, 0xd1, 0x36, 0xf5, 0x4e, 0x3a, 0x44, 0x07, 0xe3, 0xa1, 0x99, 0x34, 0xf6, 0xf2, 0x2a,
+		0xa2, 0x28, 0x0c, 0x93, 0xbc, 0x9d, 0xb3, 0x68, 0x8e, 0x52, 0x38, 0x3b, 0x1b, 0x05, 0xd4, 0xb3,
+		0x9c, 0x9d, 0x27, 0x21, 0x86, 0xff, 0x19, 0xff, 0xc3, 0x8c, 0xff, 0x98, 0x41, 0xf9, 0x9f, 0x81,
+		0x28, 0x55, 0xa1, 0x2d, 0xdd, 0x19, 0x1b, 0x91, 0x46, 0xa5, 0xd3, 0xd2, 0xb7, 0xb4, 0x41, 0xc7,
+		0x56, 0x77, 0xb5, 0xce, 0x40, 0xa7, 0xca, 0x30, 0xa6, 0x24, 0x78, 0xe6, 0x35, 0xcc, 0x23, 0xa7,
+		0x21, 0xce, 0x34, 0x6e, 0xdb, 0x68, 0xe9, 0x7b, 0x74, 0x35, 0x9e, 0x53, 0x98, 0x12, 0xae, 0x60,
+		0x0e, 0x56, 0xff, 0x92, 0x65, 0x1a, 0x42, 0x6d, 0xd1, 0x2a, 0x30, 0x83, 0x56, 0xff, 0xd4, 0xb0,
+		0x21, 0x70, 0xf7, 0xf8, 0xee, 0x8d, 0xe8, 0xd9, 0x07, 0x20, 0x45, 0x21, 0x1e, 0xe7, 0x53, 0x5a,
+		0xeb, 0xa4, 0x17, 0xa8, 0x38, 0x24, 0x59, 0x76, 0x8d, 0xe7, 0xe6, 0x7e, 0x21, 0x00, 0x21, 0xba,
+		0xe8, 0xa4, 0x20, 0xde, 0xb8, 0xbe, 0x5e, 0x56, 0x4b, 0xb5, 0x8d, 0xc2, 0x6a, 0x59, 0x96, 0x50,
+		0x04, 0x68, 0xc6, 0xa5, 0xd5, 0x5a, 0xbe, 0x21, 0x07, 0x9c, 0x74, 0xa5, 0xda, 0xb8, 0xf0, 0x84,
+		0x1c, 0x74, 0x10, 0x36, 0x58, 0x46, 0xc8, 0x0b, 0xf0, 0xf8, 0x79, 0x79, 0x8e, 0xc8, 0x90, 0x60,
+		0x04, 0x2a, 0x2f, 0x94, 0x4b, 0x17, 0x9e, 0x90, 0xc3, 0xfe, 0x9c, 0xc7, 0xcf, 0xcb, 0x11, 0x32,
+		0x0f, 0x31, 0x9a, 0x53, 0xa8, 0xd5, 0x56, 0xe5, 0xa8, 0x43, 0xb3, 0xde, 0x50, 0x2a, 0xd5, 0xcb,
+		0x72, 0xcc, 0xa1, 0x79, 0x59, 0xa9, 0x6d, 0xac, 0xcb, 0xe0, 0x50, 0x58, 0x2b, 0xd7, 0xeb, 0xf9,
+		0xcb, 0x65, 0x39, 0xee, 0x40, 0x14, 0xae, 0x37, 0xca, 0x75, 0x39, 0xe1, 0x6b, 0xd6, 0xe3, 0xe7,
+		0xe5, 0x79, 0xa7, 0x8a, 0x72, 0x75, 0x63, 0x4d, 0x4e, 0x92, 0x05, 0x98, 0x67, 0x55, 0x88, 0x46,
+		0xa4, 0x86, 0xb2, 0x2e, 0x3c, 0x21, 0xcb, 0x6e, 0x43, 0x18, 0x95, 0x05, 0x5f, 0xc6, 0x85, 0x27,
+		0x64, 0x92, 0x2b, 0xc2, 0x1c, 0x15, 0x43, 0x42, 0x20, 0xb9, 0x9a, 0x2f, 0x94, 0x57, 0xd5, 0xda,
+		0x3a, 0x4e, 0x9e, 0xfc, 0xaa, 0x2c, 0xb9, 0x79, 0x4a, 0x79, 0xbd, 0x9c, 0x6f, 0x94, 0x4b, 0x72,
+		0xd0, 0x9b, 0xf7, 0xce, 0x8d, 0x8a, 0x52, 0x2e, 0xc9, 0x81, 0x5c, 0x13, 0x96, 0xc6, 0x2d, 0xb6,
+		0x63, 0xa7, 0x90, 0x47, 0x16, 0x02, 0x13, 0x64, 0x81, 0xd2, 0x1a, 0x96, 0x85, 0xdc, 0xc7, 0x82,
+		0xb0, 0x38, 0xc6, 0xe0, 0x18, 0x5b, 0xc9, 0xb3, 0x30, 0xc7, 0x64, 0x99, 0x69, 0xec, 0x07, 0xc7,
+		0x5a, 0x2e, 0x54, 0xb2, 0x47, 0xcc, 0x30, 0x8a, 0xe7, 0x35, 0x5d, 0x83, 0x13, 0x4c, 0x57, 0x24,
+		0x31, 0x22, 0xb0, 0x5f, 0x3d, 0x62, 0x18, 0x30, 0xdb, 0xe9, 0xc2, 0x2c, 0xb6, 0x13, 0xcd, 0x3b,
+		0x9c, 0x81, 0x30, 0x37, 0xd5, 0x40, 0x08, 0xdf, 0x8e, 0x81, 0xf0, 0x0c, 0x2c, 0x8c, 0xb4, 0x65,
+		0xe6, 0x85, 0xfa, 0xfd, 0x12, 0xa4, 0x27, 0xf1, 0x77, 0x8a, 0x56, 0x0d, 0xf8, 0xb4, 0xea, 0x33,
+		0xc3, 0x83, 0x70, 0xcf, 0xe4, 0x71, 0x1c, 0x11, 0x97, 0x9f, 0x97, 0xe0, 0xd8, 0x78, 0x2f, 0x67,
+		0x6c, 0x1b, 0xde, 0x0e, 0xe1, 0xae, 0x6e, 0xef, 0x98, 0xc2, 0x6a, 0xbf, 0x7f, 0x8c, 0x2d, 0x88,
+		0xc5, 0xc3, 0xf2, 0xc2, 0xb1, 0xc8, 0xc5, 0xe1, 0xb6, 0x9e, 0x9e, 0xe4, 0x73, 0x0d, 0xb7, 0x94,
+		0x2d, 0x68, 0x4a, 0xd8, 0xb2, 0xfb, 0xba, 0xd6, 0xcd, 0x7d, 0x73, 0x00, 0x8e, 0x8e, 0xad, 0x6a,
+		0x6c, 0xb3, 0xef, 0x06, 0x68, 0x1b, 0xbd, 0x81, 0xcd, 0xec, 0x74, 0xa6, 0xda, 0x63, 0x34, 0x87,
+		0x6a, 0x43, 0x54, 0xdb, 0x03, 0xdb, 0x29, 0x67, 0xcb, 0x2f, 0xb0, 0x2c, 0x0a, 0xf0, 0xb4, 0xdb,
+		0xec, 0x10, 0x6d, 0xf6, 0xa9, 0x09, 0xfd, 0x1e, 0x91, 0xf4, 0x47, 0x41, 0x6e, 0x76, 0xda, 0xba,
+		0x61, 0xab, 0xac, 0xe1, 0x6d, 0x63, 0x9b, 0x2d, 0xe3, 0x2b, 0x73, 0x5b, 0x5a, 0xc7, 0xd2, 0x95,
+		0x14, 0x2b, 0xae, 0x8b, 0x52, 0xc4, 0xa0, 0xe2, 0xd4, 0xf7, 0x60, 0x84, 0x7d, 0x18, 0xac, 0xd8,
+		0xc1, 0xc8, 0xfd, 0x2f, 0x80, 0xb8, 0xc7, 0x43, 0x24, 0xf7, 0x40, 0xe2, 0x25, 0x6d, 0x57, 0x53,
+		0x45, 0x88, 0x80, 0x71, 0x22, 0x8e, 0x79, 0xeb, 0x2c, 0x8b, 0x3c, 0x0a, 0x4b, 0x14, 0xc4, 0x1c,
+		0xd8, 0x7a, 0x5f, 0x6d, 0x76, 0x34, 0xcb, 0xa2, 0x4c, 0x8b, 0x52, 0x50, 0x82, 0x65, 0x35, 0x2c,
+		0x2a, 0x8a, 0x12, 0xf2, 0x0f, 0x12, 0x2c, 0x52, 0x94, 0xee, 0xa0, 0x63, 0xb7, 0x7b, 0x1d, 0x5d,
+		0xc5, 0x18, 0x86, 0x95, 0x06, 0x4f, 0xd3, 0x0a, 0xbf, 0x21, 0x7d, 0x42, 0xfa, 0x55, 0x29, 0xfa,
+		0xe7, 0x91, 0xec, 0x67, 0x22, 0x67, 0x3f, 0x29, 0x35, 0x76, 0xda, 0x56, 0x76, 0x53, 0xdf, 0xd1,
+		0x76, 0xdb, 0x66, 0x3f, 0xdb, 0xb6, 0xb2, 0xba, 0xa1, 0x6d, 0x76, 0xf4, 0x56, 0x76, 0x73, 0x3f,
+		0xcb, 0xd7, 0xcd, 0x6c, 0xdb, 0xc8, 0x72, 0xcf, 0xd1, 0xca, 0x9e, 0x7f, 0xf4, 0xfc, 0x13, 0x59,
+		0xcd, 0x68, 0x65, 0xb5, 0x4d, 0x73, 0x57, 0x5f, 0xce, 0x36, 0xcc, 0x6c, 0xab, 0x6d, 0x21, 0x4a,
+		0xb6, 0x6d, 0x3f, 0x9c, 0xdd, 0x37, 0x07, 0xd9, 0xa6, 0x66, 0x64, 0x2d, 0xdd, 0xce, 0xbe, 0x47,
+		0x18, 0x7a, 0xcb, 0x67, 0x7a, 0x9b, 0xcb, 0xd8, 0xb0, 0x07, 0x97, 0xd1, 0x2f, 0x53, 0xdb, 0x06,
+		0x6d, 0x18, 0xeb, 0x53, 0xf6, 0x6d, 0xd9, 0xeb, 0xe5, 0xfa, 0x7b, 0xb2, 0xa6, 0x91, 0x6d, 0x1b,
+		0xad, 0xf6, 0x6e, 0xbb, 0x35, 0xd0, 0x3a, 0x59, 0x1e, 0x47, 0xb0, 0x1e, 0xce, 0xa2, 0x13, 0x66,
+		0x3d, 0x9c, 0x35, 0xfb, 0x59, 0xee, 0xd1, 0x5b, 0xcb, 0xca, 0x02, 0x12, 0x5b, 0xe3, 0x9d, 0x44,
+		0xb6, 0x5a, 0xa4, 0x04, 0x77, 0xd3, 0xae, 0x6f, 0xeb, 0x86, 0xde, 0xd7, 0x6c, 0x5d, 0xd5, 0xdf,
+		0x3b, 0xd0, 0x3a, 0x96, 0xaa, 0x19, 0x2d, 0x75, 0x47, 0xb3, 0x76, 0xd2, 0x4b, 0xc8, 0x84, 0x42,
+		0x20, 0x2d, 0x29, 0x27, 0x10, 0xf0, 0x32, 0x87, 0x2b, 0x53, 0xb0, 0xbc, 0xd1, 0xba, 0xa2, 0x59,
+		0x3b, 0x64, 0x05, 0x8e, 0x51, 0x2a, 0x96, 0xdd, 0x6f, 0x1b, 0xdb, 0x6a, 0x73, 0x47, 0x6f, 0xde,
+		0x50, 0x07, 0xf6, 0xd6, 0xd3, 0xe9, 0x93, 0xde, 0xe1, 0xa5, 0x5c, 0xae, 0x53, 0x98, 0x22, 0x82,
+		0x6c, 0xd8, 0x5b, 0x4f, 0x93, 0x3a, 0x24, 0x50, 0xa2, 0xba, 0xed, 0xf7, 0xe9, 0xea, 0x96, 0xd9,
+		0xa7, 0x96, 0x45, 0x72, 0x8c, 0xc2, 0xf6, 0x88, 0xc1, 0x72, 0x8d, 0x23, 0xac, 0x99, 0x2d, 0x7d,
+		0x65, 0xae, 0xbe, 0x5e, 0x2e, 0x97, 0x94, 0xb8, 0xa0, 0x72, 0xc9, 0xec, 0xe3, 0xac, 0xd8, 0x36,
+		0x1d, 0x29, 0x89, 0xb3, 0x59, 0xb1, 0x6d, 0x0a, 0x19, 0x79, 0x12, 0x16, 0x9b, 0x4d, 0xd6, 0xe7,
+		0x76, 0x53, 0x15, 0x0c, 0x4a, 0xcb, 0xde, 0xc6, 0x2e, 0x34, 0x9b, 0x97, 0x19, 0x00, 0x9f, 0xb6,
+		0x16, 0xb9, 0x08, 0x47, 0x5d, 0x66, 0x79, 0x11, 0x17, 0x46, 0x7a, 0x39, 0x8c, 0xfa, 0x24, 0x2c,
+		0xf6, 0xf6, 0x47, 0x11, 0x89, 0xaf, 0xc6, 0xde, 0xfe, 0x30, 0xda, 0x7d, 0x34, 0xe6, 0xd5, 0xd7,
+		0x9b, 0xd4, 0x10, 0x3e, 0xee, 0x85, 0xf6, 0x14, 0x90, 0x65, 0x90, 0x9b, 0x4d, 0x95, 0x89, 0xa0,
+		0xaa, 0xf5, 0x75, 0x43, 0xb3, 0xd2, 0xa7, 0x29, 0x70, 0xc8, 0xee, 0x0f, 0x74, 0x25, 0xd9, 0x6c,
+		0x96, 0x69, 0x61, 0x9e, 0x96, 0x91, 0xb3, 0xb0, 0x60, 0x6e, 0xbe, 0xd4, 0x64, 0x92, 0xa4, 0xf6,
+		0xfa, 0xfa, 0x56, 0x7b, 0x2f, 0xfd, 0x16, 0xca, 0xa5, 0x14, 0x16, 0xd0, 0xb9, 0xb1, 0x4e, 0xb3,
+		0xc9, 0x83, 0x20, 0x37, 0xad, 0x1d, 0xad, 0xdf, 0xa3, 0xeb, 0x8d, 0xd5, 0xd3, 0x9a, 0x7a, 0xfa,
+		0x3e, 0x06, 0xca, 0xf2, 0xab, 0x22, 0x1b, 0x67, 0xa7, 0xf5, 0x72, 0x7b, 0xcb, 0x16, 0x14, 0x1f,
+		0x60, 0xb3, 0x93, 0xe6, 0x71, 0x6a, 0x67, 0x40, 0xee, 0xed, 0xf4, 0xfc, 0x15, 0x9f, 0xa1, 0x60,
+		0xc9, 0xde, 0x4e, 0xcf, 0x5b, 0xef, 0xbd, 0x30, 0xdf, 0xdb, 0xf1, 0x56, 0xfa, 0x20, 0xb3, 0x4a,
+		0x7b, 0x3b, 0x9e, 0x1a, 0x9f, 0x80, 0x63, 0x08, 0xd4, 0xd5, 0x6d, 0xad, 0xa5, 0xd9, 0x9a, 0x07,
+		0xfa, 0x61, 0x0a, 0xbd, 0xd4, 0xdb, 0xe9, 0xad, 0xf1, 0x42, 0x5f, 0x3b, 0xfb, 0x83, 0xcd, 0x7d,
+		0x47, 0x3e, 0x1e, 0x61, 0xed, 0xc4, 0x3c, 0x21, 0x21, 0xb7, 0xed, 0x9c, 0x7d, 0xd9, 0x5c, 0xd1,
+		0xdc, 0x0a, 0x24, 0xbc, 0x72, 0x4f, 0x62, 0xc0, 0x24, 0x5f, 0x96, 0xd0, 0x34, 0x2c, 0xd6, 0x4a,
+		0x68, 0xd4, 0xbd, 0x58, 0x96, 0x03, 0x68, 0x5c, 0xae, 0x56, 0x1a, 0x65, 0x55, 0xd9, 0xa8, 0x36,
+		0x2a, 0x6b, 0x65, 0x39, 0x38, 0xc6, 0xed, 0xb9, 0x1a, 0x8a, 0x9e, 0x95, 0x1f, 0xba, 0x1a, 0x8a,
+		0xde, 0x2f, 0x3f, 0x40, 0xd9, 0x34, 0x22, 0x9c, 0xb9, 0x7f, 0x0c, 0x42, 0xd2, 0x1f, 0xff, 0x20,
+		0x6f, 0x85, 0xe3, 0x22, 0xc0, 0x69, 0xe9, 0xb6, 0xfa, 0x72, 0xbb, 0x4f, 0x27, 0x6d, 0x57, 0x63,
+		0x36, 0x81, 0x23, 0x9c, 0x4b, 0x1c, 0xaa, 0xae, 0xdb, 0xcf, 0xb7, 0xfb, 0x38, 0x25, 0xbb, 0x9a,
+		0x4d, 0x56, 0xe1, 0xb4, 0x61, 0xaa, 0x96, 0xad, 0x19, 0x2d, 0xad, 0xdf, 0x52, 0xdd, 0xa8, 0xb4,
+		0xaa, 0x35, 0x9b, 0xba, 0x65, 0x99, 0x6c, 0xfd, 0x77, 0xa8, 0xdc, 0x65, 0x98, 0x75, 0x0e, 0xec,
+		0x2e, 0x85, 0x79, 0x0e, 0x3a, 0x34, 0x37, 0x82, 0x93, 0xe6, 0xc6, 0x49, 0x88, 0x75, 0xb5, 0x9e,
+		0xaa, 0x1b, 0x76, 0x7f, 0x9f, 0x7a, 0x36, 0x51, 0x25, 0xda, 0xd5, 0x7a, 0x65, 0x4c, 0x93, 0x6b,
+		0x70, 0xbf, 0x0b, 0xaa, 0x76, 0xf4, 0x6d, 0xad, 0xb9, 0xaf, 0x52, 0x37, 0x86, 0x06, 0xe3, 0xd4,
+		0xa6, 0x69, 0x6c, 0x75, 0xda, 0x4d, 0xdb, 0x4a, 0xc7, 0x1d, 0x3d, 0x98, 0x73, 0x31, 0x56, 0x29,
+		0xc2, 0x55, 0xcb, 0x34, 0xa8, 0xf7, 0x52, 0x14, 0xd0, 0x3e, 0xf1, 0x49, 0xbc, 0x29, 0xc4, 0x67,
+		0xbc, 0x08, 0x84, 0xe4, 0xb9, 0xab, 0xa1, 0xe8, 0x9c, 0x1c, 0xbe, 0x1a, 0x8a, 0x86, 0xe5, 0xc8,
+		0xd5, 0x50, 0x34, 0x2a, 0xc7, 0xae, 0x86, 0xa2, 0x31, 0x19, 0x72, 0xbf, 0x3b, 0x0f, 0x09, 0xaf,
+		0x53, 0x86, 0x3e, 0x6e, 0x93, 0x5a, 0x11, 0x12, 0x55, 0xd1, 0xf7, 0x1e, 0xe8, 0xc2, 0x2d, 0x17,
+		0xd1, 0xbc, 0x58, 0x09, 0x33, 0x0f, 0x48, 0x61, 0x98, 0x68, 0xe8, 0xe1, 0xa4, 0xd3, 0x99, 0xb9,
+		0x18, 0x55, 0x78, 0x8a, 0x5c, 0x86, 0xf0, 0x4b, 0x16, 0xa5, 0xcd, 0xac, 0xd5, 0xb7, 0x1c, 0x4c,
+		0xfb, 0x6a, 0x9d, 0x12, 0x8f, 0x5d, 0xad, 0xab, 0xd5, 0x9a, 0xb2, 0x96, 0x5f, 0x55, 0x38, 0x3a,
+		0x39, 0x01, 0xa1, 0x8e, 0xf6, 0xbe, 0x7d, 0xbf, 0x21, 0x42, 0xb3, 0xc8, 0x32, 0xa4, 0x06, 0x06,
+		0x8b, 0x6c, 0xe0, 0x58, 0x23, 0x54, 0xca, 0x0b, 0x95, 0x74, 0x4b, 0x57, 0x11, 0x7e, 0x46, 0xf9,
+		0xba, 0x1b, 0x42, 0xb8, 0x65, 0xe0, 0xb7, 0x16, 0x50, 0x4e, 0x68, 0x36, 0x39, 0x03, 0x89, 0x96,
+		0xbe, 0x39, 0xd8, 0x56, 0xfb, 0x7a, 0x4b, 0x6b, 0xda, 0xfe, 0x35, 0x26, 0x4e, 0x8b, 0x14, 0x5a,
+		0x42, 0x9e, 0x83, 0x18, 0x8e, 0x97, 0x41, 0xc7, 0x7b, 0x81, 0xb2, 0xe1, 0x91, 0x83, 0xd9, 0xc0,
+		0x87, 0x5b, 0x20, 0x29, 0x2e, 0x3e, 0xb9, 0x02, 0x11, 0x5b, 0xeb, 0x6f, 0xeb, 0xb6, 0x95, 0x5e,
+		0xcc, 0x06, 0xcf, 0x24, 0xcf, 0x2f, 0xcf, 0x42, 0xaa, 0x41, 0x51, 0x68, 0x4c, 0x41, 0xa0, 0x93,
+		0xe7, 0x41, 0xe6, 0x56, 0x8c, 0xca, 0x0d, 0x1b, 0x2b, 0xbd, 0x44, 0x85, 0xf1, 0xe1, 0x83, 0x49,
+		0xf2, 0xf0, 0x79, 0x89, 0x21, 0x29, 0x29, 0xdd, 0x97, 0xf6, 0xcf, 0x91, 0xa3, 0x87, 0x99, 0x23,
+		0x1b, 0x90, 0xe2, 0xbf, 0x55, 0x6b, 0xd0, 0xeb, 0x99, 0x7d, 0x3b, 0x7d, 0x2c, 0x2b, 0x4d, 0x6f,
+		0x90, 0x20, 0xc6, 0x70, 0x94, 0xe4, 0x96, 0x2f, 0xfd, 0xe5, 0x9b, 0x7a, 0x99, 0x17, 0x21, 0xe9,
+		0x67, 0x86, 0x77, 0xf7, 0x21, 0x38, 0xe3, 0xee, 0x03, 0x7a, 0x5f, 0xc2, 0xa5, 0xc5, 0xe5, 0x8a,
+		0x25, 0x32, 0xbf, 0x12, 0x80, 0xa4, 0xbf, 0x63, 0xe4, 0x32, 0x10, 0x31, 0x62, 0x6d, 0xc3, 0xee,
+		0x9b, 0xad, 0x41, 0x53, 0x6f, 0xa5, 0xa5, 0x29, 0xf5, 0x2c, 0x70, 0x9c, 0x8a, 0x83, 0xe2, 0x25,
+		0xe4, 0x99, 0x09, 0x81, 0x19, 0x09, 0x95, 0xdc, 0x39, 0x72, 0x0e, 0x16, 0x05, 0x01, 0x24, 0xf6,
+		0xb2, 0xd6, 0x37, 0xd0, 0xf6, 0x67, 0xde, 0x08, 0xf1, 0x14, 0x3d, 0xcf, 0x4a, 0x48, 0x1e, 0x84,
+		0xb8, 0xa8, 0x7d, 0xbd, 0x6b, 0x62, 0x84, 0x30, 0x34, 0xa5, 0xda, 0x24, 0x47, 0x50, 0x18, 0x3c,
+		0xf3, 0x94, 0xbb, 0xe6, 0xae, 0xd6, 0x51, 0xf5, 0x7e, 0xdf, 0xec, 0x53, 0x95, 0x40, 0x3d, 0x65,
+		0x9a, 0x59, 0xc6, 0xbc, 0xdc, 0x39, 0x98, 0xa3, 0x7a, 0x8a, 0x00, 0x70, 0x4d, 0x25, 0x1f, 0x21,
+		0x51, 0x08, 0x15, 0x6b, 0x0a, 0xae, 0xab, 0x32, 0x24, 0x58, 0xae, 0xba, 0x5e, 0x29, 0x17, 0xcb,
+		0x72, 0x20, 0xf7, 0x24, 0x84, 0x99, 0xf2, 0xc1, 0x35, 0xd7, 0x51, 0x3f, 0xf2, 0x11, 0x9e, 0xe4,
+		0x34, 0x24, 0x51, 0xba, 0xb1, 0x56, 0x28, 0x2b, 0x72, 0x20, 0xb7, 0x01, 0xa9, 0xa1, 0xc9, 0x4a,
+		0x8e, 0xc2, 0x82, 0x52, 0x6e, 0x94, 0xab, 0x18, 0x6b, 0x51, 0x37, 0xaa, 0xcf, 0x55, 0x6b, 0xcf,
+		0x63, 0xc0, 0xd2, 0x97, 0x2d, 0x16, 0x70, 0x89, 0x2c, 0x81, 0xec, 0x66, 0xd7, 0x6b, 0x1b, 0x0a,
+		0x6d, 0xcd, 0xb7, 0x06, 0x40, 0x1e, 0x9e, 0xb9, 0xe4, 0x38, 0x2c, 0x36, 0xf2, 0xca, 0xe5, 0x72,
+		0x43, 0x65, 0xf1, 0x23, 0x87, 0xf4, 0x12, 0xc8, 0xde, 0x82, 0x4b, 0x15, 0x1a, 0x1e, 0x3b, 0x0d,
+		0x27, 0xbd, 0xb9, 0xe5, 0x17, 0x1a, 0xe5, 0x6a, 0x9d, 0x56, 0x9e, 0xaf, 0x5e, 0x46, 0x6b, 0x62,
+		0x88, 0x9e, 0x88, 0x58, 0x05, 0xb1, 0xa9, 0x7e, 0x7a, 0xe5, 0xd5, 0x92, 0x1c, 0x1a, 0xce, 0xae,
+		0x55, 0xcb, 0xb5, 0x4b, 0xf2, 0xdc, 0x70, 0xed, 0x34, 0x8a, 0x15, 0x26, 0x19, 0x38, 0x36, 0x9c,
+		0xab, 0x96, 0xab, 0x0d, 0xe5, 0xba, 0x1c, 0x19, 0xae, 0xb8, 0x5e, 0x56, 0xae, 0x55, 0x8a, 0x65,
+		0x39, 0x4a, 0x8e, 0x01, 0xf1, 0xb7, 0xa8, 0x71, 0xa5, 0x56, 0x92, 0x63, 0x07, 0x2d, 0x71, 0x44,
+		0x5e, 0xcc, 0x7d, 0x52, 0x82, 0x84, 0x37, 0xb2, 0xe4, 0xd3, 0x40, 0xd2, 0x9b, 0x75, 0x95, 0xce,
+		0xfd, 0xe7, 0x00, 0xc4, 0x3d, 0xa1, 0x26, 0x74, 0xe9, 0xb5, 0x4e, 0xc7, 0x7c, 0x59, 0xd5, 0x3a,
+		0x6d, 0xcd, 0xe2, 0x0b, 0x29, 0xd0, 0xac, 0x3c, 0xe6, 0xcc, 0xba, 0x70, 0xcd, 0x6e, 0xfb, 0x84,
+		0x6f, 0xdb, 0xf6, 0x89, 0xbc, 0x89, 0x6d, 0x9f, 0x39, 0x39, 0x9c, 0xfb, 0x93, 0x00, 0xc8, 0xc3,
+		0x11, 0xa4, 0x21, 0xfe, 0x49, 0x93, 0xf8, 0xe7, 0xed, 0x67, 0xe0, 0x30, 0xfd, 0x1c, 0x36, 0x09,
+		0x82, 0x13, 0x4d, 0x82, 0x31, 0x2b, 0x5d, 0xe8, 0xcd, 0xbc, 0xd2, 0x8d, 0x13, 0xdf, 0xff, 0x22,
+		0x41, 0xd2, 0x1f, 0xf8, 0xf2, 0x71, 0x2e, 0x77, 0x18, 0xce, 0xf9, 0x47, 0xe6, 0x9e, 0x49, 0x23,
+		0xf3, 0x15, 0xed, 0xdf, 0x47, 0x82, 0x30, 0xef, 0x8b, 0x90, 0xcd, 0xda, 0xca, 0xf7, 0xc2, 0x42,
+		0xbb, 0xa5, 0x77, 0x7b, 0xa6, 0x8d, 0x67, 0x47, 0xd4, 0x8e, 0xbe, 0xab, 0x77, 0x28, 0x3b, 0x92,
+		0x63, 0xf6, 0xc7, 0x7d, 0x35, 0x2c, 0x57, 0x5c, 0xbc, 0x55, 0x44, 0x5b, 0x59, 0xac, 0x94, 0xca,
+		0x6b, 0xeb, 0xb5, 0x46, 0xb9, 0x5a, 0xbc, 0x2e, 0x34, 0xbe, 0x22, 0xb7, 0x87, 0xc0, 0x7c, 0x8c,
+		0xbf, 0xf7, 0xcd, 0xe1, 0xd5, 0xae, 0x83, 0x3c, 0xdc, 0x1b, 0x54, 0xfc, 0x63, 0xfa, 0x23, 0x1f,
+		0x21, 0x8b, 0x90, 0xaa, 0xd6, 0xd4, 0x7a, 0xa5, 0x54, 0x56, 0xcb, 0x97, 0x2e, 0x95, 0x8b, 0x8d,
+		0x3a, 0xdb, 0xdf, 0x71, 0xa0, 0x1b, 0x72, 0x60, 0xdc, 0x18, 0x7d, 0x7f, 0x10, 0x16, 0xc7, 0xb4,
+		0x88, 0xe4, 0x79, 0x40, 0x95, 0x45, 0x7c, 0x1f, 0x99, 0xa5, 0x17, 0xcb, 0x18, 0x46, 0x58, 0xd7,
+		0xfa, 0x36, 0x8f, 0xbf, 0x3e, 0x08, 0xc8, 0x66, 0xc3, 0x46, 0xbf, 0xa1, 0xcf, 0xf7, 0xcf, 0x98,
+		0x5d, 0x93, 0x72, 0xf3, 0xd9, 0x16, 0xda, 0xc3, 0x40, 0x7a, 0xa6, 0xd5, 0xb6, 0xdb, 0xbb, 0x78,
+		0xa4, 0x45, 0x6c, 0xb6, 0xe1, 0x84, 0x0e, 0x29, 0xb2, 0x28, 0xa9, 0x18, 0xb6, 0x03, 0x6d, 0xe8,
+		0xdb, 0xda, 0x10, 0x34, 0x1a, 0x31, 0x41, 0x45, 0x16, 0x25, 0x0e, 0xf4, 0x3d, 0x90, 0x68, 0x99,
+		0x03, 0x0c, 0xff, 0x30, 0x38, 0x54, 0xd9, 0x92, 0x12, 0x67, 0x79, 0x0e, 0x08, 0x8f, 0xcf, 0xb9,
+		0xbb, 0x7c, 0x09, 0x25, 0xce, 0xf2, 0x18, 0xc8, 0x03, 0x90, 0xd2, 0xb6, 0xb7, 0xfb, 0x48, 0x5c,
+		0x10, 0x62, 0x61, 0xd3, 0xa4, 0x93, 0x4d, 0x01, 0x33, 0x57, 0x21, 0x2a, 0xf8, 0x80, 0x0e, 0x36,
+		0x72, 0x42, 0xed, 0xb1, 0x9d, 0x81, 0x00, 0x6e, 0xfc, 0x19, 0xa2, 0xf0, 0x1e, 0x48, 0xb4, 0x2d,
+		0xd5, 0x3d, 0xd0, 0x12, 0xc8, 0x06, 0xce, 0x44, 0x95, 0x78, 0xdb, 0x72, 0x36, 0xa7, 0x73, 0x3f,
+		0x9d, 0x02, 0x70, 0x85, 0x8e, 0x7c, 0xa7, 0x04, 0x49, 0xb6, 0x00, 0xf5, 0xfa, 0xba, 0xa5, 0x1b,
+		0x4d, 0xe1, 0x6f, 0x3e, 0x78, 0x80, 0xa8, 0x32, 0xf5, 0xb7, 0xce, 0x11, 0x0a, 0xcf, 0x7e, 0x50,
+		0x92, 0x5e, 0x95, 0x42, 0xaf, 0x4a, 0xd2, 0x47, 0xa5, 0x79, 0x12, 0x2d, 0xbf, 0xb0, 0xbe, 0x5a,
+		0x29, 0x56, 0x1a, 0xe9, 0x0f, 0x44, 0x68, 0xba, 0xb2, 0xc6, 0xd3, 0xaf, 0x47, 0xfc, 0xe5, 0x6f,
+		0x44, 0x3e, 0x21, 0x05, 0xa3, 0x6f, 0x44, 0x94, 0xf9, 0x2d, 0x2f, 0x3d, 0xd2, 0xf1, 0x9e, 0x85,
+		0x09, 0x4c, 0xf2, 0x50, 0xdd, 0xd6, 0x94, 0xf9, 0x09, 0x98, 0xc2, 0x83, 0xb4, 0x21, 0x61, 0xda,
+		0x90, 0x38, 0x09, 0x17, 0x57, 0x6b, 0xf5, 0x72, 0x89, 0x36, 0x23, 0x46, 0x42, 0xb5, 0xf5, 0x72,
+		0x35, 0xfd, 0xba, 0xa8, 0xd2, 0x3d, 0x36, 0xf3, 0xaa, 0x04, 0xc7, 0xc5, 0x66, 0x37, 0x5f, 0x8b,
+		0x75, 0xa3, 0x69, 0xb6, 0x84, 0xc9, 0x9c, 0x3c, 0xff, 0xd8, 0x41, 0x95, 0x2b, 0x1c, 0x95, 0xb2,
+		0xa4, 0xcc, 0x11, 0x0b, 0x8f, 0x8c, 0xb0, 0x24, 0x5f, 0x2d, 0xf1, 0xb6, 0xc4, 0x49, 0x78, 0x3d,
+		0x5f, 0x7c, 0xae, 0x5c, 0x72, 0x5b, 0x73, 0xb4, 0x3f, 0x8e, 0x0a, 0xf9, 0x5a, 0x48, 0x61, 0x58,
+		0x17, 0x65, 0xa3, 0xdd, 0x62, 0xa7, 0x0f, 0x42, 0x93, 0xb6, 0xab, 0xdd, 0x16, 0x61, 0x9c, 0xf7,
+		0x9a, 0x83, 0x51, 0x78, 0xd0, 0xd3, 0x94, 0x18, 0x09, 0x55, 0x6b, 0xd5, 0xb2, 0x68, 0x06, 0xdd,
+		0xa9, 0xbf, 0xee, 0x36, 0x23, 0x39, 0xf0, 0xa1, 0x92, 0xaf, 0x05, 0x59, 0xc4, 0x9f, 0x1c, 0x96,
+		0xcc, 0x4d, 0xda, 0x71, 0x77, 0x1b, 0xc0, 0xa3, 0x58, 0x0e, 0x33, 0xee, 0xf7, 0xb4, 0x60, 0x89,
+		0xa4, 0x56, 0xcb, 0xd5, 0xcb, 0x8d, 0x2b, 0xea, 0xba, 0x52, 0xa6, 0x1b, 0xa7, 0xe9, 0x0f, 0x88,
+		0xea, 0x53, 0x5d, 0x3f, 0x22, 0xf9, 0x7a, 0x09, 0xe2, 0xcc, 0x44, 0x62, 0x41, 0x2f, 0x16, 0xad,
+		0xb8, 0xff, 0xa0, 0xba, 0xa9, 0x85, 0x44, 0xa1, 0x0b, 0x17, 0x69, 0xb5, 0x41, 0x21, 0x10, 0xc7,
+		0x09, 0x59, 0x2d, 0x5f, 0xce, 0x17, 0xaf, 0xab, 0x85, 0x72, 0xbd, 0x81, 0x1a, 0xad, 0xa6, 0x30,
+		0x19, 0x05, 0x32, 0x97, 0x5f, 0x5d, 0xad, 0x3d, 0xef, 0x32, 0x02, 0x5e, 0x72, 0xc8, 0x90, 0xff,
+		0x5f, 0x82, 0x25, 0xdd, 0xd8, 0x32, 0xf1, 0x7c, 0x9c, 0x41, 0xf7, 0x49, 0x54, 0xcb, 0xde, 0xef,
+		0xb0, 0x19, 0x3d, 0xd6, 0xd3, 0xf7, 0x4a, 0x26, 0xc5, 0xab, 0x52, 0xb4, 0x3a, 0x62, 0x15, 0x5e,
+		0xfc, 0xa0, 0x14, 0x78, 0x15, 0x1b, 0x16, 0xa0, 0x6d, 0x0b, 0xbd, 0x2a, 0xcd, 0xd1, 0x16, 0x46,
+		0x5e, 0x95, 0xa2, 0xaf, 0x4a, 0xb1, 0x8f, 0x4a, 0x0b, 0x24, 0x51, 0x6f, 0x5c, 0x5f, 0x2d, 0xab,
+		0xac, 0xb5, 0xb4, 0x85, 0x49, 0x12, 0xa3, 0x79, 0xb8, 0xaf, 0x91, 0xfe, 0x8c, 0x3f, 0xe3, 0x42,
+		0xfa, 0x3b, 0xaa, 0xd8, 0xec, 0xcf, 0x44, 0x14, 0xa2, 0x8f, 0xd4, 0x47, 0x7e, 0x59, 0x82, 0x13,
+		0xe2, 0xbc, 0x81, 0x45, 0xf7, 0x20, 0x55, 0xcf, 0x6e, 0x65, 0x94, 0xf6, 0xa1, 0x7c, 0x50, 0x1f,
+		0xdc, 0x2d, 0x4b, 0x9e, 0xb9, 0xcc, 0xdd, 0xea, 0xe1, 0x1d, 0xcd, 0xc2, 0x05, 0xd6, 0xb5, 0x8f,
+		0x4a, 0x29, 0x02, 0xe5, 0x17, 0xd6, 0x6b, 0x4a, 0x43, 0xcd, 0xaf, 0xae, 0xd2, 0x0e, 0x1c, 0x25,
+		0x32, 0xcf, 0x69, 0xd4, 0xd6, 0xd5, 0xd5, 0xf2, 0xb5, 0xf2, 0x6a, 0xfa, 0x33, 0x11, 0xde, 0xec,
+		0xe3, 0xad, 0xf1, 0x04, 0x33, 0x1f, 0x91, 0x60, 0x61, 0xa4, 0xfa, 0xdc, 0xd7, 0x49, 0x70, 0x7c,
+		0x42, 0x13, 0xc8, 0x7d, 0x70, 0x4f, 0xa9, 0x7c, 0x29, 0xbf, 0xb1, 0xda, 0x50, 0xeb, 0xd7, 0xd7,
+		0x0a, 0xb5, 0x55, 0xf5, 0x5a, 0xa5, 0x5e, 0x29, 0x54, 0x56, 0x2b, 0x0d, 0xef, 0xca, 0x96, 0x04,
+		0x4f, 0x03, 0x99, 0xbf, 0x37, 0xdc, 0x3c, 0x39, 0x80, 0x5e, 0xe5, 0x6a, 0xad, 0x98, 0x5f, 0xa5,
+		0x40, 0x41, 0xe1, 0xb4, 0x16, 0x1b, 0x72, 0xe8, 0x6a, 0x34, 0x2a, 0xf1, 0xc5, 0xee, 0xab, 0x60,
+		0xde, 0xa7, 0x0d, 0xd1, 0xc7, 0xa2, 0xbe, 0x19, 0x0a, 0x78, 0xbd, 0x5c, 0x2d, 0x7a, 0x7d, 0xc2,
+		0x04, 0x38, 0xda, 0x4f, 0x96, 0x30, 0x25, 0x74, 0xa3, 0x1c, 0xc0, 0xd5, 0x96, 0xcb, 0xa7, 0xb3,
+		0xd3, 0x1f, 0xcc, 0x3d, 0x05, 0x51, 0xa1, 0xdd, 0xd0, 0xd3, 0xa3, 0x0e, 0xdb, 0x90, 0x9f, 0x19,
+		0x05, 0xaa, 0xda, 0x64, 0x09, 0x1b, 0xc8, 0x54, 0x9e, 0x1c, 0xc8, 0x5d, 0x83, 0xa3, 0x63, 0x35,
+		0x13, 0xb9, 0x17, 0x4e, 0x8b, 0xd3, 0x05, 0xcc, 0x87, 0x54, 0xcb, 0xd5, 0x62, 0xad, 0x84, 0x5e,
+		0xb7, 0x4b, 0x13, 0x80, 0xab, 0x28, 0xd6, 0x4a, 0xa1, 0xbe, 0xe4, 0x40, 0xae, 0x02, 0x49, 0xbf,
+		0x7e, 0x21, 0x27, 0xe1, 0xf8, 0x46, 0xe3, 0xd2, 0xd3, 0xea, 0xb5, 0xfc, 0x6a, 0xa5, 0x94, 0x1f,
+		0xf2, 0xaf, 0x01, 0xb8, 0x92, 0x91, 0x03, 0xd8, 0x50, 0x54, 0x3e, 0x72, 0x30, 0x17, 0x8a, 0x4a,
+		0xb2, 0x94, 0xab, 0x43, 0x6a, 0x48, 0x53, 0x90, 0xbb, 0x20, 0xcd, 0x1d, 0xde, 0x71, 0xad, 0x5a,
+		0x84, 0x61, 0xdd, 0xc1, 0x5c, 0xff, 0x52, 0x79, 0xb5, 0xb2, 0x56, 0x69, 0xd0, 0xf6, 0x5d, 0x01,
+		0x70, 0x55, 0x00, 0x9a, 0x36, 0x57, 0xeb, 0xb5, 0xaa, 0x7a, 0x09, 0xe3, 0x06, 0x0d, 0x0f, 0xa9,
+		0x18, 0xb0, 0x29, 0x2f, 0x4b, 0xe8, 0xde, 0x8e, 0xea, 0x05, 0x39, 0x90, 0xdb, 0x02, 0x32, 0x3a,
+		0x7d, 0x49, 0x16, 0xee, 0x2a, 0x57, 0x2f, 0xd5, 0x94, 0x62, 0x59, 0xad, 0xe6, 0xd7, 0xb0, 0x7d,
+		0x6c, 0xb2, 0xba, 0xa4, 0xe7, 0xc1, 0x9d, 0xab, 0x22, 0xa8, 0xe1, 0x4e, 0x67, 0x26, 0x56, 0xce,
+		0xdc, 0xa5, 0x9b, 0x05, 0x6f, 0x44, 0xe4, 0x6f, 0xa9, 0x9e, 0x0d, 0x47, 0xbf, 0xa5, 0x2a, 0x7f,
+		0x08, 0xff, 0x7f, 0xa8, 0x2a, 0x7f, 0x67, 0xf5, 0x6a, 0x38, 0xfa, 0x7a, 0x44, 0x7e, 0x23, 0x92,
+		0xfb, 0xab, 0x20, 0x10, 0x77, 0x3a, 0x3a, 0xf1, 0xbc, 0x17, 0x20, 0xea, 0x04, 0x08, 0xd9, 0x89,
+		0xf1, 0xb7, 0x1e, 0x30, 0x8b, 0x05, 0x9a, 0x27, 0x6b, 0x28, 0x60, 0xe8, 0x50, 0xc3, 0x68, 0x50,
+		0xb7, 0x6d, 0xb4, 0xbb, 0x83, 0xae, 0x2a, 0xa2, 0x66, 0x53, 0xa3, 0x41, 0x1c, 0x81, 0xa7, 0x29,
+		0x09, 0x6d, 0xcf, 0x47, 0x62, 0x6e, 0x2a, 0x09, 0x86, 0xc0, 0xd3, 0x99, 0xbf, 0x97, 0x20, 0x3d,
+		0xa9, 0xb1, 0xb7, 0x15, 0xd0, 0xab, 0xc2, 0x92, 0xb9, 0xab, 0xf7, 0xfb, 0xed, 0x16, 0xdd, 0xb7,
+		0x73, 0x2c, 0xf3, 0xd0, 0x74, 0xcb, 0x7c, 0xd1, 0x83, 0xc8, 0xb3, 0x2d, 0x52, 0x40, 0xc3, 0x69,
+		0x0f, 0x6d, 0x06, 0x41, 0x69, 0x6e, 0x3a, 0xa5, 0x79, 0x8a, 0x22, 0x68, 0x5c, 0xc5, 0x89, 0x80,
+		0xce, 0x71, 0x40, 0x0e, 0xba, 0xe6, 0x7f, 0xee, 0xe7, 0x03, 0x90, 0xf4, 0x9f, 0xb3, 0x26, 0x25,
+		0x88, 0x76, 0x4c, 0x7e, 0x00, 0x91, 0x8d, 0xf6, 0x99, 0x29, 0x47, 0xb3, 0x97, 0x57, 0x39, 0xbc,
+		0xe2, 0x60, 0x66, 0x7e, 0x57, 0x82, 0xa8, 0xc8, 0x26, 0xc7, 0x20, 0xd4, 0xd3, 0xec, 0x1d, 0x4a,
+		0x6e, 0xae, 0x10, 0x90, 0x25, 0x85, 0xa6, 0x31, 0xdf, 0xea, 0x69, 0xec, 0xf0, 0x25, 0xcf, 0xc7,
+		0x34, 0x9a, 0xde, 0x1d, 0x5d, 0x6b, 0xd1, 0x1d, 0x67, 0xb3, 0xdb, 0xd5, 0x0d, 0xdb, 0x12, 0xa6,
+		0x37, 0xcf, 0x2f, 0xf2, 0x6c, 0x3c, 0xc5, 0x6f, 0xf7, 0xb5, 0x76, 0xc7, 0x07, 0x1b, 0xa2, 0xb0,
+		0xb2, 0x28, 0x70, 0x80, 0x57, 0xe0, 0x84, 0xa0, 0xdb, 0xd2, 0x6d, 0xad, 0xb9, 0xa3, 0xb7, 0x5c,
+		0xa4, 0x30, 0x3d, 0x6f, 0x73, 0x9c, 0x03, 0x94, 0x78, 0xb9, 0xc0, 0x1d, 0xba, 0xf8, 0xf0, 0xa9,
+		0x00, 0x2c, 0x88, 0x1d, 0xf3, 0x96, 0xc3, 0xba, 0x35, 0x00, 0xcd, 0x30, 0x4c, 0xdb, 0xcb, 0xbc,
+		0x51, 0xdf, 0x63, 0x04, 0x6f, 0x39, 0xef, 0x20, 0x29, 0x1e, 0x02, 0x99, 0xbf, 0x90, 0x00, 0xdc,
+		0xa2, 0x89, 0x5c, 0x3c, 0x0d, 0x71, 0x7e, 0xa6, 0x9e, 0xde, 0xe9, 0x60, 0x41, 0x64, 0x60, 0x59,
+		0xb8, 0xb7, 0x8e, 0xf1, 0xe5, 0x4d, 0x7d, 0xbb, 0x6d, 0xf0, 0x93, 0x8d, 0x2c, 0x21, 0x4e, 0xf7,
+		0x84, 0xdc, 0x13, 0xc0, 0x0a, 0x44, 0x2d, 0xbd, 0xab, 0x19, 0x76, 0xbb, 0xc9, 0xe7, 0xd0, 0x85,
+		0x43, 0x35, 0x7e, 0xb9, 0xce, 0xb1, 0x15, 0x87, 0x4e, 0xee, 0x0c, 0x44, 0x45, 0xae, 0xa3, 0x95,
+		0x8f, 0x90, 0x08, 0x04, 0xeb, 0x65, 0x5c, 0x97, 0xa8, 0x72, 0xac, 0xe4, 0xeb, 0x72, 0xe0, 0xec,
+		0xef, 0x05, 0x20, 0x22, 0x26, 0xf5, 0x22, 0xa4, 0xca, 0xa5, 0xca, 0x90, 0x82, 0x5f, 0x84, 0xa4,
+		0xc8, 0xe4, 0x0a, 0xee, 0x03, 0x11, 0x6f, 0xe6, 0xba, 0x52, 0x6b, 0xd4, 0xce, 0xcb, 0x7f, 0x3e,
+		0x9a, 0xf9, 0xb8, 0xfc, 0x7a, 0x84, 0x2c, 0x40, 0x42, 0x64, 0x9e, 0x7f, 0xf4, 0xfc, 0xe3, 0xf2,
+		0x1b, 0xc3, 0x59, 0x4f, 0xc8, 0x9f, 0x19, 0xce, 0xba, 0x20, 0x7f, 0x36, 0x42, 0x8e, 0x82, 0xec,
+		0x36, 0xa6, 0xde, 0xc8, 0xe3, 0xe1, 0xc3, 0xef, 0xa8, 0xa2, 0xc2, 0x17, 0xd9, 0x8f, 0xa9, 0x0d,
+		0xd4, 0xe7, 0xb5, 0xea, 0xea, 0x75, 0x59, 0xf2, 0x16, 0x9c, 0xf7, 0x14, 0x04, 0xc8, 0xdd, 0x70,
+		0x5c, 0x14, 0x5c, 0xbc, 0x78, 0xf1, 0xe2, 0x53, 0x9e, 0xc2, 0x5b, 0xdf, 0x16, 0x1e, 0x2e, 0x7e,
+		0xda, 0x53, 0xfc, 0x43, 0xa3, 0xc5, 0x17, 0x3d, 0xc5, 0x3f, 0xfc, 0x6d, 0x61, 0xb2, 0x08, 0x71,
+		0x51, 0xbc, 0x96, 0x7f, 0x41, 0xfe, 0xe2, 0x17, 0xbf, 0xf8, 0xc5, 0xc8, 0xd9, 0x0d, 0x90, 0x47,
+		0xec, 0x96, 0x25, 0x90, 0x7d, 0x86, 0x0a, 0x0e, 0xc4, 0x91, 0xa1, 0x5c, 0x6a, 0x8b, 0xc8, 0x12,
+		0xda, 0x01, 0x9e, 0x5c, 0x66, 0xb7, 0xc8, 0x81, 0xc2, 0xd7, 0xc2, 0x62, 0xd3, 0xec, 0x0e, 0xcb,
+		0x46, 0x41, 0x1e, 0x3a, 0xd6, 0x64, 0x5d, 0x91, 0x5e, 0x7c, 0x84, 0x03, 0x6d, 0x9b, 0x1d, 0xcd,
+		0xd8, 0x5e, 0x36, 0xfb, 0xdb, 0xee, 0xe5, 0x25, 0x74, 0xba, 0x2c, 0xcf, 0x15, 0xa6, 0xde, 0xe6,
+		0xdf, 0x4b, 0xd2, 0x47, 0x03, 0xc1, 0xcb, 0xeb, 0x85, 0x9f, 0x08, 0x64, 0x2e, 0x33, 0xc4, 0x75,
+		0x21, 0x79, 0x8a, 0xbe, 0xd5, 0xd1, 0x9b, 0x28, 0x1e, 0xf0, 0x97, 0x0f, 0xc1, 0xd2, 0xb6, 0xb9,
+		0x6d, 0x52, 0x4a, 0xe7, 0xf0, 0x17, 0x6b, 0x04, 0x89, 0x39, 0xb9, 0x99, 0xa9, 0x57, 0xa5, 0x56,
+		0xaa, 0xb0, 0xc8, 0x81, 0x55, 0xea, 0x03, 0xb2, 0x43, 0x0b, 0xe4, 0xc0, 0xe3, 0x80, 0xe9, 0x9f,
+		0xfd, 0x33, 0x1a, 0xd4, 0x53, 0x16, 0x38, 0x2a, 0x96, 0xb1, 0x73, 0x0d, 0x2b, 0x0a, 0x1c, 0xf5,
+		0xd1, 0x63, 0xfe, 0xb7, 0xde, 0x9f, 0x42, 0xf1, 0x37, 0x39, 0xc5, 0x45, 0x0f, 0xc5, 0x3a, 0x47,
+		0x5d, 0x29, 0xc2, 0xfc, 0x61, 0x68, 0xfd, 0x5b, 0x4e, 0x2b, 0xa1, 0x7b, 0x89, 0x5c, 0x86, 0x14,
+		0x25, 0xd2, 0x1c, 0x58, 0xb6, 0xd9, 0xa5, 0xc1, 0x8d, 0x83, 0xc9, 0xfc, 0xbb, 0x3f, 0x63, 0xda,
+		0x36, 0x89, 0x68, 0x45, 0x07, 0x6b, 0x65, 0x05, 0xa8, 0x2f, 0x8b, 0x67, 0xdd, 0xa7, 0x50, 0xf8,
+		0x2d, 0xde, 0x10, 0x07, 0x7e, 0xe5, 0x1a, 0xba, 0x36, 0x83, 0x2e, 0x8d, 0x3d, 0x78, 0x5b, 0x32,
+		0xfd, 0xe0, 0x5f, 0xfa, 0xf7, 0xde, 0xcf, 0x14, 0xfa, 0xa2, 0x43, 0xc0, 0xd3, 0x26, 0xcf, 0x28,
+		0x6e, 0xeb, 0xb6, 0xad, 0xf7, 0x2d, 0x55, 0xeb, 0x8c, 0x6b, 0x9e, 0xe7, 0x98, 0x51, 0xfa, 0xfb,
+		0x3e, 0xe7, 0x1f, 0xc5, 0xcb, 0x0c, 0x33, 0xdf, 0xe9, 0xac, 0x6c, 0xc0, 0xf1, 0x31, 0x52, 0x31,
+		0x03, 0xcd, 0xef, 0xe7, 0x34, 0x97, 0x46, 0x24, 0x03, 0xc9, 0xae, 0x83, 0xc8, 0x77, 0xc6, 0x72,
+		0x06, 0x9a, 0x3f, 0xc0, 0x69, 0x12, 0x8e, 0x2b, 0x86, 0x14, 0x29, 0x5e, 0x85, 0x85, 0x5d, 0xbd,
+		0xbf, 0x69, 0x5a, 0xfc, 0x68, 0xd7, 0x0c, 0xe4, 0x7e, 0x90, 0x93, 0x4b, 0x71, 0x44, 0x7a, 0xd6,
+		0x0b, 0x69, 0x5d, 0x84, 0xe8, 0x96, 0xd6, 0xd4, 0x67, 0x20, 0x71, 0x8b, 0x93, 0x88, 0x20, 0x3c,
+		0xa2, 0xe6, 0x21, 0xb1, 0x6d, 0xf2, 0xf0, 0xd3, 0x74, 0xf4, 0x1f, 0xe2, 0xe8, 0x71, 0x81, 0xc3,
+		0x49, 0xf4, 0xcc, 0xde, 0xa0, 0x83, 0xb1, 0xa9, 0xe9, 0x24, 0x7e, 0x58, 0x90, 0x10, 0x38, 0x9c,
+		0xc4, 0x21, 0xd8, 0xfa, 0x61, 0x41, 0xc2, 0xf2, 0xf0, 0xf3, 0x59, 0x3c, 0x07, 0xdf, 0xd9, 0x37,
+		0x8d, 0x59, 0x1a, 0xf1, 0x11, 0x4e, 0x01, 0x38, 0x0a, 0x12, 0x78, 0x06, 0x62, 0xb3, 0x0e, 0xc4,
+		0x8f, 0x7d, 0x4e, 0x4c, 0x0f, 0x31, 0x02, 0x97, 0x21, 0x25, 0x14, 0x14, 0xee, 0x8d, 0x4e, 0x27,
+		0xf1, 0x31, 0x4e, 0x22, 0xe9, 0x41, 0xe3, 0xdd, 0xb0, 0x75, 0xcb, 0xde, 0xd6, 0x67, 0x21, 0xf2,
+		0x7f, 0x8b, 0x6e, 0x70, 0x14, 0xce, 0xca, 0x4d, 0xdd, 0x68, 0xee, 0xcc, 0x46, 0xe1, 0xe3, 0x82,
+		0x95, 0x02, 0x07, 0x49, 0x14, 0x61, 0xbe, 0xab, 0xf5, 0xad, 0x1d, 0xad, 0x33, 0xd3, 0x70, 0xfc,
+		0x38, 0xa7, 0x91, 0x70, 0x90, 0x38, 0x47, 0x06, 0xc6, 0x61, 0xc8, 0xfc, 0x84, 0xe0, 0xc8, 0xc0,
+		0xf0, 0x11, 0x5a, 0x87, 0x25, 0xcb, 0xa6, 0x86, 0xf8, 0x61, 0xa8, 0xfd, 0x3f, 0x62, 0xea, 0x31,
+		0xdc, 0x35, 0x2f, 0xc5, 0x67, 0x20, 0x66, 0xb5, 0xdf, 0x37, 0x13, 0x99, 0x9f, 0x14, 0x23, 0x4d,
+		0x11, 0x10, 0xf9, 0x3a, 0x9c, 0x18, 0xbb, 0x4c, 0xcc, 0x40, 0xec, 0xa7, 0x38, 0xb1, 0x63, 0x63,
+		0x96, 0x0a, 0xae, 0x12, 0x0e, 0x4b, 0xf2, 0xa7, 0x85, 0x4a, 0xd0, 0x87, 0x68, 0xad, 0xe3, 0xc6,
+		0x80, 0xa5, 0x6d, 0x1d, 0x8e, 0x6b, 0xff, 0xaf, 0xe0, 0x1a, 0xc3, 0xf5, 0x71, 0xad, 0x01, 0xc7,
+		0x38, 0xc5, 0xc3, 0x8d, 0xeb, 0xcf, 0x08, 0xc5, 0xca, 0xb0, 0x37, 0xfc, 0xa3, 0xfb, 0x2e, 0xc8,
+		0x38, 0xec, 0x14, 0x91, 0x67, 0x4b, 0xc5, 0x83, 0x61, 0xd3, 0x29, 0xff, 0x2c, 0xa7, 0x2c, 0x34,
+		0xbe, 0x13, 0xba, 0xb6, 0xd6, 0xb4, 0x1e, 0x12, 0x7f, 0x01, 0xd2, 0x82, 0xf8, 0xc0, 0xe8, 0xeb,
+		0x4d, 0x73, 0xdb, 0x68, 0xbf, 0x4f, 0x6f, 0xcd, 0x40, 0xfa, 0x13, 0x43, 0x43, 0xb5, 0xe1, 0x41,
+		0x47, 0xca, 0x15, 0x90, 0x1d, 0x5b, 0x45, 0x6d, 0x77, 0xe9, 0xee, 0xdd, 0xc1, 0x14, 0x7f, 0x4e,
+		0x8c, 0x94, 0x83, 0x57, 0xa1, 0x68, 0x2b, 0x65, 0x60, 0x57, 0x66, 0x66, 0x15, 0xc9, 0x4f, 0x72,
+		0x42, 0xf3, 0x2e, 0x16, 0x57, 0x1c, 0x4d, 0xb3, 0xdb, 0xd3, 0xfa, 0xb3, 0xe8, 0xbf, 0x9f, 0x17,
+		0x8a, 0x83, 0xa3, 0x70, 0xc5, 0x81, 0x16, 0x1d, 0xae, 0xf6, 0x33, 0x50, 0xf8, 0x05, 0xa1, 0x38,
+		0x04, 0x0e, 0x27, 0x21, 0x0c, 0x86, 0x19, 0x48, 0xfc, 0xa2, 0x20, 0x21, 0x70, 0x90, 0xc4, 0x3b,
+		0xdd, 0x85, 0xb6, 0xaf, 0x6f, 0xb7, 0x2d, 0x9b, 0x5f, 0x6e, 0x3b, 0x98, 0xd4, 0x2f, 0x7d, 0xce,
+		0x6f, 0x84, 0x29, 0x1e, 0x54, 0xd4, 0x44, 0x3c, 0x5c, 0x4c, 0xb7, 0x43, 0xa6, 0x37, 0xec, 0x97,
+		0x85, 0x26, 0xf2, 0xa0, 0x61, 0xdb, 0x3c, 0x16, 0x22, 0xb2, 0xbd, 0x89, 0x1e, 0xe6, 0x0c, 0xe4,
+		0xfe, 0xbf, 0xa1, 0xc6, 0xd5, 0x05, 0x2e, 0xd2, 0xf4, 0xd8, 0x3f, 0x03, 0xe3, 0x86, 0xbe, 0x3f,
+		0x93, 0x74, 0xfe, 0xca, 0x90, 0xfd, 0xb3, 0xc1, 0x30, 0x99, 0x0e, 0x49, 0x0d, 0xd9, 0x53, 0x64,
+		0xda, 0xe5, 0xd9, 0xf4, 0xd7, 0x7d, 0x9e, 0xf7, 0xd7, 0x6f, 0x4e, 0xad, 0xac, 0x82, 0xcc, 0x73,
+		0x5c, 0x03, 0x76, 0x2a, 0xb1, 0xf7, 0x7f, 0xde, 0x91, 0x73, 0x9f, 0xcd, 0xb3, 0x72, 0x09, 0xe6,
+		0x7d, 0x06, 0xcf, 0x74, 0x52, 0x1f, 0xe0, 0xa4, 0x12, 0x5e, 0x7b, 0x67, 0xe5, 0x49, 0x08, 0xa1,
+		0xf1, 0x32, 0x1d, 0xfd, 0x1b, 0x38, 0x3a, 0x05, 0x5f, 0x79, 0x1b, 0x44, 0x85, 0xd1, 0x32, 0x1d,
+		0xf5, 0x1b, 0x39, 0xaa, 0x83, 0x82, 0xe8, 0xc2, 0x60, 0x99, 0x8e, 0xfe, 0x4d, 0x02, 0x5d, 0xa0,
+		0x20, 0xfa, 0xec, 0x2c, 0xfc, 0xf5, 0x6f, 0x09, 0x31, 0x74, 0x81, 0xb2, 0x82, 0xf7, 0x6d, 0x98,
+		0xa5, 0x32, 0x1d, 0xfb, 0x9b, 0x79, 0xe5, 0x02, 0x63, 0xe5, 0x29, 0x98, 0x9b, 0x91, 0xe1, 0xdf,
+		0xc6, 0x51, 0x19, 0xfc, 0x4a, 0x11, 0xe2, 0x1e, 0xeb, 0x64, 0x3a, 0xfa, 0xb7, 0x73, 0x74, 0x2f,
+		0x16, 0x36, 0x9d, 0x5b, 0x27, 0xd3, 0x09, 0x7c, 0x87, 0x68, 0x3a, 0xc7, 0x40, 0xb6, 0x09, 0xc3,
+		0x64, 0x3a, 0xf6, 0x87, 0x04, 0xd7, 0x05, 0xca, 0xca, 0xb3, 0x10, 0x73, 0x16, 0x9b, 0xe9, 0xf8,
+		0xdf, 0xc9, 0xf1, 0x5d, 0x1c, 0xe4, 0xc0, 0xc0, 0x38, 0x04, 0x89, 0xff, 0x4b, 0x70, 0xc0, 0x83,
+		0x85, 0xd3, 0x68, 0xd8, 0x80, 0x99, 0x4e, 0xe9, 0xbb, 0xc4, 0x34, 0x1a, 0xb2, 0x5f, 0x70, 0x34,
+		0xa9, 0xce, 0x9f, 0x4e, 0xe2, 0xbb, 0xc5, 0x68, 0x52, 0x78, 0x6c, 0xc6, 0xb0, 0x45, 0x30, 0x9d,
+		0xc6, 0xf7, 0x8a, 0x66, 0x0c, 0x19, 0x04, 0x2b, 0xeb, 0x40, 0x46, 0xad, 0x81, 0xe9, 0xf4, 0x5e,
+		0xe5, 0xf4, 0x16, 0x46, 0x8c, 0x81, 0x95, 0xe7, 0xe1, 0xd8, 0x78, 0x4b, 0x60, 0x3a, 0xd5, 0xef,
+		0xfb, 0xfc, 0x90, 0xef, 0xe6, 0x35, 0x04, 0x56, 0x1a, 0xb0, 0x34, 0xce, 0x0a, 0x98, 0x4e, 0xf6,
+		0xfb, 0x3f, 0xef, 0x57, 0xdc, 0x5e, 0x23, 0x60, 0x25, 0x0f, 0xe0, 0x2e, 0xc0, 0xd3, 0x69, 0xfd,
+		0x20, 0xa7, 0xe5, 0x41, 0xc2, 0xa9, 0xc1, 0xd7, 0xdf, 0xe9, 0xf8, 0xb7, 0xc4, 0xd4, 0xe0, 0x18,
+		0x38, 0x35, 0xc4, 0xd2, 0x3b, 0x1d, 0xfb, 0x87, 0xc4, 0xd4, 0x10, 0x28, 0x28, 0xd9, 0x9e, 0xd5,
+		0x6d, 0x3a, 0x85, 0x8f, 0x08, 0xc9, 0xf6, 0x60, 0xad, 0x54, 0x61, 0x61, 0x64, 0x41, 0x9c, 0x4e,
+		0xea, 0xa3, 0x9c, 0x94, 0x3c, 0xbc, 0x1e, 0x7a, 0x17, 0x2f, 0xbe, 0x18, 0x4e, 0xa7, 0xf6, 0x23,
+		0x43, 0x8b, 0x17, 0x5f, 0x0b, 0x57, 0x9e, 0x81, 0xa8, 0x31, 0xe8, 0x74, 0x70, 0xf2, 0x90, 0x83,
+		0x2f, 0x35, 0xa7, 0x3f, 0xf3, 0x05, 0xce, 0x1d, 0x81, 0xb0, 0xf2, 0x24, 0xcc, 0xe9, 0xdd, 0x4d,
+		0xbd, 0x35, 0x0d, 0xf3, 0xb3, 0x5f, 0x10, 0x0a, 0x13, 0xa1, 0x57, 0x9e, 0x05, 0x60, 0xa1, 0x11,
+		0x7a, 0xe4, 0x7d, 0x0a, 0xee, 0x5f, 0x7c, 0x81, 0x5f, 0xfa, 0x73, 0x51, 0x5c, 0x02, 0xec, 0x0a,
+		0xe1, 0xc1, 0x04, 0x3e, 0xe7, 0x27, 0x40, 0x47, 0xe4, 0x22, 0x44, 0x70, 0x43, 0xda, 0xd6, 0xb6,
+		0xa7, 0x61, 0xff, 0x25, 0xc7, 0x16, 0xf0, 0xc8, 0xb0, 0xae, 0xd9, 0xd7, 0x6d, 0x6d, 0xdb, 0x9a,
+		0x86, 0xfb, 0xdf, 0x39, 0xae, 0x83, 0x80, 0xc8, 0x4d, 0xcd, 0xb2, 0x67, 0xe9, 0xf7, 0x5f, 0x09,
+		0x64, 0x81, 0x80, 0x8d, 0xc6, 0xdf, 0x37, 0xf4, 0xfd, 0x69, 0xb8, 0x7f, 0x2d, 0x1a, 0xcd, 0xe1,
+		0x57, 0xde, 0x06, 0x31, 0xfc, 0xc9, 0xae, 0x06, 0x4f, 0x41, 0xfe, 0x1b, 0x8e, 0xec, 0x62, 0x60,
+		0xcd, 0x96, 0xdd, 0xb2, 0xdb, 0xd3, 0x99, 0xfd, 0xb7, 0x7c, 0xa4, 0x05, 0xfc, 0x4a, 0x1e, 0xe2,
+		0x96, 0xdd, 0x6a, 0x0d, 0xb8, 0x7d, 0x3a, 0x05, 0xfd, 0x7f, 0x7c, 0xc1, 0x09, 0x59, 0x38, 0x38,
+		0x38, 0xda, 0x2f, 0xdf, 0xb0, 0x7b, 0x26, 0x3d, 0xcb, 0x34, 0x8d, 0xc2, 0xe7, 0x39, 0x05, 0x0f,
+		0xca, 0x4a, 0x11, 0x12, 0xd8, 0x17, 0x71, 0x24, 0x64, 0x1a, 0x89, 0xbf, 0xe3, 0x0c, 0xf0, 0x21,
+		0x15, 0xde, 0xf3, 0x5b, 0x9f, 0x3e, 0x25, 0x7d, 0xea, 0xd3, 0xa7, 0xa4, 0x3f, 0xf9, 0xf4, 0x29,
+		0xe9, 0x43, 0x7f, 0x7a, 0xea, 0xc8, 0xa7, 0xfe, 0xf4, 0xd4, 0x91, 0x3f, 0xfc, 0xd3, 0x53, 0x47,
+		0xc6, 0x47, 0x89, 0xe1, 0xb2, 0x79, 0xd9, 0x64, 0xf1, 0xe1, 0x17, 0xef, 0xdb, 0x6e, 0xdb, 0x3b,
+		0x83, 0xcd, 0xe5, 0xa6, 0xd9, 0x3d, 0xd7, 0x34, 0xad, 0xae, 0x69, 0x9d, 0xf3, 0xc7, 0x75, 0xe9,
+		0x2f, 0xf8, 0x27, 0x09, 0x4e, 0x30, 0x32, 0x6e, 0x38, 0x57, 0x33, 0xf6, 0x27, 0x3d, 0x79, 0x75,
+		0x01, 0x82, 0x79, 0x63, 0x9f, 0x9c, 0x60, 0x0a, 0x4e, 0x1d, 0xf4, 0x3b, 0xfc, 0x3a, 0x69, 0x04,
+		0xd3, 0x1b, 0xfd, 0x8e, 0xff, 0xc4, 0x7d, 0x82, 0x9f, 0xb8, 0x2f, 0x7c, 0xbb, 0x74, 0xb8, 0x9e,
+		0x44, 0xf3, 0xc6, 0x3e, 0xed, 0xc8, 0xba, 0xf4, 0xe2, 0xc3, 0x53, 0xe3, 0xdc, 0x37, 0x0c, 0xf3,
+		0x65, 0x03, 0x9b, 0xdd, 0xdb, 0x14, 0x31, 0xee, 0x53, 0xc3, 0x31, 0xee, 0xe7, 0xf5, 0x4e, 0xe7,
+		0x39, 0x84, 0xc3, 0x5d, 0x78, 0x6b, 0x33, 0xcc, 0x9e, 0x42, 0x80, 0xef, 0x0a, 0xc0, 0xa9, 0x91,
+		0x70, 0x36, 0x17, 0x82, 0x49, 0x4c, 0x58, 0x81, 0x68, 0x49, 0xc8, 0x56, 0x1a, 0x5f, 0x8d, 0x6a,
+		0x9a, 0x46, 0x8b, 0x9d, 0x86, 0x0e, 0x2a, 0x22, 0x89, 0x8c, 0x30, 0x34, 0xc3, 0xb4, 0xf8, 0xf5,
+		0x6c, 0x96, 0x28, 0xfc, 0xc0, 0x21, 0x19, 0x31, 0x2f, 0x6a, 0x12, 0xdc, 0x78, 0x6c, 0x46, 0x6e,
+		0x88, 0x4e, 0xf8, 0x22, 0xff, 0xb3, 0x72, 0xe5, 0x7b, 0x03, 0x70, 0x7a, 0x98, 0x2b, 0x38, 0xb3,
+		0x2c, 0x5b, 0xeb, 0xf6, 0x26, 0xb1, 0xe5, 0x19, 0x88, 0x35, 0x04, 0xcc, 0xa1, 0xf9, 0x72, 0xeb,
+		0x90, 0x7c, 0x49, 0x3a, 0x55, 0x09, 0xc6, 0x9c, 0x9f, 0x91, 0x31, 0x4e, 0x3f, 0x6e, 0x8b, 0x33,
+		0xff, 0x10, 0x86, 0x13, 0x6c, 0x3a, 0xa9, 0x6c, 0x2a, 0xb1, 0x04, 0xe7, 0x49, 0xc2, 0x5b, 0x34,
+		0x7d, 0x9f, 0x24, 0xf7, 0x1c, 0x2c, 0x56, 0x50, 0x5b, 0xa0, 0x17, 0xe4, 0xee, 0xf0, 0x8c, 0xbd,
+		0xb3, 0x9e, 0xf5, 0x19, 0xfc, 0x7c, 0x2b, 0xd2, 0x9b, 0x85, 0x27, 0x6a, 0xe4, 0x7a, 0x53, 0xeb,
+		0x68, 0xfd, 0x2f, 0x95, 0x14, 0x79, 0x0a, 0x80, 0x9d, 0xa1, 0x73, 0x5e, 0xc2, 0xc2, 0xcd, 0x79,
+		0x6f, 0xe7, 0x96, 0x59, 0x4d, 0xf4, 0xda, 0x53, 0x8c, 0xc2, 0xe2, 0xcf, 0xb3, 0x2f, 0x00, 0xb8,
+		0x05, 0x78, 0xa8, 0xa4, 0x5e, 0xcc, 0xaf, 0xe6, 0x15, 0x71, 0xda, 0xa5, 0xbe, 0x5e, 0x2e, 0xb2,
+		0x57, 0x65, 0x8e, 0xe0, 0x89, 0x0d, 0x6f, 0xa1, 0x73, 0x0b, 0xe4, 0x28, 0x2c, 0x78, 0xf3, 0xd9,
+		0xd3, 0x1e, 0x01, 0xb4, 0x14, 0xdb, 0xdd, 0x5e, 0x47, 0xa7, 0x5b, 0xc6, 0x6a, 0x5b, 0x70, 0x6d,
+		0xba, 0x11, 0xf2, 0xdb, 0xff, 0x91, 0x3d, 0xf7, 0xb0, 0xe8, 0xa2, 0x3b, 0x3c, 0x5f, 0x59, 0x85,
+		0x05, 0xbc, 0x46, 0xd9, 0xf3, 0x91, 0x9c, 0xa2, 0xaa, 0x91, 0x20, 0xdd, 0x04, 0xe7, 0x98, 0x2e,
+		0xb5, 0xa7, 0x20, 0x6c, 0xd1, 0xde, 0x4f, 0x23, 0xf1, 0x3b, 0x9c, 0x04, 0x07, 0x5f, 0x31, 0x60,
+		0x81, 0xbd, 0x72, 0xa4, 0x7b, 0x9a, 0x71, 0x70, 0x9c, 0xe1, 0x57, 0x7f, 0xee, 0x51, 0xba, 0x09,
+		0x7e, 0x8f, 0x7f, 0x58, 0xc6, 0x88, 0x93, 0x22, 0x73, 0xda, 0x6e, 0x43, 0x75, 0x48, 0x8a, 0xfa,
+		0x78, 0x83, 0x0f, 0xae, 0xec, 0xd7, 0x78, 0x65, 0xa7, 0xc6, 0xc9, 0x80, 0xa7, 0xa6, 0x79, 0x4e,
+		0x95, 0x15, 0x14, 0xca, 0x93, 0xe6, 0xf4, 0x8b, 0x0f, 0x8d, 0xae, 0x4e, 0xec, 0xdf, 0x23, 0x94,
+		0xf2, 0x33, 0xde, 0x6a, 0x9c, 0xb9, 0xf7, 0x47, 0x41, 0x58, 0xc0, 0x43, 0x3c, 0xe6, 0x39, 0xfa,
+		0x97, 0xcf, 0xb9, 0x39, 0x9a, 0x98, 0x61, 0x53, 0xf2, 0x02, 0x9b, 0x0a, 0xd3, 0x25, 0xe6, 0x6f,
+		0xbe, 0xf5, 0x63, 0x73, 0xee, 0x74, 0x59, 0x59, 0x1b, 0x3d, 0x3f, 0x39, 0x9d, 0xc6, 0xdf, 0x0a,
+		0x1a, 0xc3, 0xc7, 0x21, 0x57, 0xde, 0x8a, 0x3b, 0x7d, 0x9c, 0xcc, 0x34, 0xcb, 0x44, 0x10, 0x71,
+		0x30, 0xd0, 0x2e, 0x61, 0x33, 0x73, 0x16, 0x2b, 0xf4, 0xf3, 0x02, 0x9f, 0xcd, 0x50, 0x3c, 0x7d,
+		0xbc, 0x72, 0x19, 0x92, 0x2d, 0xd3, 0xb0, 0x55, 0xb3, 0xdb, 0xb6, 0xf5, 0x6e, 0xcf, 0x9e, 0x6a,
+		0xd7, 0xfd, 0x1d, 0x23, 0x12, 0x55, 0xe6, 0x11, 0xaf, 0x26, 0xd0, 0x6e, 0x6b, 0x70, 0xad, 0xd6,
+		0x0d, 0xae, 0x8b, 0xed, 0x3d, 0x36, 0x88, 0xce, 0xe0, 0xfe, 0x55, 0x10, 0x4e, 0x71, 0xe0, 0x4d,
+		0xcd, 0xd2, 0xcf, 0xed, 0x3e, 0xb6, 0xa9, 0xdb, 0xda, 0x63, 0xe7, 0x9a, 0x66, 0x5b, 0x2c, 0xc4,
+		0x8b, 0xac, 0x7c, 0x19, 0xcb, 0x97, 0x79, 0x79, 0x66, 0xec, 0x6e, 0x75, 0x66, 0xb2, 0x8e, 0xce,
+		0x8c, 0x8a, 0x50, 0xee, 0x45, 0x08, 0x15, 0xcd, 0x36, 0xbd, 0x2d, 0xd8, 0xd2, 0x0d, 0xb3, 0xcb,
+		0xb5, 0x25, 0x4b, 0x90, 0x0b, 0x10, 0xd6, 0xba, 0xe6, 0xc0, 0xb0, 0x99, 0xa6, 0x2c, 0x9c, 0xfa,
+		0xad, 0xd7, 0x4e, 0x1f, 0xf9, 0xa3, 0xd7, 0x4e, 0x07, 0x2b, 0x86, 0xfd, 0xfb, 0x9f, 0x7c, 0x04,
+		0x38, 0xf5, 0x8a, 0x61, 0x7f, 0xfc, 0xf5, 0x9f, 0x39, 0x2b, 0x29, 0x1c, 0x7a, 0x25, 0xf4, 0xc6,
+		0x87, 0x4f, 0x4b, 0xb9, 0x17, 0x20, 0x52, 0xd2, 0x9b, 0x07, 0x90, 0x7f, 0x6c, 0x88, 0xfc, 0x09,
+		0x41, 0xbe, 0xa4, 0x37, 0x3d, 0xe4, 0x4b, 0x7a, 0x73, 0x88, 0xf2, 0x53, 0x10, 0xad, 0x18, 0x36,
+		0x7b, 0xe5, 0xe4, 0x21, 0x08, 0xb6, 0x0d, 0x76, 0x9f, 0xdc, 0x43, 0x61, 0xa4, 0x81, 0x0a, 0x42,
+		0x21, 0x62, 0x49, 0x6f, 0x3a, 0x88, 0x2d, 0xbd, 0x99, 0x96, 0xa6, 0x55, 0x8d, 0x50, 0x85, 0xd2,
+		0x1f, 0xfe, 0xb7, 0x53, 0x47, 0x5e, 0xf9, 0xf4, 0xa9, 0x23, 0x13, 0x87, 0x3e, 0x37, 0x7d, 0xe8,
+		0x9d, 0x11, 0xff, 0x58, 0x08, 0xee, 0xa6, 0xaf, 0x69, 0xf5, 0xbb, 0x6d, 0xc3, 0x3e, 0xd7, 0xec,
+		0xef, 0xf7, 0x6c, 0x13, 0xe7, 0xaf, 0xb9, 0xc5, 0x07, 0x7c, 0xc1, 0x2d, 0x5e, 0x66, 0xc5, 0xe3,
+		0x87, 0x3b, 0xb7, 0x05, 0x73, 0xeb, 0x88, 0x87, 0x2c, 0xb6, 0x4d, 0x5b, 0xeb, 0x70, 0xa3, 0x83,
+		0x25, 0x30, 0x97, 0xbd, 0xc0, 0x15, 0x60, 0xb9, 0x6d, 0xf1, 0xf8, 0x56, 0x47, 0xd7, 0xb6, 0xd8,
+		0x93, 0x1d, 0x41, 0x6a, 0xad, 0x46, 0x31, 0x83, 0xbe, 0xce, 0xb1, 0x04, 0x73, 0xda, 0x80, 0x1d,
+		0x78, 0x0a, 0xa2, 0x19, 0x4b, 0x13, 0xb9, 0xe7, 0x20, 0xc2, 0xb7, 0xcf, 0xf1, 0x8c, 0xcf, 0x0d,
+		0x7d, 0x9f, 0xd6, 0x93, 0x50, 0xf0, 0x27, 0x59, 0x86, 0x39, 0xda, 0x78, 0x7e, 0xb1, 0x29, 0xbd,
+		0x3c, 0xd2, 0xfa, 0x65, 0xda, 0x48, 0x85, 0x81, 0xe5, 0xae, 0x42, 0xb4, 0x64, 0xa2, 0x14, 0xfa,
+		0xa9, 0xc5, 0x18, 0x35, 0xda, 0xe6, 0xde, 0xc0, 0x16, 0x37, 0x57, 0x69, 0x02, 0xef, 0x82, 0xb3,
+		0x77, 0x68, 0xf8, 0xa1, 0x2d, 0x9e, 0xca, 0x15, 0x21, 0x42, 0x69, 0xd7, 0x7a, 0xce, 0xab, 0x71,
+		0x92, 0xe7, 0xd5, 0x38, 0x4e, 0x3e, 0xe0, 0x36, 0x96, 0x40, 0xa8, 0xa5, 0xd9, 0x1a, 0xef, 0x37,
+		0xfd, 0x9d, 0x7b, 0x3b, 0x44, 0x39, 0x11, 0x8b, 0x9c, 0x87, 0xa0, 0xd9, 0x13, 0x67, 0x12, 0x33,
+		0x93, 0xba, 0x52, 0xeb, 0x15, 0x42, 0x28, 0x33, 0x0a, 0x02, 0x17, 0xaa, 0x13, 0xc5, 0xe2, 0x09,
+		0x9f, 0x58, 0x74, 0x75, 0x7b, 0x73, 0xcb, 0x76, 0x7f, 0xb0, 0xe1, 0x1c, 0x11, 0x05, 0x47, 0x50,
+		0x6e, 0x05, 0xe0, 0x94, 0xa7, 0x74, 0x57, 0xef, 0x63, 0xfc, 0x88, 0x49, 0x13, 0x97, 0x14, 0xe2,
+		0x69, 0x20, 0x2f, 0x9f, 0x20, 0x2a, 0x6f, 0x83, 0x60, 0xbe, 0xd7, 0xc3, 0xb7, 0xdd, 0x68, 0xba,
+		0x69, 0x32, 0x59, 0x09, 0x29, 0x4e, 0x1a, 0xcb, 0x2c, 0x73, 0xcb, 0x7e, 0x59, 0xeb, 0x3b, 0xef,
+		0xbe, 0x89, 0x74, 0xee, 0x22, 0xc4, 0x8a, 0xa6, 0x61, 0xe9, 0x86, 0x35, 0xa0, 0xa6, 0xec, 0x66,
+		0xc7, 0x6c, 0xde, 0xe0, 0x14, 0x58, 0x02, 0x99, 0xad, 0xf5, 0x7a, 0x14, 0x33, 0xa4, 0xe0, 0x4f,
+		0x36, 0x5f, 0x0b, 0xb5, 0x89, 0xec, 0x79, 0xf2, 0x70, 0xec, 0xe1, 0x1d, 0x74, 0x6d, 0x52, 0x09,
+		0xee, 0x1a, 0x9d, 0x48, 0x37, 0xf4, 0x7d, 0xeb, 0xb0, 0xf3, 0xe8, 0x05, 0x88, 0xad, 0xd3, 0x57,
+		0x7f, 0x9f, 0xd3, 0xf7, 0x49, 0x06, 0x8f, 0x67, 0x9e, 0x7f, 0xf2, 0xc9, 0xc7, 0x2e, 0x32, 0x29,
+		0xbf, 0x72, 0x44, 0x11, 0x19, 0xe4, 0x14, 0xc4, 0x2c, 0xbd, 0xd9, 0x3b, 0xff, 0xe4, 0x85, 0x1b,
+		0x8f, 0x31, 0xb1, 0xba, 0x72, 0x44, 0x71, 0xb3, 0x56, 0xa2, 0xd8, 0xe3, 0x37, 0x3e, 0x72, 0x5a,
+		0x2a, 0xcc, 0x41, 0xd0, 0x1a, 0x74, 0xbf, 0x6c, 0xb2, 0xf1, 0x3d, 0x73, 0x90, 0xf5, 0x94, 0xb2,
+		0xc5, 0x85, 0xdf, 0xb4, 0x70, 0x5e, 0x6e, 0x96, 0x3d, 0xfd, 0xa7, 0x10, 0x13, 0x56, 0x8d, 0x03,
+		0xb9, 0x98, 0xfb, 0x84, 0x04, 0x89, 0x6b, 0x82, 0x32, 0xde, 0xba, 0x79, 0x06, 0xc0, 0xa9, 0x49,
+		0x4c, 0x95, 0x93, 0xcb, 0xc3, 0x75, 0x2d, 0x3b, 0x38, 0x8a, 0x07, 0x1c, 0xaf, 0x95, 0xf5, 0xfa,
+		0x66, 0xcf, 0xb4, 0xf8, 0x03, 0x5e, 0x53, 0x50, 0x1d, 0x60, 0xbc, 0xe3, 0x44, 0xb5, 0x9a, 0xba,
+		0x6b, 0xda, 0x78, 0x32, 0xa4, 0x67, 0xbe, 0xcc, 0x5f, 0x56, 0x0c, 0x2a, 0x32, 0x2d, 0xb9, 0x46,
+		0x0b, 0xd6, 0x31, 0x1f, 0x1b, 0x1d, 0x73, 0xa8, 0xa0, 0x57, 0xa6, 0xb5, 0x5a, 0x7d, 0xdd, 0xb2,
+		0xb8, 0xe2, 0x12, 0x49, 0x7c, 0x35, 0xac, 0x37, 0xd8, 0x54, 0x85, 0x96, 0xc0, 0xa7, 0xdb, 0xc6,
+		0xcc, 0x79, 0x21, 0x1b, 0x7c, 0xd6, 0x87, 0x7b, 0x83, 0x4d, 0x94, 0x94, 0x7b, 0x20, 0x31, 0xa6,
+		0x31, 0xf1, 0x5d, 0xb7, 0x1d, 0xf4, 0xa1, 0x69, 0xde, 0x03, 0xb5, 0xd7, 0x6f, 0x9b, 0x7d, 0xbc,
+		0xb7, 0x10, 0x62, 0x8d, 0x16, 0x05, 0xeb, 0x3c, 0x3f, 0x77, 0x03, 0x52, 0x75, 0x6a, 0xad, 0xbb,
+		0x2d, 0x7f, 0xd2, 0x6d, 0x9f, 0x34, 0xbd, 0x7d, 0x13, 0x5b, 0x16, 0x18, 0x69, 0x59, 0x61, 0x6d,
+		0xa2, 0x64, 0x3e, 0x7e, 0x38, 0xc9, 0xf4, 0xaf, 0x6e, 0x7f, 0x71, 0x02, 0xee, 0x1a, 0x2e, 0xf4,
+		0xa9, 0xac, 0x59, 0x85, 0x72, 0x9a, 0x23, 0x9e, 0x39, 0x78, 0x11, 0xcd, 0x4c, 0x51, 0x9d, 0x99,
+		0xa9, 0xd3, 0x27, 0x77, 0x11, 0xe6, 0xf1, 0x5e, 0x5a, 0x5d, 0xb7, 0xaf, 0xe8, 0x5a, 0x4b, 0xef,
+		0xfb, 0x57, 0xd9, 0x79, 0xb1, 0xca, 0x12, 0x08, 0xd1, 0xa5, 0x94, 0xad, 0x32, 0xf4, 0x77, 0x6e,
+		0x07, 0x42, 0x88, 0xea, 0xae, 0xc0, 0x1c, 0x83, 0x26, 0x30, 0x77, 0x73, 0xdf, 0xe6, 0x57, 0x81,
+		0x13, 0x0a, 0x4b, 0x90, 0x27, 0xc4, 0x3a, 0x1a, 0x3c, 0x78, 0x1d, 0xe5, 0x42, 0xc8, 0x57, 0xd3,
+		0x0e, 0x44, 0x0a, 0xa8, 0x7e, 0x2b, 0x25, 0xa7, 0x21, 0x92, 0xdb, 0x10, 0xb2, 0x06, 0xa9, 0x9e,
+		0xd6, 0xb7, 0xe9, 0x0b, 0x3c, 0x3b, 0xb4, 0x17, 0x5c, 0xce, 0x4f, 0x8f, 0xce, 0x3a, 0x5f, 0x67,
+		0x79, 0x2d, 0xf3, 0x3d, 0x6f, 0x66, 0xee, 0xcf, 0x43, 0x10, 0xe6, 0xcc, 0x78, 0x1b, 0x44, 0x38,
+		0x5b, 0xb9, 0x64, 0xde, 0xbd, 0x3c, 0xba, 0x18, 0x2d, 0x3b, 0x8b, 0x06, 0xa7, 0x27, 0x70, 0xc8,
+		0xfd, 0x10, 0x6d, 0xee, 0x68, 0x6d, 0x43, 0x6d, 0xb7, 0xb8, 0x01, 0x18, 0xff, 0xf4, 0x6b, 0xa7,
+		0x23, 0x45, 0xcc, 0xab, 0x94, 0x94, 0x08, 0x2d, 0xac, 0xb4, 0x70, 0xe5, 0xdf, 0xd1, 0xdb, 0xdb,
+		0x3b, 0x36, 0x9f, 0x5d, 0x3c, 0x85, 0xef, 0xcd, 0xa3, 0x40, 0xf0, 0x03, 0xf0, 0x99, 0x11, 0x5b,
+		0xde, 0x89, 0x93, 0x14, 0xa2, 0x58, 0xf1, 0x87, 0xfe, 0xeb, 0x69, 0x49, 0xa1, 0x18, 0xa4, 0x08,
+		0xf3, 0x1d, 0xcd, 0xb2, 0x55, 0xba, 0x6a, 0x61, 0xf5, 0xec, 0xe4, 0xfb, 0x89, 0x51, 0x86, 0x70,
+		0xc6, 0xf2, 0xa6, 0xc7, 0x11, 0x8b, 0x65, 0xb5, 0xf0, 0x6d, 0x2a, 0x4a, 0x04, 0xcf, 0x7a, 0xb7,
+		0x6d, 0x66, 0x4b, 0x85, 0x29, 0xdf, 0x93, 0x98, 0x5f, 0xa4, 0xd9, 0xd4, 0xa2, 0x3a, 0x09, 0x31,
+		0xfa, 0xdc, 0x14, 0x05, 0x61, 0xf7, 0x28, 0xa3, 0x98, 0x41, 0x0b, 0x1f, 0x80, 0x94, 0xab, 0x1b,
+		0x19, 0x48, 0x94, 0x51, 0x71, 0xb3, 0x29, 0xe0, 0xa3, 0xb0, 0x64, 0xe8, 0x7b, 0xb6, 0x3a, 0x0c,
+		0x1d, 0xa3, 0xd0, 0x04, 0xcb, 0xae, 0xf9, 0x31, 0xee, 0x83, 0x64, 0x53, 0x30, 0x9f, 0xc1, 0x02,
+		0x85, 0x9d, 0x77, 0x72, 0x29, 0xd8, 0x09, 0x88, 0x6a, 0xbd, 0x1e, 0x03, 0x88, 0x73, 0xdd, 0xd8,
+		0xeb, 0xd1, 0xa2, 0xb3, 0xb0, 0x40, 0xfb, 0xd8, 0xd7, 0x2d, 0xbc, 0x5a, 0xc1, 0x60, 0x12, 0x14,
+		0x26, 0x85, 0x05, 0x0a, 0xcb, 0xa7, 0xb0, 0xf7, 0xc2, 0xbc, 0xbe, 0x8b, 0x97, 0x58, 0x9b, 0x3a,
+		0x83, 0x9b, 0xa7, 0x70, 0x09, 0x91, 0x49, 0x81, 0x1e, 0x04, 0x47, 0xe7, 0xa9, 0x42, 0x1f, 0x27,
+		0x19, 0x3d, 0x91, 0x9f, 0x67, 0xd9, 0xb9, 0x34, 0x84, 0x4a, 0x9a, 0xad, 0xa1, 0x51, 0x61, 0xef,
+		0xb1, 0x45, 0x26, 0xa1, 0xe0, 0xcf, 0xdc, 0x1b, 0x01, 0x08, 0x5d, 0x33, 0x6d, 0x9d, 0x3c, 0xee,
+		0x31, 0xf8, 0x92, 0xe3, 0xe4, 0xb9, 0xde, 0xde, 0x36, 0xf4, 0xd6, 0x9a, 0xb5, 0xed, 0x79, 0xf8,
+		0xd6, 0x15, 0xa7, 0x80, 0x4f, 0x9c, 0x96, 0x60, 0xae, 0x6f, 0x0e, 0x8c, 0x96, 0x38, 0xd0, 0x4e,
+		0x13, 0xa4, 0x0c, 0x51, 0x47, 0x4a, 0x42, 0xd3, 0xa4, 0x24, 0x85, 0x52, 0x82, 0x32, 0xcc, 0x33,
+		0x94, 0xc8, 0x26, 0x17, 0x96, 0x02, 0xc4, 0x1c, 0xe5, 0x95, 0x9e, 0x3b, 0x84, 0xc0, 0xba, 0x68,
+		0xb8, 0x90, 0x38, 0x63, 0xef, 0x30, 0x8f, 0x49, 0x9c, 0xec, 0x14, 0x70, 0xee, 0xf9, 0xc4, 0x8a,
+		0x3f, 0xc2, 0x1b, 0xa1, 0xfd, 0x72, 0xc5, 0x8a, 0x3d, 0xc4, 0x7b, 0x17, 0x1e, 0x3b, 0xdb, 0x36,
+		0xe8, 0xd5, 0x0d, 0x2e, 0x79, 0x6e, 0x46, 0xee, 0xd7, 0x25, 0x08, 0x33, 0x49, 0xf6, 0xf0, 0x4d,
+		0x1a, 0xcf, 0xb7, 0xc0, 0x24, 0xbe, 0x05, 0x6f, 0x9f, 0x6f, 0x79, 0x00, 0xa7, 0x31, 0x16, 0x7f,
+		0x1b, 0x75, 0x8c, 0xb5, 0xc0, 0x9a, 0x58, 0x6f, 0x6f, 0xf3, 0x89, 0xea, 0x41, 0xc2, 0x17, 0x05,
+		0x62, 0x4e, 0x39, 0xc9, 0xc3, 0xbc, 0x68, 0x97, 0xba, 0xd5, 0xd1, 0xb6, 0xb9, 0xec, 0xdc, 0x3d,
+		0xb1, 0x71, 0x97, 0x3a, 0xda, 0xb6, 0x12, 0xe7, 0xed, 0xc1, 0xc4, 0xf8, 0x71, 0x08, 0x4c, 0x18,
+		0x07, 0xdf, 0xc0, 0x07, 0x6f, 0x6f, 0xe0, 0x7d, 0x43, 0x14, 0x1a, 0x1e, 0xa2, 0x9f, 0x0b, 0x50,
+		0xe7, 0xa5, 0x67, 0x5a, 0x5a, 0xe7, 0x2b, 0x31, 0x23, 0x4e, 0x42, 0xac, 0x67, 0x76, 0x54, 0x56,
+		0xc2, 0x2e, 0x7a, 0x44, 0x7b, 0x66, 0x47, 0x19, 0x19, 0xf6, 0xb9, 0x3b, 0x34, 0x5d, 0xc2, 0x77,
+		0x80, 0x6b, 0x91, 0x61, 0xae, 0xf5, 0x21, 0xc1, 0x58, 0xc1, 0xd7, 0xb2, 0x47, 0x91, 0x07, 0xf8,
+		0x2b, 0x2d, 0x8d, 0xae, 0xbd, 0xac, 0xd9, 0x0c, 0x52, 0x09, 0xef, 0x38, 0x18, 0x4c, 0xf5, 0xa7,
+		0x03, 0x93, 0x30, 0x98, 0xd8, 0x29, 0x1c, 0x2e, 0xf7, 0x3d, 0x12, 0xc0, 0x2a, 0x72, 0x96, 0xf6,
+		0x17, 0x57, 0x21, 0x8b, 0x36, 0x41, 0xf5, 0xd5, 0x7c, 0x6a, 0xd2, 0xa0, 0xf1, 0xfa, 0x13, 0x96,
+		0xb7, 0xdd, 0x45, 0x98, 0x77, 0x85, 0xd1, 0xd2, 0x45, 0x63, 0x4e, 0x1d, 0x60, 0x51, 0xe3, 0x3d,
+		0xae, 0xc4, 0xae, 0x27, 0x95, 0xfb, 0xd7, 0x12, 0xc4, 0x68, 0x9b, 0xf0, 0xf1, 0x43, 0xdf, 0x18,
+		0x4a, 0xb7, 0x3f, 0x86, 0x77, 0x03, 0x30, 0x32, 0xb8, 0x09, 0xcf, 0x25, 0x2b, 0x46, 0x73, 0x70,
+		0x6b, 0x1d, 0x63, 0x4b, 0xbc, 0xdb, 0xc1, 0x83, 0x19, 0x2e, 0x2c, 0x6e, 0xce, 0xf6, 0xe3, 0x10,
+		0xa1, 0x77, 0xeb, 0xf7, 0x2c, 0x6e, 0x44, 0xe3, 0xeb, 0xbf, 0x8d, 0x3d, 0x2b, 0xf7, 0x12, 0x44,
+		0x1a, 0x7b, 0x2c, 0x16, 0x72, 0x12, 0x62, 0x7d, 0xd3, 0xe4, 0x6b, 0x32, 0xb3, 0x85, 0xa2, 0x98,
+		0x41, 0x97, 0x20, 0xe1, 0xff, 0x07, 0x5c, 0xff, 0xdf, 0x0d, 0x60, 0x04, 0x67, 0x0a, 0x60, 0x9c,
+		0xfd, 0x4f, 0x12, 0xc4, 0x3d, 0xfa, 0x81, 0x3c, 0x06, 0x47, 0x0b, 0xab, 0xb5, 0xe2, 0x73, 0x6a,
+		0xa5, 0xa4, 0x5e, 0x5a, 0xcd, 0x7b, 0x2e, 0x7d, 0x66, 0x8e, 0xdd, 0xbc, 0x95, 0x25, 0x1e, 0xd8,
+		0x0d, 0x83, 0x6e, 0xc6, 0x90, 0x73, 0xb0, 0xe4, 0x47, 0xc9, 0x17, 0xea, 0xf8, 0x22, 0x85, 0x94,
+		0x39, 0x7a, 0xf3, 0x56, 0x76, 0xc1, 0x83, 0x91, 0xdf, 0xb4, 0x74, 0xc3, 0x1e, 0x45, 0x28, 0xd6,
+		0xd6, 0xd6, 0xf0, 0xe6, 0xed, 0x08, 0x02, 0x57, 0xd8, 0x0f, 0xc2, 0x82, 0x1f, 0xa1, 0x5a, 0x59,
+		0x95, 0x83, 0x19, 0x72, 0xf3, 0x56, 0x36, 0xe9, 0x81, 0xae, 0xb6, 0x3b, 0x99, 0xe8, 0x07, 0x7f,
+		0xe4, 0xd4, 0x91, 0x8f, 0xff, 0xe8, 0x29, 0x09, 0x7b, 0x36, 0xef, 0xd3, 0x11, 0xe4, 0x61, 0x38,
+		0x5e, 0xaf, 0x5c, 0xae, 0x96, 0x4b, 0xea, 0x5a, 0xfd, 0xf2, 0xd0, 0xe5, 0xdd, 0x4c, 0xea, 0xe6,
+		0xad, 0x6c, 0x9c, 0x77, 0x69, 0x12, 0xf4, 0xba, 0x52, 0xbe, 0x56, 0x6b, 0x94, 0x65, 0x89, 0x41,
+		0xaf, 0xf7, 0xf5, 0x5d, 0xd3, 0x66, 0x1f, 0xaf, 0x79, 0x14, 0x4e, 0x8c, 0x81, 0x76, 0x3a, 0xb6,
+		0x70, 0xf3, 0x56, 0x76, 0x7e, 0xbd, 0xaf, 0xb3, 0xf9, 0x43, 0x31, 0x96, 0x21, 0x3d, 0x8a, 0x51,
+		0x5b, 0xaf, 0xd5, 0xf3, 0xab, 0x72, 0x36, 0x23, 0xdf, 0xbc, 0x95, 0x4d, 0x08, 0x65, 0x88, 0xf0,
+		0x6e, 0xcf, 0xbe, 0x5c, 0xde, 0xce, 0x37, 0x46, 0xe0, 0xee, 0xe1, 0xc2, 0x73, 0x3d, 0xad, 0xaf,
+		0x75, 0x0f, 0xeb, 0xee, 0x4c, 0xd9, 0x8d, 0xcd, 0xbd, 0x12, 0x80, 0x94, 0x63, 0x4c, 0xaf, 0xd3,
+		0x1a, 0xc8, 0xe3, 0xde, 0x38, 0x4c, 0x7c, 0xe2, 0x32, 0xc6, 0xa0, 0x45, 0x98, 0x06, 0xe3, 0xf0,
+		0xdc, 0x28, 0xe3, 0xea, 0x22, 0x3b, 0x8a, 0x57, 0xe6, 0x10, 0x1c, 0xd5, 0xc1, 0x20, 0xcf, 0x42,
+		0xcc, 0x51, 0x1e, 0xce, 0x3b, 0xdb, 0x93, 0xb5, 0x0d, 0xc7, 0x77, 0x71, 0xf0, 0xe9, 0x6b, 0xe1,
+		0x36, 0x84, 0x26, 0x39, 0x22, 0xd7, 0x18, 0x00, 0x47, 0x16, 0xf0, 0xb9, 0x0a, 0x9f, 0x76, 0xbc,
+		0xf7, 0xf4, 0xb1, 0xce, 0x3d, 0x95, 0x79, 0x52, 0xcc, 0x2a, 0x89, 0x76, 0xb5, 0xbd, 0x02, 0xa6,
+		0x51, 0x51, 0x60, 0xe1, 0x36, 0x7f, 0xf4, 0x2a, 0xa8, 0x84, 0xbb, 0xda, 0xde, 0x65, 0x0d, 0x2f,
+		0xad, 0x06, 0xe5, 0x50, 0xee, 0xc7, 0x25, 0x48, 0xfa, 0xfb, 0x48, 0x1e, 0x02, 0x82, 0x18, 0xb8,
+		0xdd, 0x81, 0x2a, 0x86, 0x32, 0x4b, 0xd0, 0xc5, 0xfb, 0xc0, 0xf9, 0x6d, 0xbd, 0x3a, 0xe8, 0xd2,
+		0x06, 0x58, 0x04, 0xf7, 0x46, 0x38, 0xb0, 0x18, 0x27, 0xce, 0xcc, 0x13, 0xa3, 0x1f, 0x75, 0xe1,
+		0x00, 0x6c, 0xa1, 0x7a, 0x15, 0x17, 0xaa, 0x24, 0xa3, 0x27, 0x4a, 0xfc, 0x5d, 0x09, 0xfa, 0xbb,
+		0x92, 0x7b, 0x16, 0x52, 0x43, 0xfc, 0x24, 0x39, 0x98, 0xe7, 0x51, 0x01, 0xba, 0x53, 0xc9, 0xec,
+		0xe3, 0x98, 0x12, 0x67, 0xde, 0x3f, 0xdd, 0xb9, 0x5d, 0x89, 0xfe, 0x12, 0x86, 0xa2, 0x30, 0x60,
+		0xfe, 0x10, 0xcc, 0xfb, 0x38, 0x2a, 0x22, 0x75, 0x92, 0x1b, 0xa9, 0x73, 0x81, 0x5f, 0x84, 0x04,
+		0x2a, 0x4a, 0xbd, 0xc5, 0x61, 0xef, 0x87, 0x14, 0x53, 0xe4, 0xc3, 0xbc, 0x66, 0x96, 0xd4, 0x9a,
+		0x60, 0x78, 0x0e, 0xe6, 0x5d, 0x38, 0x97, 0xed, 0x71, 0x01, 0x75, 0x59, 0xb3, 0x0a, 0xef, 0xfc,
+		0xf8, 0xa7, 0x4f, 0x49, 0x5f, 0x9e, 0x89, 0xf8, 0x7b, 0xef, 0x84, 0x93, 0x9e, 0x42, 0x6d, 0xb3,
+		0xd9, 0xf6, 0x45, 0x1d, 0x52, 0x6e, 0xe1, 0x32, 0x16, 0x4e, 0x8b, 0x1e, 0x1c, 0x18, 0xc3, 0x38,
+		0x38, 0x60, 0x96, 0x39, 0x58, 0x23, 0x4c, 0x0f, 0x6c, 0x8c, 0x8f, 0x51, 0x7e, 0x31, 0x0a, 0x11,
+		0x45, 0x7f, 0xef, 0x40, 0xb7, 0xf0, 0x06, 0x79, 0x48, 0x6f, 0xee, 0x98, 0xe3, 0x42, 0x42, 0xd8,
+		0xb9, 0x65, 0x0e, 0x57, 0x6e, 0xee, 0x98, 0x57, 0x8e, 0x28, 0x14, 0x96, 0x3c, 0x09, 0x73, 0x5b,
+		0x9d, 0x01, 0x8f, 0x53, 0x0c, 0x29, 0x0b, 0x2f, 0xd2, 0x25, 0x04, 0xba, 0x72, 0x44, 0x61, 0xd0,
+		0x58, 0x15, 0xfd, 0xa4, 0x56, 0xf0, 0xe0, 0xaa, 0xf0, 0xde, 0x2e, 0x56, 0x85, 0xb0, 0xa4, 0x00,
+		0xd0, 0x36, 0xda, 0xb6, 0x4a, 0x7d, 0xf8, 0xf4, 0xdc, 0xa8, 0x96, 0xf0, 0x63, 0xb6, 0x6d, 0xea,
+		0xf5, 0x63, 0x24, 0xb5, 0x2d, 0x12, 0xd8, 0xdc, 0xf7, 0x0e, 0xf4, 0xfe, 0x7e, 0x3a, 0x7c, 0x70,
+		0x73, 0xdf, 0x89, 0x40, 0xd8, 0x5c, 0x0a, 0x4d, 0xca, 0x10, 0xa7, 0x77, 0x91, 0xd9, 0xfc, 0xe5,
+		0x6f, 0xca, 0xe5, 0x26, 0x21, 0x17, 0x10, 0x94, 0x4e, 0xe9, 0x2b, 0x47, 0x14, 0xd8, 0x74, 0x52,
+		0xa8, 0x24, 0xd9, 0xc3, 0xe4, 0xf6, 0x5e, 0x3a, 0x3a, 0xaa, 0xa6, 0xbc, 0x34, 0xe8, 0xeb, 0xe4,
+		0x8d, 0x3d, 0x8c, 0x12, 0x37, 0xd9, 0x4f, 0xec, 0x7f, 0x4b, 0xef, 0xb4, 0xf1, 0xf1, 0x7a, 0x7b,
+		0x2f, 0x1d, 0x3b, 0xb8, 0xff, 0x25, 0x06, 0x49, 0x29, 0xc4, 0x5a, 0x22, 0x81, 0x8a, 0x56, 0x37,
+		0x5a, 0xbc, 0x1b, 0x30, 0xaa, 0xa7, 0x7d, 0xe3, 0x6c, 0xb4, 0x44, 0x27, 0xa2, 0x3a, 0xff, 0x4d,
+		0x9e, 0x76, 0x2c, 0xd4, 0xf8, 0xa8, 0x51, 0xe8, 0xeb, 0x00, 0x8b, 0x55, 0x1c, 0x11, 0x96, 0x2a,
+		0xa9, 0x42, 0xb2, 0xd3, 0xb6, 0x6c, 0xd5, 0x32, 0xb4, 0x9e, 0xb5, 0x63, 0xda, 0xe2, 0x59, 0xe2,
+		0xfb, 0x26, 0x51, 0x58, 0x6d, 0x5b, 0x76, 0x5d, 0x00, 0x5f, 0x39, 0xa2, 0xcc, 0x77, 0xbc, 0x19,
+		0x48, 0xcf, 0xdc, 0xda, 0xd2, 0xfb, 0x0e, 0xc1, 0xf4, 0xfc, 0xc1, 0xf4, 0x6a, 0x08, 0x2d, 0xf0,
+		0x91, 0x9e, 0xe9, 0xcd, 0x20, 0xef, 0x82, 0xc5, 0x8e, 0xa9, 0xb5, 0x1c, 0x72, 0x6a, 0x73, 0x67,
+		0x60, 0xdc, 0xa0, 0x91, 0x04, 0xfc, 0x7a, 0xc7, 0xa4, 0x46, 0x9a, 0x5a, 0x4b, 0x90, 0x28, 0x22,
+		0xc2, 0x95, 0x23, 0xca, 0x42, 0x67, 0x38, 0x93, 0xbc, 0x1b, 0x96, 0xb4, 0x5e, 0xaf, 0xb3, 0x3f,
+		0x4c, 0x3d, 0x45, 0xa9, 0x9f, 0x9d, 0x44, 0x3d, 0x8f, 0x38, 0xc3, 0xe4, 0x89, 0x36, 0x92, 0x4b,
+		0x1a, 0x18, 0x03, 0xd1, 0xe9, 0x9d, 0x98, 0x1e, 0x37, 0x55, 0xe8, 0x3b, 0xbf, 0xf8, 0xdd, 0xbd,
+		0x09, 0xb4, 0xd7, 0x19, 0xbc, 0xb0, 0x6c, 0xae, 0x1c, 0xc1, 0x70, 0x89, 0x2f, 0x8b, 0x51, 0x35,
+		0x9b, 0x3a, 0x7d, 0x28, 0x9d, 0x53, 0x5d, 0x98, 0x46, 0x95, 0xc2, 0xfb, 0xa9, 0xfa, 0xb2, 0x0a,
+		0x11, 0x7e, 0xa6, 0x8d, 0x7f, 0x45, 0xe9, 0x01, 0x88, 0x7b, 0x14, 0x0b, 0x06, 0xd5, 0xf9, 0x1e,
+		0xbf, 0x38, 0x0b, 0xc7, 0x93, 0xb9, 0x24, 0x24, 0xbc, 0xca, 0x24, 0xf7, 0x21, 0x09, 0xe2, 0x1e,
+		0x3d, 0x81, 0x98, 0xde, 0xd0, 0x61, 0xcc, 0x8d, 0x0a, 0xde, 0x2b, 0x56, 0x11, 0x51, 0xce, 0x76,
+		0x93, 0x12, 0x34, 0x93, 0x2f, 0x62, 0xf8, 0x3a, 0x41, 0xef, 0x7c, 0xcf, 0x01, 0x09, 0x52, 0x10,
+		0xe8, 0x9d, 0xef, 0x09, 0x80, 0x7b, 0x20, 0x81, 0x3d, 0x55, 0xbd, 0x86, 0x46, 0x4c, 0x89, 0x63,
+		0x1e, 0x07, 0xc9, 0xfd, 0xfb, 0x00, 0xc8, 0xc3, 0x0a, 0xc8, 0x89, 0x29, 0x4a, 0x87, 0x8e, 0x29,
+		0x9e, 0x18, 0x8e, 0x66, 0xba, 0x01, 0xcc, 0x55, 0x90, 0xdd, 0x38, 0x1c, 0x5b, 0x08, 0x26, 0x1b,
+		0x4e, 0x43, 0x16, 0x9e, 0x92, 0x6a, 0xfa, 0x33, 0xc8, 0x25, 0xdf, 0xde, 0x8b, 0xf8, 0x66, 0xe4,
+		0xf0, 0x10, 0x3b, 0xf6, 0xc2, 0x46, 0xaf, 0xa5, 0xd9, 0xba, 0x88, 0x8b, 0xb8, 0x98, 0xb8, 0xac,
+		0x63, 0xd8, 0xcf, 0xb2, 0x35, 0x5b, 0xe7, 0xcb, 0xfa, 0x1c, 0x0b, 0x0f, 0x6a, 0xbd, 0x1e, 0xfd,
+		0x3e, 0x16, 0x5b, 0xd6, 0xef, 0x83, 0x24, 0xea, 0xe4, 0xb6, 0xd6, 0x51, 0x79, 0x94, 0x20, 0xcc,
+		0x56, 0x7f, 0x9e, 0x7b, 0x85, 0x66, 0xe6, 0x5a, 0x90, 0xf0, 0xea, 0x63, 0xc7, 0xcd, 0x92, 0x3c,
+		0x6e, 0x16, 0xe1, 0x8f, 0x4d, 0x30, 0xfe, 0x88, 0xe7, 0x3a, 0xc6, 0x47, 0x77, 0x97, 0xa8, 0x4b,
+		0xb6, 0xcb, 0x02, 0x1e, 0x51, 0x85, 0x25, 0x72, 0xef, 0x0f, 0xc0, 0xc2, 0x88, 0xe6, 0x1e, 0x1b,
+		0xf6, 0x76, 0xfd, 0xcb, 0xc0, 0xa1, 0xfc, 0xcb, 0xe7, 0xfc, 0x61, 0x5d, 0xcf, 0xca, 0x77, 0x72,
+		0x84, 0xc9, 0x4c, 0x6f, 0xa2, 0x40, 0x73, 0x22, 0x9e, 0xc8, 0x2f, 0x15, 0xf3, 0x0d, 0x58, 0xda,
+		0xdc, 0x7f, 0x9f, 0x66, 0xd8, 0x6d, 0x43, 0x57, 0x47, 0x46, 0x6d, 0x74, 0x29, 0x5d, 0x6b, 0x5b,
+		0xe2, 0x2b, 0x21, 0x9c, 0xe2, 0xa2, 0x83, 0xef, 0x06, 0x77, 0x73, 0x0a, 0x24, 0xfd, 0x4b, 0x0f,
+		0x49, 0x42, 0xc0, 0xde, 0xe3, 0xfd, 0x0f, 0xd8, 0x7b, 0xe4, 0x51, 0x1e, 0x07, 0x62, 0xaf, 0xcf,
+		0x8d, 0x56, 0xc4, 0xf1, 0xdc, 0x20, 0x50, 0x2e, 0x07, 0xf2, 0xf0, 0x72, 0x34, 0x4c, 0x35, 0xf7,
+		0x20, 0xa4, 0x86, 0xd6, 0x9b, 0x49, 0x51, 0xc1, 0x5c, 0x0a, 0xe6, 0x39, 0x28, 0x63, 0x47, 0xee,
+		0x18, 0x2c, 0x8d, 0x5b, 0x2b, 0x72, 0x3b, 0xb0, 0x34, 0x4e, 0xe7, 0x93, 0x27, 0x21, 0xea, 0x2c,
+		0x16, 0x63, 0xa2, 0x10, 0xb4, 0x17, 0x02, 0x58, 0x71, 0x40, 0x7d, 0xc1, 0xec, 0x80, 0x2f, 0x98,
+		0x9d, 0x7b, 0x0f, 0xa4, 0x27, 0x2d, 0x04, 0x43, 0xdd, 0x08, 0x39, 0x52, 0x78, 0x0c, 0xc2, 0xfc,
+		0xed, 0xb6, 0x00, 0xdd, 0xbe, 0xe1, 0x29, 0x94, 0x4e, 0xb6, 0x28, 0x04, 0x69, 0x36, 0x4b, 0xe4,
+		0x54, 0x38, 0x31, 0x71, 0x31, 0x98, 0xbc, 0x11, 0xc4, 0x08, 0xf1, 0x8d, 0xa0, 0xa6, 0x68, 0x8e,
+		0x45, 0xfb, 0x2a, 0x0e, 0x3b, 0xb0, 0x54, 0xee, 0xd5, 0x20, 0x1c, 0x1b, 0xbf, 0x24, 0x90, 0x2c,
+		0x24, 0xd0, 0xee, 0xb6, 0xfd, 0x26, 0x3a, 0x74, 0xb5, 0xbd, 0x06, 0xb7, 0xcf, 0x79, 0x20, 0x3d,
+		0xe0, 0x04, 0xd2, 0xc9, 0x06, 0x2c, 0x74, 0xcc, 0xa6, 0xd6, 0x51, 0x3d, 0x12, 0xcf, 0x85, 0xfd,
+		0xde, 0x11, 0x66, 0x97, 0xd9, 0x07, 0xe0, 0x5a, 0x23, 0x42, 0x9f, 0xa2, 0x34, 0x56, 0x1d, 0xc9,
+		0x27, 0x25, 0x88, 0x77, 0x5d, 0x41, 0x3e, 0x84, 0xb0, 0x7b, 0xd1, 0x3c, 0x43, 0x32, 0x37, 0x76,
+		0xdb, 0x27, 0x7c, 0x68, 0x15, 0x3d, 0x69, 0x07, 0x25, 0x32, 0x71, 0x07, 0x65, 0xdc, 0x76, 0x45,
+		0x74, 0xfc, 0x76, 0xc5, 0x07, 0xbd, 0x43, 0xe3, 0x5b, 0x44, 0x47, 0x77, 0x30, 0x48, 0x1d, 0x96,
+		0x38, 0x7e, 0xcb, 0xc7, 0xfb, 0xc0, 0xac, 0x8a, 0x86, 0x08, 0xf4, 0xc9, 0x6c, 0x0f, 0xde, 0x1e,
+		0xdb, 0x85, 0x2e, 0x0d, 0x79, 0x74, 0xe9, 0xff, 0x61, 0x43, 0xf1, 0x07, 0x31, 0x88, 0x2a, 0xba,
+		0xd5, 0xc3, 0x85, 0x13, 0x43, 0xc9, 0xfa, 0x5e, 0x53, 0xef, 0xd9, 0xee, 0x36, 0xe5, 0x38, 0x67,
+		0x80, 0x41, 0x97, 0x05, 0x24, 0x5a, 0xe2, 0x0e, 0x1a, 0x46, 0xd5, 0xa9, 0xb3, 0x35, 0xd9, 0x6f,
+		0xe2, 0xe8, 0x5e, 0x6f, 0xeb, 0x82, 0xf0, 0xb6, 0x82, 0x13, 0x8d, 0x6f, 0x86, 0x35, 0xe4, 0x6e,
+		0x3d, 0xce, 0xdd, 0xad, 0xd0, 0x94, 0xca, 0x7c, 0xfe, 0x56, 0xd1, 0xe7, 0x6f, 0x85, 0xa7, 0x74,
+		0x73, 0x82, 0xc3, 0x75, 0x41, 0x38, 0x5c, 0x91, 0x29, 0x2d, 0x1e, 0xf2, 0xb8, 0x2e, 0xf9, 0x3d,
+		0xae, 0xe8, 0x04, 0x05, 0x22, 0xb0, 0x27, 0xba, 0x5c, 0x6f, 0xf3, 0xb8, 0x5c, 0xb1, 0x89, 0xfe,
+		0x0e, 0x23, 0x32, 0xc6, 0xe7, 0x2a, 0xfa, 0x7c, 0x2e, 0x98, 0xc2, 0x83, 0x09, 0x4e, 0xd7, 0x3b,
+		0xbc, 0x4e, 0x57, 0x7c, 0xa2, 0xdf, 0xc6, 0xc7, 0x7b, 0x9c, 0xd7, 0x75, 0xd1, 0xf1, 0xba, 0x12,
+		0x13, 0xdd, 0x46, 0xde, 0x87, 0x61, 0xb7, 0xab, 0x36, 0xe2, 0x76, 0xcd, 0xf3, 0x8f, 0xe6, 0x4e,
+		0x22, 0x31, 0xc5, 0xef, 0xaa, 0x8d, 0xf8, 0x5d, 0xc9, 0x29, 0x04, 0xa7, 0x38, 0x5e, 0x5f, 0x35,
+		0xde, 0xf1, 0x9a, 0xec, 0x1a, 0xf1, 0x66, 0xce, 0xe6, 0x79, 0xa9, 0x13, 0x3c, 0x2f, 0xe6, 0x1d,
+		0x3d, 0x34, 0x91, 0xfc, 0xcc, 0xae, 0xd7, 0xc6, 0x18, 0xd7, 0x8b, 0x39, 0x49, 0x67, 0x26, 0x12,
+		0x9f, 0xc1, 0xf7, 0xda, 0x18, 0xe3, 0x7b, 0x91, 0xa9, 0x64, 0x0f, 0xe3, 0x7c, 0xe1, 0xeb, 0xf3,
+		0x0f, 0xc2, 0x82, 0x40, 0x76, 0xf4, 0x14, 0xda, 0x0f, 0xec, 0x7b, 0x15, 0xfc, 0x64, 0x2d, 0x4d,
+		0xe4, 0xce, 0x40, 0xc2, 0x01, 0x3d, 0xd8, 0x51, 0xa3, 0x76, 0x9a, 0x47, 0x0f, 0xe5, 0x7e, 0x49,
+		0x82, 0x84, 0x57, 0xc5, 0xf8, 0x0c, 0xf9, 0x18, 0x37, 0xe4, 0x3d, 0xee, 0x5b, 0xc0, 0xef, 0xbe,
+		0xe1, 0xe7, 0x06, 0x7a, 0x23, 0x9e, 0x99, 0xd6, 0x73, 0x3c, 0x33, 0x71, 0xa4, 0x80, 0x39, 0x79,
+		0x7c, 0x59, 0x61, 0x3b, 0x39, 0x29, 0xe7, 0x78, 0x05, 0xf3, 0x29, 0xc8, 0x23, 0xb0, 0xe8, 0x81,
+		0x75, 0xec, 0x3a, 0xe6, 0xa6, 0xc8, 0x0e, 0x74, 0x9e, 0x1b, 0x78, 0xff, 0x46, 0x82, 0x85, 0x11,
+		0x15, 0x37, 0xd6, 0xfb, 0x92, 0xee, 0x90, 0xf7, 0x15, 0xb8, 0x6d, 0xef, 0xcb, 0x6b, 0xa7, 0x06,
+		0xfd, 0x76, 0xea, 0xff, 0x94, 0x60, 0xde, 0xa7, 0x69, 0x71, 0x08, 0x9a, 0x66, 0x4b, 0xe7, 0x96,
+		0x23, 0xfd, 0x8d, 0x46, 0x45, 0xc7, 0x14, 0x1f, 0x45, 0xc1, 0x9f, 0x08, 0xe5, 0x2c, 0x1c, 0x31,
+		0xbe, 0x2e, 0x38, 0x46, 0xe7, 0x9c, 0xf7, 0xfc, 0x2f, 0x3f, 0x14, 0x1b, 0x76, 0x0f, 0xc5, 0x3a,
+		0x77, 0xd7, 0x22, 0x9e, 0xbb, 0x6b, 0xe4, 0x69, 0x88, 0xd1, 0x88, 0xa8, 0x8a, 0x07, 0x62, 0xa3,
+		0xa3, 0xb6, 0x89, 0xff, 0x40, 0xac, 0x45, 0x8f, 0xea, 0xd1, 0x5f, 0x1e, 0x8b, 0x21, 0xe6, 0xb3,
+		0x18, 0xee, 0x82, 0x18, 0xb6, 0x9e, 0x7d, 0x74, 0x0d, 0xf8, 0xc5, 0x47, 0x91, 0x91, 0x7b, 0x37,
+		0x90, 0xd1, 0x45, 0x82, 0x5c, 0x81, 0xb0, 0xbe, 0x4b, 0x5f, 0x53, 0x64, 0x07, 0x0d, 0x8f, 0x8d,
+		0x9a, 0xa6, 0x58, 0x5c, 0x48, 0x23, 0x93, 0x3f, 0xfb, 0xda, 0x69, 0x99, 0x41, 0x3f, 0xec, 0x9c,
+		0xf3, 0x57, 0x38, 0x7e, 0xee, 0x8f, 0x03, 0x90, 0x12, 0x15, 0x08, 0xcf, 0x69, 0x1c, 0x6f, 0xc7,
+		0x6d, 0x11, 0xce, 0xc6, 0xef, 0x53, 0x00, 0xdb, 0x9a, 0xa5, 0xbe, 0xac, 0x19, 0x36, 0xff, 0x4c,
+		0x75, 0x50, 0xf1, 0xe4, 0xe0, 0x01, 0x5b, 0x4c, 0x0d, 0x2c, 0xfe, 0xa1, 0xea, 0xa0, 0xe2, 0xa4,
+		0x3d, 0xfd, 0x8c, 0x7c, 0x69, 0xfd, 0xf4, 0x73, 0x39, 0x3a, 0xc4, 0x65, 0x8f, 0x73, 0x11, 0xf3,
+		0x3a, 0x17, 0xec, 0x60, 0x30, 0x3f, 0x9f, 0x08, 0xac, 0x6d, 0x22, 0x8d, 0x51, 0x99, 0xae, 0xde,
+		0xed, 0x99, 0xa6, 0xf8, 0x3c, 0x0e, 0xfb, 0x48, 0x62, 0x82, 0x67, 0xb2, 0xcf, 0xe3, 0x7c, 0x43,
+		0x00, 0x16, 0x46, 0x96, 0xd7, 0x7f, 0x79, 0x0c, 0xa6, 0xdf, 0xd9, 0x19, 0x36, 0x11, 0x48, 0xdd,
+		0x7b, 0x6a, 0x64, 0x40, 0xd5, 0x82, 0x10, 0xe8, 0x59, 0xf5, 0x87, 0xbc, 0xeb, 0xcf, 0xb6, 0xc8,
+		0x75, 0x38, 0x3e, 0xa4, 0xdb, 0x1c, 0xd2, 0x81, 0x59, 0x55, 0xdc, 0x51, 0xbf, 0x8a, 0x13, 0xa4,
+		0x5d, 0x66, 0x05, 0xbf, 0xc4, 0x59, 0x57, 0x81, 0xa4, 0xe0, 0x06, 0xf7, 0x54, 0xc6, 0x0d, 0x3f,
+		0xfd, 0x00, 0x93, 0x8d, 0x01, 0x34, 0x5f, 0x38, 0x28, 0xc1, 0x32, 0x79, 0x90, 0x69, 0x1d, 0x8e,
+		0x0a, 0x52, 0x3e, 0xcb, 0x87, 0x3c, 0x05, 0x31, 0xd7, 0x68, 0x62, 0x5c, 0x3d, 0x20, 0x5c, 0xe0,
+		0xc2, 0xe6, 0x7e, 0x4d, 0x82, 0xa3, 0x63, 0x6d, 0x1f, 0x52, 0x86, 0x30, 0x3b, 0xf6, 0xc6, 0x0f,
+		0xd3, 0x3c, 0x32, 0x9b, 0xcd, 0xb4, 0xcc, 0xce, 0xc4, 0x29, 0x1c, 0x39, 0xf7, 0x6e, 0x08, 0xb3,
+		0x1c, 0x12, 0x87, 0x88, 0xef, 0x61, 0xe9, 0x7c, 0xb1, 0x58, 0x5e, 0x17, 0xef, 0x95, 0x16, 0xe8,
+		0x23, 0x98, 0x98, 0xad, 0x94, 0xaf, 0x96, 0x8b, 0x0d, 0x39, 0x88, 0xdf, 0xe7, 0x66, 0xbf, 0xf9,
+		0xf3, 0xcf, 0x72, 0xc8, 0x93, 0x55, 0x2f, 0x57, 0x4b, 0x65, 0x45, 0x9e, 0xcb, 0x3d, 0x06, 0x27,
+		0x44, 0x3b, 0x46, 0xc3, 0x1a, 0x4e, 0x74, 0x41, 0xf2, 0x44, 0x17, 0x72, 0xaf, 0x06, 0x20, 0x33,
+		0xd9, 0x74, 0x22, 0x57, 0x87, 0x3a, 0x7e, 0xfe, 0x10, 0x76, 0xd7, 0x50, 0xef, 0x31, 0x78, 0xd8,
+		0xd7, 0xb7, 0x74, 0xbb, 0xb9, 0xc3, 0x4c, 0x39, 0xb6, 0x64, 0xce, 0x2b, 0xf3, 0x3c, 0x97, 0x22,
+		0x59, 0x0c, 0xec, 0x25, 0xbd, 0x69, 0xab, 0x4c, 0x17, 0x31, 0xa1, 0x8b, 0x29, 0xf3, 0x2c, 0xb7,
+		0xce, 0x32, 0x73, 0xef, 0x39, 0x14, 0x2f, 0x63, 0x30, 0xa7, 0x94, 0xf1, 0xdb, 0x50, 0xf4, 0x23,
+		0xe4, 0xf4, 0xa7, 0x5a, 0xaf, 0xe6, 0xd7, 0xeb, 0x57, 0x6a, 0xc8, 0xcb, 0x45, 0x48, 0x09, 0x5e,
+		0x8a, 0xcc, 0xb9, 0xdc, 0x43, 0x70, 0x7c, 0x82, 0xdd, 0x37, 0xe6, 0x1c, 0xe2, 0x47, 0x24, 0x2f,
+		0xb4, 0xdf, 0xe7, 0xaf, 0x41, 0xd8, 0xb2, 0x35, 0x7b, 0x60, 0x71, 0x26, 0x3e, 0x35, 0xab, 0x21,
+		0xb8, 0x2c, 0x7e, 0xd4, 0x29, 0xba, 0xc2, 0xc9, 0xe4, 0x9e, 0x84, 0xa4, 0xbf, 0x64, 0x32, 0x0f,
+		0x5c, 0x21, 0x0a, 0xe4, 0xae, 0x03, 0x78, 0xe2, 0x91, 0xce, 0x89, 0x2e, 0xc9, 0x7b, 0xa2, 0xeb,
+		0x49, 0x98, 0xc3, 0x13, 0x19, 0xc2, 0x9c, 0x19, 0x9d, 0x38, 0x78, 0xd8, 0xd2, 0x13, 0x7c, 0x60,
+		0xd0, 0xb9, 0x36, 0x90, 0xd1, 0x98, 0xd0, 0x84, 0x2a, 0xde, 0xe6, 0xaf, 0xe2, 0x9e, 0x89, 0xd1,
+		0xa5, 0xf1, 0x55, 0xbd, 0x0f, 0xe6, 0xa8, 0xb6, 0x19, 0x7b, 0xc5, 0xe7, 0xab, 0x01, 0x34, 0xdb,
+		0xee, 0xb7, 0x37, 0x07, 0x6e, 0x05, 0xa7, 0xc7, 0x6b, 0xab, 0xbc, 0x80, 0x2b, 0xdc, 0xc5, 0xd5,
+		0xd6, 0x92, 0x8b, 0xea, 0x51, 0x5d, 0x1e, 0x82, 0xb9, 0x2a, 0x24, 0xfd, 0xb8, 0xe3, 0xaf, 0x2c,
+		0x8d, 0x7e, 0x6c, 0xcf, 0x35, 0xbe, 0xf8, 0x73, 0x1c, 0x34, 0x91, 0xbb, 0x29, 0x41, 0xb4, 0xb1,
+		0xc7, 0xe5, 0xf8, 0x80, 0x43, 0x95, 0xee, 0xbd, 0x2d, 0x27, 0x58, 0xc8, 0xe2, 0xb1, 0x41, 0x27,
+		0xca, 0xfb, 0x0e, 0x67, 0xa6, 0x86, 0x66, 0xf5, 0x76, 0x45, 0xb4, 0x9b, 0x6b, 0xa7, 0x67, 0x66,
+		0xbb, 0x23, 0x81, 0xc1, 0x78, 0xcf, 0xfd, 0x06, 0x96, 0xc8, 0xb5, 0x3c, 0xc7, 0x12, 0xd8, 0xb2,
+		0xe1, 0xbd, 0x4c, 0x21, 0x1d, 0xfa, 0x32, 0x85, 0x53, 0x4b, 0xc0, 0x5b, 0xcb, 0x2e, 0x44, 0x85,
+		0x50, 0x90, 0xb7, 0x7b, 0xcf, 0x9e, 0x88, 0x3d, 0x9a, 0x89, 0x8b, 0x27, 0x27, 0xef, 0xa2, 0xa0,
+		0xf3, 0xc1, 0x8f, 0xdc, 0xb9, 0x7e, 0x05, 0xff, 0x24, 0x5a, 0x8a, 0x15, 0xac, 0x0a, 0xa7, 0x22,
+		0xf7, 0x63, 0x12, 0xc8, 0xc3, 0x52, 0xf9, 0x95, 0x6c, 0x00, 0x2a, 0x45, 0x94, 0x7e, 0xcf, 0xe7,
+		0x70, 0xd8, 0xc8, 0xcf, 0x63, 0xae, 0xfb, 0x41, 0x9c, 0xf7, 0x07, 0x20, 0xee, 0x89, 0xe9, 0x91,
+		0x27, 0x7c, 0x47, 0x40, 0xb3, 0x07, 0xc5, 0xff, 0x3c, 0x67, 0x40, 0x7d, 0x1d, 0x0b, 0x1c, 0xbe,
+		0x63, 0x77, 0xfe, 0x90, 0xfe, 0xf8, 0xdb, 0x3e, 0x73, 0x13, 0x6e, 0xfb, 0x7c, 0xbd, 0x04, 0x51,
+		0x67, 0xe9, 0x3e, 0x6c, 0x34, 0xff, 0x18, 0x84, 0xf9, 0xea, 0xc4, 0xc2, 0xf9, 0x3c, 0x35, 0x36,
+		0x16, 0x9a, 0x81, 0xa8, 0xf8, 0x7e, 0x34, 0x77, 0x44, 0x9d, 0xf4, 0xd9, 0x8b, 0x10, 0xf7, 0x6c,
+		0xac, 0xa0, 0x9e, 0xa8, 0x96, 0x9f, 0x97, 0x8f, 0x64, 0x22, 0x37, 0x6f, 0x65, 0x83, 0x55, 0xfd,
+		0x65, 0x9c, 0x61, 0x4a, 0xb9, 0x78, 0xa5, 0x5c, 0x7c, 0x4e, 0x96, 0x32, 0xf1, 0x9b, 0xb7, 0xb2,
+		0x11, 0x45, 0xa7, 0xe1, 0xab, 0xb3, 0xcf, 0x41, 0x6a, 0x68, 0x60, 0xfc, 0xfa, 0x9d, 0x40, 0xb2,
+		0xb4, 0x81, 0xdf, 0xda, 0xc8, 0x37, 0xca, 0x2a, 0x3b, 0x4e, 0x87, 0x6f, 0x82, 0xaf, 0x56, 0x2e,
+		0x5f, 0x69, 0xa8, 0xc5, 0xd5, 0x4a, 0xb9, 0xda, 0x50, 0xf3, 0x8d, 0x46, 0xbe, 0xf8, 0x9c, 0x1c,
+		0x38, 0xff, 0x05, 0x80, 0x54, 0xbe, 0x50, 0xac, 0xe0, 0xfa, 0xdc, 0xe6, 0xcf, 0xe5, 0x17, 0x21,
+		0x44, 0x43, 0x01, 0x07, 0x1e, 0x15, 0xc9, 0x1c, 0x1c, 0xdb, 0x24, 0x97, 0x60, 0x8e, 0x46, 0x09,
+		0xc8, 0xc1, 0x67, 0x47, 0x32, 0x53, 0x82, 0x9d, 0xd8, 0x18, 0x3a, 0x9d, 0x0e, 0x3c, 0x4c, 0x92,
+		0x39, 0x38, 0xf6, 0x49, 0x14, 0x88, 0xb9, 0x5e, 0xc6, 0xf4, 0xc3, 0x15, 0x99, 0x19, 0xb4, 0x23,
+		0x59, 0x85, 0x88, 0x70, 0x0c, 0xa7, 0x1d, 0xf7, 0xc8, 0x4c, 0x0d, 0x4e, 0x22, 0xbb, 0x98, 0x03,
+		0x7f, 0xf0, 0xd9, 0x95, 0xcc, 0x94, 0x48, 0x2b, 0xa9, 0x38, 0x87, 0xf0, 0xa7, 0x1c, 0xe1, 0xc8,
+		0x4c, 0x0b, 0x36, 0x22, 0xd3, 0xdc, 0xd0, 0xc8, 0xf4, 0x13, 0x39, 0x99, 0x19, 0x82, 0xc8, 0x64,
+		0x03, 0xc0, 0xe3, 0xae, 0xcf, 0x70, 0xd4, 0x26, 0x33, 0x4b, 0x70, 0x98, 0xd4, 0x20, 0xea, 0x78,
+		0x4f, 0x53, 0x0f, 0xbe, 0x64, 0xa6, 0x47, 0x69, 0xc9, 0xbb, 0x61, 0xde, 0xef, 0x35, 0xcc, 0x76,
+		0x9c, 0x25, 0x33, 0x63, 0xf8, 0x15, 0xe9, 0xfb, 0x5d, 0x88, 0xd9, 0x8e, 0xb7, 0x64, 0x66, 0x8c,
+		0xc6, 0x92, 0x97, 0x60, 0x61, 0xd4, 0xc4, 0x9f, 0xfd, 0xb4, 0x4b, 0xe6, 0x10, 0xf1, 0x59, 0xd2,
+		0x05, 0x32, 0xc6, 0x35, 0x38, 0xc4, 0xe1, 0x97, 0xcc, 0x61, 0xc2, 0xb5, 0xa4, 0x05, 0xa9, 0x61,
+		0x7b, 0x7b, 0xd6, 0xc3, 0x30, 0x99, 0x99, 0x43, 0xb7, 0xac, 0x16, 0xbf, 0x9d, 0x3e, 0xeb, 0xe1,
+		0x98, 0xcc, 0xcc, 0x91, 0xdc, 0x42, 0x7e, 0xe2, 0xf9, 0xc6, 0x07, 0x0e, 0x3c, 0xdf, 0xe8, 0x9e,
+		0x58, 0x74, 0xce, 0x34, 0xfe, 0xd8, 0x3b, 0xe0, 0x2d, 0xfc, 0x31, 0x01, 0xcb, 0xd6, 0x6e, 0xb4,
+		0x8d, 0x6d, 0xe7, 0x75, 0x08, 0x9e, 0xe6, 0x87, 0x1b, 0x8f, 0x31, 0xa8, 0x65, 0x91, 0x3b, 0xe5,
+		0x8d, 0x88, 0x89, 0xef, 0x5e, 0x4d, 0x3b, 0x84, 0x3c, 0xfd, 0xe8, 0xe2, 0x01, 0xef, 0x4f, 0x4c,
+		0x79, 0xe5, 0x62, 0xcc, 0xfb, 0x14, 0x53, 0x0e, 0x61, 0x1e, 0x74, 0xde, 0x33, 0xf7, 0x5d, 0x12,
+		0x24, 0xaf, 0xb4, 0x2d, 0xdb, 0xec, 0xb7, 0x9b, 0x5a, 0x87, 0xae, 0x18, 0xcf, 0xcc, 0x7a, 0xc9,
+		0xa3, 0x10, 0x43, 0x63, 0x84, 0x3f, 0x6a, 0xc1, 0x50, 0x48, 0x09, 0xc2, 0xbb, 0x5a, 0x87, 0x5d,
+		0xb1, 0xf0, 0x3e, 0x3f, 0x33, 0xcc, 0x73, 0x8f, 0x95, 0xe4, 0xa5, 0xc2, 0x70, 0x73, 0x3f, 0x49,
+		0x0f, 0x71, 0x77, 0xbb, 0x6d, 0x0b, 0x8d, 0x37, 0x85, 0x86, 0x5a, 0xd6, 0x21, 0xd4, 0xd7, 0x6c,
+		0xee, 0xd4, 0x14, 0xde, 0xca, 0x9f, 0xa2, 0xb8, 0x7f, 0xfa, 0x83, 0x12, 0xcb, 0xa3, 0xaf, 0x55,
+		0x50, 0x4a, 0xe4, 0x79, 0xc0, 0xc3, 0xc3, 0x2a, 0xa5, 0x1a, 0xb8, 0x03, 0x54, 0xf1, 0x24, 0x35,
+		0xb6, 0x15, 0x67, 0x10, 0x12, 0x6e, 0xee, 0x68, 0xc6, 0xb6, 0xce, 0xe8, 0x07, 0xef, 0x00, 0xfd,
+		0xf9, 0xae, 0xb6, 0x57, 0xa4, 0x34, 0xb1, 0x96, 0x95, 0xe8, 0xab, 0x1f, 0x3e, 0x7d, 0x84, 0x9e,
+		0x45, 0xfe, 0x4d, 0x09, 0xc0, 0x65, 0x17, 0xd1, 0x30, 0x96, 0x2f, 0x52, 0xb4, 0x7a, 0x11, 0xcb,
+		0x7f, 0x60, 0xd2, 0x68, 0x0c, 0x31, 0xbb, 0x30, 0x8f, 0x0d, 0xfd, 0xd4, 0x6b, 0xa7, 0x25, 0x36,
+		0x2e, 0xa9, 0xe6, 0xd0, 0x60, 0x5c, 0x85, 0x38, 0x0b, 0xa1, 0xa9, 0xd4, 0x6e, 0x0d, 0x4c, 0xb5,
+		0x5b, 0xe7, 0x85, 0xdd, 0xca, 0x08, 0x02, 0xc3, 0xc6, 0x72, 0x4f, 0x3f, 0x7e, 0x55, 0x82, 0xe3,
+		0x6e, 0x4b, 0xea, 0xf8, 0x11, 0xa0, 0x41, 0x47, 0x2f, 0x1b, 0x76, 0x7f, 0x9f, 0xac, 0x43, 0x52,
+		0xdf, 0xda, 0xd2, 0x9b, 0xf4, 0xdb, 0x9d, 0x33, 0x9e, 0x3e, 0x1b, 0xaa, 0x74, 0xde, 0x21, 0x80,
+		0x20, 0x8e, 0x40, 0x05, 0xee, 0x94, 0x40, 0xe5, 0x5e, 0x02, 0x32, 0xda, 0x7c, 0xd2, 0x80, 0x88,
+		0x6e, 0xd8, 0xfd, 0xb6, 0x13, 0xc9, 0x3c, 0x37, 0x7d, 0x14, 0x7c, 0x7d, 0xf7, 0xce, 0x10, 0x41,
+		0x2a, 0xf7, 0x93, 0x12, 0xc4, 0x4b, 0x9e, 0x27, 0xb9, 0x70, 0xf3, 0xca, 0x34, 0xda, 0x37, 0xf4,
+		0xbe, 0xb3, 0x79, 0xc5, 0x92, 0x68, 0x8b, 0xb3, 0xaf, 0xa6, 0xda, 0xfb, 0xe2, 0xb9, 0x0a, 0x91,
+		0x46, 0xac, 0x97, 0xf5, 0x4d, 0xab, 0x2d, 0x24, 0x54, 0x11, 0x49, 0x3c, 0x49, 0x60, 0xe9, 0xcd,
+		0x01, 0xc6, 0xb5, 0xf1, 0xab, 0xd5, 0x36, 0x7e, 0x54, 0x99, 0x85, 0x98, 0x53, 0x22, 0xbf, 0xc8,
+		0xb2, 0x91, 0x48, 0x4b, 0xb7, 0xb5, 0x76, 0xc7, 0xe2, 0xdf, 0x83, 0x17, 0x49, 0xcf, 0xd0, 0xfe,
+		0x4a, 0xc4, 0xeb, 0x43, 0x17, 0x41, 0x36, 0x7b, 0x7a, 0xdf, 0x77, 0x37, 0x90, 0xcd, 0xeb, 0xf4,
+		0xef, 0x7f, 0xf2, 0x91, 0x25, 0xce, 0x1e, 0x7e, 0x52, 0x81, 0xbd, 0x42, 0xad, 0xa4, 0x04, 0x06,
+		0xcf, 0x26, 0xd7, 0x7d, 0x5b, 0x56, 0x83, 0x4d, 0xf7, 0x6d, 0x82, 0xa5, 0x11, 0x99, 0xc8, 0x1b,
+		0xfb, 0x85, 0xf4, 0xef, 0xb8, 0xa4, 0x5d, 0x1f, 0x1b, 0x5f, 0x03, 0xf0, 0xec, 0x5f, 0x51, 0x32,
+		0xe8, 0xea, 0xbc, 0xa4, 0xb5, 0x3b, 0xe2, 0x4b, 0xdf, 0x0a, 0x4f, 0x91, 0x15, 0x27, 0x5e, 0xc5,
+		0xbe, 0x95, 0x96, 0x9b, 0x34, 0x92, 0x05, 0xd3, 0x68, 0xf9, 0x43, 0x53, 0xa4, 0x01, 0x61, 0xdb,
+		0xbc, 0xa1, 0x1b, 0x9c, 0x49, 0x87, 0x12, 0xb8, 0xd1, 0x87, 0x7a, 0x38, 0x2d, 0xb2, 0x0d, 0x72,
+		0x4b, 0xef, 0xe8, 0xdb, 0x94, 0x95, 0xd6, 0x8e, 0x86, 0x17, 0x40, 0xc3, 0x77, 0x40, 0xa0, 0x53,
+		0x0e, 0xd5, 0x3a, 0x25, 0x4a, 0xd6, 0xfd, 0x8f, 0xc2, 0x45, 0xf8, 0xf1, 0x85, 0x09, 0xfd, 0xf7,
+		0x48, 0xa6, 0x57, 0x7a, 0xbd, 0x24, 0x50, 0xc2, 0x06, 0xc6, 0xa6, 0x69, 0xd0, 0x8f, 0x82, 0x71,
+		0x4f, 0x34, 0xca, 0xb6, 0x38, 0x9d, 0x7c, 0xbe, 0xc5, 0xb9, 0x0e, 0x49, 0x17, 0x94, 0x4e, 0xfe,
+		0xd8, 0xa1, 0x27, 0xbf, 0x43, 0x80, 0x4e, 0xfe, 0x35, 0x00, 0x57, 0xa7, 0x39, 0xc7, 0x20, 0xa6,
+		0xce, 0x4b, 0x6f, 0x67, 0x3c, 0x04, 0x48, 0x07, 0x16, 0xbb, 0x6d, 0x43, 0xb5, 0xf4, 0xce, 0x96,
+		0xca, 0x39, 0x87, 0x74, 0xe3, 0x77, 0x60, 0xa4, 0x17, 0xba, 0x6d, 0xa3, 0xae, 0x77, 0xb6, 0x4a,
+		0x0e, 0x59, 0xf2, 0x56, 0x38, 0xe9, 0xb2, 0xc3, 0x34, 0xd4, 0x1d, 0xb3, 0xd3, 0x52, 0xfb, 0xfa,
+		0x96, 0xda, 0xa4, 0xef, 0x44, 0x25, 0x28, 0x13, 0x8f, 0x3b, 0x20, 0x35, 0xe3, 0x8a, 0xd9, 0x69,
+		0x29, 0xfa, 0x56, 0x11, 0x8b, 0x71, 0x0f, 0xc1, 0xc5, 0x6e, 0xb7, 0xf0, 0xf8, 0x44, 0x10, 0xcf,
+		0x0e, 0x3b, 0x99, 0x95, 0x96, 0xb5, 0x92, 0xf8, 0xe0, 0x87, 0x4f, 0x1f, 0xe1, 0xb3, 0xf7, 0x48,
+		0x6e, 0x9d, 0xbe, 0x6c, 0xc2, 0x27, 0x9e, 0x6e, 0x91, 0x0b, 0x10, 0xd3, 0x44, 0x82, 0xdd, 0xa9,
+		0x39, 0x60, 0xe2, 0xba, 0xa0, 0x4c, 0x1f, 0xbc, 0xf2, 0xc7, 0x59, 0x29, 0x97, 0x87, 0x05, 0x1a,
+		0x92, 0x60, 0xb6, 0xc7, 0x3e, 0xd3, 0xf1, 0x07, 0xc4, 0xf9, 0xc6, 0x84, 0xbc, 0xb6, 0xe1, 0xa8,
+		0x7b, 0xdf, 0xc7, 0x43, 0x8b, 0x54, 0x87, 0x15, 0xee, 0x83, 0x93, 0x06, 0x76, 0xa4, 0x09, 0x63,
+		0x55, 0x6d, 0x01, 0xe4, 0xab, 0x5a, 0xbb, 0x33, 0x53, 0x53, 0x8f, 0x61, 0xb0, 0x51, 0xb3, 0x9c,
+		0x2d, 0x7f, 0x9e, 0xca, 0xe9, 0xb0, 0xe4, 0x34, 0xd6, 0x43, 0x8c, 0xac, 0x0d, 0xb7, 0xf5, 0xcc,
+		0xa4, 0xb6, 0x0e, 0x37, 0x61, 0x6c, 0x53, 0x7f, 0x54, 0x82, 0x70, 0xe9, 0xda, 0xba, 0xd6, 0xee,
+		0x93, 0x32, 0xbe, 0x19, 0x28, 0x34, 0xc3, 0xac, 0x4a, 0xd6, 0x55, 0x26, 0x3c, 0x1f, 0xc9, 0x8c,
+		0xbf, 0xc7, 0x7d, 0x20, 0x99, 0xe1, 0x1b, 0xde, 0x43, 0xf2, 0x74, 0x15, 0x22, 0xac, 0x95, 0x16,
+		0x79, 0x16, 0xe6, 0x7a, 0xf8, 0x23, 0x2d, 0xf9, 0x5e, 0x10, 0x1c, 0xd5, 0x28, 0x14, 0xde, 0xdb,
+		0x69, 0x86, 0x97, 0xfb, 0x27, 0x09, 0xa0, 0x74, 0xed, 0x5a, 0xa3, 0xdf, 0xee, 0x75, 0x74, 0xfb,
+		0x4e, 0x75, 0x7b, 0x15, 0x8e, 0xba, 0xdd, 0xb6, 0xfa, 0xcd, 0x99, 0xbb, 0xbe, 0xe8, 0xa0, 0xd5,
+		0xfb, 0xcd, 0xb1, 0xd4, 0x5a, 0x96, 0xed, 0x50, 0x0b, 0xce, 0x4c, 0xad, 0x64, 0xd9, 0xe3, 0x79,
+		0xf9, 0x02, 0xc4, 0xdd, 0xee, 0x5b, 0xa4, 0x02, 0x51, 0x9b, 0xff, 0xe6, 0x2c, 0xcd, 0x4d, 0x66,
+		0xa9, 0x40, 0xf3, 0xb2, 0xd5, 0x41, 0xcf, 0xfd, 0x33, 0x72, 0xd6, 0xd5, 0x3a, 0x6f, 0x2a, 0x81,
+		0xc2, 0xe5, 0x94, 0x2f, 0x77, 0x77, 0xc2, 0xb4, 0xe6, 0xb4, 0x86, 0x58, 0xfb, 0xc1, 0x00, 0x7e,
+		0xe2, 0x9e, 0x6b, 0xc5, 0x37, 0x2d, 0x27, 0x36, 0x5c, 0x15, 0xc2, 0x36, 0xa1, 0x1f, 0x9d, 0x34,
+		0xe0, 0x63, 0xfa, 0x32, 0x51, 0x95, 0x0c, 0xb1, 0xe2, 0x37, 0x82, 0x90, 0x9e, 0x84, 0x8e, 0xcf,
+		0x68, 0x34, 0xfb, 0x3a, 0xcd, 0x50, 0x7d, 0x5a, 0x31, 0x29, 0xb2, 0xf9, 0x3a, 0xae, 0x00, 0x7a,
+		0x12, 0x28, 0x5d, 0x08, 0x7a, 0x7b, 0xae, 0x43, 0xd2, 0xa5, 0x40, 0x57, 0x72, 0x1d, 0x52, 0xe2,
+		0xe6, 0xc5, 0xa6, 0xd6, 0xd1, 0xf0, 0xba, 0x6e, 0xf0, 0x0e, 0x2c, 0xbb, 0xe2, 0x3a, 0x47, 0x81,
+		0xd1, 0x24, 0xd7, 0x20, 0x22, 0xc8, 0x87, 0xee, 0x00, 0x79, 0x41, 0x0c, 0xef, 0xe0, 0x78, 0x57,
+		0x63, 0x6a, 0x1c, 0x86, 0x94, 0xb8, 0x93, 0x57, 0x69, 0x4d, 0x5b, 0xee, 0xc3, 0x07, 0x2e, 0xf7,
+		0x1e, 0x1b, 0xfc, 0x5f, 0x05, 0xf1, 0xe4, 0x49, 0xeb, 0x5f, 0xe0, 0xe0, 0xbd, 0x0b, 0x80, 0x4d,
+		0x70, 0x54, 0xbe, 0xe9, 0xd0, 0x1d, 0x50, 0x18, 0x31, 0x46, 0xaf, 0x64, 0xd9, 0x5f, 0xc9, 0x11,
+		0xfc, 0x0f, 0x01, 0x48, 0x78, 0x47, 0xf0, 0x5f, 0xc0, 0x6a, 0xe7, 0xb5, 0xe6, 0x42, 0x07, 0x5b,
+		0x73, 0x23, 0xb2, 0x3d, 0x83, 0x5e, 0xfb, 0xc5, 0x38, 0x84, 0xf9, 0xa1, 0xc5, 0xda, 0x88, 0x93,
+		0x21, 0x4d, 0xbb, 0xa9, 0x3e, 0x2f, 0x6e, 0xaa, 0x8f, 0xf5, 0x31, 0xee, 0x03, 0xbc, 0xbf, 0xae,
+		0xfa, 0x4e, 0x42, 0xe2, 0xc6, 0x19, 0xc6, 0x71, 0xdc, 0x73, 0xfb, 0x78, 0x18, 0x14, 0xc1, 0x5c,
+		0x1d, 0x8e, 0x30, 0x78, 0x25, 0xa5, 0xcc, 0x72, 0xc8, 0x23, 0x40, 0x76, 0x9c, 0x10, 0x9d, 0xea,
+		0x32, 0x03, 0xe1, 0x16, 0xdc, 0x12, 0x01, 0x8e, 0x4f, 0x8a, 0x98, 0x46, 0x4b, 0x65, 0x6f, 0xc9,
+		0xf2, 0x07, 0x7e, 0x31, 0xa7, 0x84, 0x19, 0xe4, 0x6b, 0x98, 0xab, 0x32, 0x14, 0x21, 0xe2, 0x4e,
+		0xe3, 0xea, 0xe1, 0x26, 0xc5, 0xdf, 0xbe, 0x76, 0x3a, 0xb3, 0xaf, 0x75, 0x3b, 0x2b, 0xb9, 0x31,
+		0x24, 0x73, 0xd4, 0x75, 0xf1, 0x47, 0x96, 0x48, 0x8f, 0x7e, 0x76, 0x5c, 0xa5, 0x0d, 0xe4, 0xcf,
+		0xda, 0x46, 0x68, 0xcd, 0x57, 0x0e, 0x5d, 0xf3, 0x31, 0xb7, 0x66, 0x0f, 0xb9, 0x9c, 0x32, 0xdf,
+		0x6d, 0x1b, 0xe8, 0x7f, 0xe7, 0x69, 0x9a, 0xd6, 0xa8, 0xed, 0x79, 0x41, 0xd2, 0xd1, 0x2f, 0xb1,
+		0x46, 0x6d, 0x6f, 0xb8, 0x46, 0x6d, 0xcf, 0x53, 0xe3, 0xdd, 0x00, 0xba, 0x41, 0x3f, 0x1a, 0xa4,
+		0xef, 0x76, 0xa9, 0xa7, 0x1a, 0x55, 0x62, 0x2c, 0xa7, 0xbc, 0xdb, 0x25, 0x5b, 0x90, 0xf1, 0x70,
+		0x8a, 0x07, 0x09, 0xe9, 0xb3, 0xde, 0xbb, 0x5a, 0x27, 0x0d, 0x87, 0x94, 0xb9, 0xb4, 0x4b, 0x8b,
+		0xc5, 0x06, 0x2b, 0x9c, 0x12, 0x39, 0x0f, 0x47, 0xa9, 0xa3, 0xa4, 0x32, 0x11, 0xd9, 0x77, 0x24,
+		0x27, 0x4e, 0x25, 0x67, 0xb1, 0x37, 0xe4, 0xfc, 0xa0, 0xec, 0x14, 0xe1, 0x94, 0x5f, 0x64, 0x55,
+		0xbb, 0xaf, 0x19, 0x16, 0xfd, 0x34, 0xb4, 0x6a, 0xd9, 0x7a, 0x8f, 0x3a, 0x97, 0xf3, 0xca, 0x49,
+		0x9f, 0x08, 0x37, 0x1c, 0x98, 0xba, 0xad, 0xf7, 0x88, 0x0e, 0xae, 0x2a, 0x53, 0xbb, 0x9a, 0xcd,
+		0x82, 0x48, 0x5d, 0x3c, 0xde, 0x38, 0xcf, 0x0f, 0x89, 0x4d, 0x33, 0x50, 0xd6, 0x38, 0xd6, 0x9a,
+		0xd9, 0xd2, 0x95, 0xa3, 0x83, 0x71, 0xd9, 0xe4, 0x6b, 0xe0, 0x2e, 0x6c, 0x2b, 0x0d, 0x84, 0xa8,
+		0x5d, 0x73, 0x97, 0xbe, 0xdd, 0xae, 0xf6, 0xf4, 0x3e, 0x3f, 0x30, 0x90, 0xbc, 0x03, 0x0b, 0x49,
+		0x1a, 0x6f, 0x86, 0x61, 0x05, 0x6b, 0x9c, 0xfe, 0xba, 0xde, 0x67, 0xdb, 0x64, 0x4d, 0xc0, 0xcf,
+		0xe1, 0x7b, 0x9d, 0xfd, 0xd4, 0x1d, 0xa8, 0x0f, 0x65, 0xd7, 0x63, 0x68, 0xae, 0xc3, 0x7d, 0xfe,
+		0x4a, 0x54, 0x7d, 0x4f, 0xef, 0xf6, 0xec, 0x91, 0x40, 0x83, 0x4c, 0x85, 0xec, 0x1e, 0x1f, 0x76,
+		0x99, 0x82, 0x0e, 0x85, 0x0e, 0x30, 0x50, 0x81, 0xa1, 0xe9, 0xa1, 0xd9, 0xbf, 0xe0, 0xb4, 0x5d,
+		0xba, 0xed, 0x25, 0x71, 0x01, 0xc3, 0xd3, 0xfe, 0xd9, 0xfe, 0x28, 0x2c, 0x61, 0xe4, 0x6c, 0x44,
+		0x02, 0x09, 0x15, 0x22, 0xf2, 0x92, 0xdf, 0xa5, 0x45, 0xed, 0x7c, 0x46, 0xac, 0x75, 0x37, 0x5f,
+		0xff, 0x99, 0xb3, 0x27, 0x3d, 0xb5, 0xee, 0x39, 0x7b, 0x3b, 0x4c, 0x5d, 0xe7, 0x7e, 0x4a, 0x02,
+		0xe2, 0x76, 0xcc, 0xb9, 0xc9, 0xb4, 0x46, 0xef, 0xb7, 0x08, 0xbe, 0x48, 0x07, 0x07, 0x76, 0x5c,
+		0x7c, 0x5f, 0x60, 0xc7, 0xb3, 0xc0, 0xbe, 0xdd, 0x35, 0xfb, 0xc4, 0xbb, 0x25, 0x63, 0x5e, 0x19,
+		0x5f, 0xc6, 0xf7, 0xbb, 0x7d, 0xab, 0x0d, 0x47, 0x72, 0xd6, 0xee, 0x23, 0xb9, 0xd7, 0x24, 0x3c,
+		0x83, 0x38, 0xb4, 0x42, 0x39, 0xcd, 0x6e, 0x02, 0xe9, 0xeb, 0xde, 0x71, 0xc6, 0x52, 0xde, 0xfc,
+		0xdb, 0x5b, 0xf0, 0x16, 0xfa, 0xc3, 0xa5, 0x5f, 0x2e, 0x1b, 0x96, 0xbf, 0x37, 0xfe, 0xdb, 0x12,
+		0x5e, 0x52, 0x6d, 0x8d, 0x0e, 0x49, 0x1d, 0x12, 0xde, 0xb6, 0xf0, 0x5e, 0xbd, 0x65, 0x96, 0x5e,
+		0x79, 0x3b, 0xe4, 0x23, 0x82, 0x7d, 0x11, 0xd2, 0xc4, 0x76, 0x9a, 0x1e, 0x9b, 0x99, 0x4b, 0xce,
+		0x2e, 0xfa, 0x38, 0xf3, 0x20, 0x44, 0x07, 0xeb, 0xdb, 0x03, 0x10, 0x5a, 0x37, 0xcd, 0x0e, 0xf9,
+		0x7a, 0x09, 0x16, 0x0c, 0xd3, 0xa6, 0xfa, 0x5e, 0x6f, 0xa9, 0x3c, 0x82, 0xcb, 0x2c, 0xac, 0x6b,
+		0x87, 0xe3, 0xde, 0x67, 0x5f, 0x3b, 0x3d, 0x4a, 0x6a, 0xdc, 0x03, 0xf1, 0x29, 0xc3, 0xb4, 0x0b,
+		0x14, 0x88, 0xaa, 0x1e, 0x8b, 0xbc, 0x0c, 0xf3, 0xfe, 0xfa, 0x99, 0x59, 0xa6, 0x1c, 0xba, 0xfe,
+		0xf9, 0xa9, 0x75, 0x27, 0x36, 0x3d, 0x15, 0xb3, 0x67, 0x9a, 0xff, 0x1a, 0x07, 0xf7, 0x3a, 0xc8,
+		0xd7, 0x86, 0xcf, 0x55, 0x97, 0x21, 0x72, 0xd8, 0x23, 0xda, 0x5e, 0x8e, 0x73, 0xdc, 0xb3, 0xbf,
+		0x20, 0x01, 0xb8, 0xf1, 0x72, 0x7c, 0x1b, 0xab, 0x50, 0xab, 0x96, 0xd4, 0x7a, 0x23, 0xdf, 0xd8,
+		0xa8, 0xfb, 0x3f, 0x0a, 0x22, 0x5e, 0xd2, 0xb2, 0x7a, 0x7a, 0xb3, 0xbd, 0xd5, 0xd6, 0x5b, 0xe4,
+		0x7e, 0x58, 0xf2, 0x43, 0x63, 0xaa, 0x5c, 0x92, 0xa5, 0x4c, 0xe2, 0xe6, 0xad, 0x6c, 0x94, 0x2d,
+		0x28, 0x3a, 0xbe, 0x43, 0x7a, 0x74, 0x14, 0x0e, 0x3f, 0x28, 0x12, 0xc8, 0xcc, 0xdf, 0xbc, 0x95,
+		0x8d, 0x39, 0x2b, 0x0f, 0xc9, 0x01, 0xf1, 0x42, 0x72, 0x7a, 0xc1, 0x0c, 0xdc, 0xbc, 0x95, 0x0d,
+		0xb3, 0x61, 0xc9, 0x84, 0xf0, 0xbd, 0xac, 0xb3, 0xff, 0x2c, 0xc1, 0xd1, 0xb1, 0x2b, 0x16, 0xa9,
+		0xc1, 0x03, 0x4e, 0x0d, 0xea, 0x5a, 0xbe, 0xb1, 0xa1, 0xe0, 0x47, 0xfc, 0xd7, 0x6a, 0xa5, 0xb2,
+		0x7a, 0xa5, 0x4c, 0x0f, 0x23, 0xe5, 0xab, 0x25, 0xb5, 0x51, 0x59, 0x2b, 0xcb, 0x47, 0x32, 0xb9,
+		0x9b, 0xb7, 0xb2, 0xa7, 0x46, 0xe8, 0x30, 0xa7, 0x2b, 0x6f, 0xb4, 0xa8, 0x29, 0x59, 0x82, 0x7b,
+		0x26, 0x11, 0x44, 0x2a, 0x6a, 0xad, 0xba, 0x7a, 0x5d, 0x96, 0x32, 0x77, 0xdf, 0xbc, 0x95, 0x3d,
+		0x31, 0x42, 0x0a, 0x29, 0xd4, 0x8c, 0xce, 0x3e, 0xb9, 0x02, 0xf7, 0x4e, 0x69, 0x16, 0xa5, 0x13,
+		0xc8, 0x9c, 0xbe, 0x79, 0x2b, 0x7b, 0x72, 0x42, 0x93, 0x90, 0x12, 0x67, 0xc0, 0x57, 0x03, 0x54,
+		0x8c, 0xad, 0xbe, 0xd6, 0xa4, 0x33, 0x32, 0x03, 0xc7, 0x2a, 0xd5, 0x4b, 0x4a, 0xbe, 0xd8, 0xa8,
+		0xd4, 0xaa, 0xfe, 0x71, 0x1b, 0x2a, 0x2b, 0xd5, 0x36, 0x0a, 0xab, 0x65, 0x15, 0x9f, 0x28, 0x63,
+		0x07, 0xb4, 0x7c, 0x65, 0xcf, 0x57, 0x29, 0x63, 0x02, 0x85, 0x4b, 0x13, 0x8f, 0x08, 0x3c, 0x7c,
+		0xa0, 0xc4, 0xbb, 0x4b, 0x85, 0xef, 0x9c, 0xc0, 0xff, 0x1e, 0x00, 0xc1, 0x9e, 0x77, 0xfd, 0xc6,
+		0xb5, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2329,11 +2900,42 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.EnableEvm != that1.EnableEvm {
 		return false
 	}
-	return true
-}
-func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+	if this.CommissionChangeInterval != that1.CommissionChangeInterval {
+		return false
+	}
+	if this.PowerHistoryEntries != that1.PowerHistoryEntries {
+		return false
+	}
+	if this.MaxValidatorsTransitionStep != that1.MaxValidatorsTransitionStep {
+		return false
+	}
+	if this.UnbondingMaturityMode != that1.UnbondingMaturityMode {
+		return false
+	}
+	if !this.MaxTokenMovementPerBlock.Equal(that1.MaxTokenMovementPerBlock) {
+		return false
+	}
+	if !this.MinDelegation.Equal(that1.MinDelegation) {
+		return false
+	}
+	if this.MinDelegationExemptSelfDelegation != that1.MinDelegationExemptSelfDelegation {
+		return false
+	}
+	if that1.MaxCommissionRate == nil {
+		if this.MaxCommissionRate != nil {
+			return false
+		}
+	} else if !this.MaxCommissionRate.Equal(*that1.MaxCommissionRate) {
+		return false
+	}
+	if this.JailHistoryEntries != that1.JailHistoryEntries {
+		return false
+	}
+	return true
+}
+func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
 
 	that1, ok := that.(*RedelegationEntryResponse)
@@ -2526,6 +3128,84 @@ func (m *Commission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CommissionScheduleEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionScheduleEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionScheduleEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintStaking(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CommissionSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Description) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2605,20 +3285,20 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.UnbondingIds) > 0 {
-		dAtA5 := make([]byte, len(m.UnbondingIds)*10)
-		var j4 int
+		dAtA6 := make([]byte, len(m.UnbondingIds)*10)
+		var j5 int
 		for _, num := range m.UnbondingIds {
 			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j4++
+				j5++
 			}
-			dAtA5[j4] = uint8(num)
-			j4++
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintStaking(dAtA, i, uint64(j4))
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintStaking(dAtA, i, uint64(j5))
 		i--
 		dAtA[i] = 0x6a
 	}
@@ -2647,12 +3327,12 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x52
-	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime):])
-	if err7 != nil {
-		return 0, err7
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnbondingTime):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n7
-	i = encodeVarintStaking(dAtA, i, uint64(n7))
+	i -= n8
+	i = encodeVarintStaking(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x4a
 	if m.UnbondingHeight != 0 {
@@ -2759,6 +3439,148 @@ func (m *ValAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PowerHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PowerHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PowerHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPowerHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPowerHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPowerHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JailHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JailHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JailHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorJailHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorJailHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorJailHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DVPair) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3062,12 +3884,12 @@ func (m *UnbondingDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	}
 	i--
 	dAtA[i] = 0x1a
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err10 != nil {
-		return 0, err10
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n10
-	i = encodeVarintStaking(dAtA, i, uint64(n10))
+	i -= n11
+	i = encodeVarintStaking(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -3128,12 +3950,12 @@ func (m *RedelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
-	if err11 != nil {
-		return 0, err11
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n11
-	i = encodeVarintStaking(dAtA, i, uint64(n11))
+	i -= n12
+	i = encodeVarintStaking(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0x12
 	if m.CreationHeight != 0 {
@@ -3222,45 +4044,121 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.EnableEvm {
+	if m.JailHistoryEntries != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.JailHistoryEntries))
 		i--
-		if m.EnableEvm {
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxCommissionRate != nil {
+		{
+			size := m.MaxCommissionRate.Size()
+			i -= size
+			if _, err := m.MaxCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.MinDelegationExemptSelfDelegation {
+		i--
+		if m.MinDelegationExemptSelfDelegation {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
 	{
-		size := m.MaxBondAmount.Size()
+		size := m.MinDelegation.Size()
 		i -= size
-		if _, err := m.MaxBondAmount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MinDelegation.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x42
+	dAtA[i] = 0x7a
 	{
-		size := m.MinBondAmount.Size()
+		size := m.MaxTokenMovementPerBlock.Size()
 		i -= size
-		if _, err := m.MinBondAmount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.MaxTokenMovementPerBlock.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.MinCommissionRate.Size()
-		i -= size
-		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	dAtA[i] = 0x72
+	if m.UnbondingMaturityMode != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingMaturityMode))
+		i--
+		dAtA[i] = 0x68
 	}
-	i--
+	if m.MaxValidatorsTransitionStep != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.MaxValidatorsTransitionStep))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.PowerHistoryEntries != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.PowerHistoryEntries))
+		i--
+		dAtA[i] = 0x58
+	}
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CommissionChangeInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CommissionChangeInterval):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintStaking(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x52
+	if m.EnableEvm {
+		i--
+		if m.EnableEvm {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.MaxBondAmount.Size()
+		i -= size
+		if _, err := m.MaxBondAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MinBondAmount.Size()
+		i -= size
+		if _, err := m.MinBondAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MinCommissionRate.Size()
+		i -= size
+		if _, err := m.MinCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
 	dAtA[i] = 0x32
 	if len(m.BondDenom) > 0 {
 		i -= len(m.BondDenom)
@@ -3284,12 +4182,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err12 != nil {
-		return 0, err12
+	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err14 != nil {
+		return 0, err14
 	}
-	i -= n12
-	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i -= n14
+	i = encodeVarintStaking(dAtA, i, uint64(n14))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -3564,6 +4462,34 @@ func (m *Commission) Size() (n int) {
 	return n
 }
 
+func (m *CommissionScheduleEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.Rate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *CommissionSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Description) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3656,6 +4582,67 @@ func (m *ValAddresses) Size() (n int) {
 	return n
 }
 
+func (m *PowerHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	if m.Power != 0 {
+		n += 1 + sovStaking(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *ValidatorPowerHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JailHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorJailHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *DVPair) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3871,6 +4858,31 @@ func (m *Params) Size() (n int) {
 	if m.EnableEvm {
 		n += 2
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CommissionChangeInterval)
+	n += 1 + l + sovStaking(uint64(l))
+	if m.PowerHistoryEntries != 0 {
+		n += 1 + sovStaking(uint64(m.PowerHistoryEntries))
+	}
+	if m.MaxValidatorsTransitionStep != 0 {
+		n += 1 + sovStaking(uint64(m.MaxValidatorsTransitionStep))
+	}
+	if m.UnbondingMaturityMode != 0 {
+		n += 1 + sovStaking(uint64(m.UnbondingMaturityMode))
+	}
+	l = m.MaxTokenMovementPerBlock.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.MinDelegation.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	if m.MinDelegationExemptSelfDelegation {
+		n += 3
+	}
+	if m.MaxCommissionRate != nil {
+		l = m.MaxCommissionRate.Size()
+		n += 2 + l + sovStaking(uint64(l))
+	}
+	if m.JailHistoryEntries != 0 {
+		n += 2 + sovStaking(uint64(m.JailHistoryEntries))
+	}
 	return n
 }
 
@@ -4354,7 +5366,7 @@ func (m *Commission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Description) Unmarshal(dAtA []byte) error {
+func (m *CommissionScheduleEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4377,49 +5389,17 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommissionScheduleEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommissionScheduleEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4429,59 +5409,28 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Website = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4509,39 +5458,9 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SecurityContact = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStaking
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStaking
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Details = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4564,7 +5483,7 @@ func (m *Description) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validator) Unmarshal(dAtA []byte) error {
+func (m *CommissionSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4587,17 +5506,17 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommissionSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommissionSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4607,29 +5526,81 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Entries = append(m.Entries, CommissionScheduleEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Description) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Description: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Description: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4639,33 +5610,29 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsensusPubkey == nil {
-				m.ConsensusPubkey = &types1.Any{}
-			}
-			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4675,34 +5642,27 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Jailed = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
 			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= BondStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
 			}
-		case 5:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Website", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4730,13 +5690,11 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Website = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecurityContact", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4764,15 +5722,13 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SecurityContact = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4782,49 +5738,79 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Details = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
 			}
-			m.UnbondingHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStaking
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UnbondingHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 9:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Validator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Validator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Validator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4834,28 +5820,27 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusPubkey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4882,15 +5867,18 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ConsensusPubkey == nil {
+				m.ConsensusPubkey = &types1.Any{}
+			}
+			if err := m.ConsensusPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -4900,13 +5888,238 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.Jailed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BondStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingHeight", wireType)
+			}
+			m.UnbondingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSelfDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStaking
 			}
 			postIndex := iNdEx + intStringLen
@@ -5012,9 +6225,364 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 					}
 					m.UnbondingIds = append(m.UnbondingIds, v)
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingIds", wireType)
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PowerHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PowerHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PowerHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPowerHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPowerHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPowerHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, PowerHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JailHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JailHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JailHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -5036,7 +6604,7 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValAddresses) Unmarshal(dAtA []byte) error {
+func (m *ValidatorJailHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5059,17 +6627,17 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValAddresses: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorJailHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorJailHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStaking
@@ -5079,23 +6647,25 @@ func (m *ValAddresses) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStaking
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStaking
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Entries = append(m.Entries, JailHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6711,6 +8281,239 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableEvm = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionChangeInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CommissionChangeInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerHistoryEntries", wireType)
+			}
+			m.PowerHistoryEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PowerHistoryEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorsTransitionStep", wireType)
+			}
+			m.MaxValidatorsTransitionStep = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidatorsTransitionStep |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingMaturityMode", wireType)
+			}
+			m.UnbondingMaturityMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingMaturityMode |= UnbondingMaturityMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTokenMovementPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTokenMovementPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegationExemptSelfDelegation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MinDelegationExemptSelfDelegation = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxCommissionRate = &v
+			if err := m.MaxCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailHistoryEntries", wireType)
+			}
+			m.JailHistoryEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailHistoryEntries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
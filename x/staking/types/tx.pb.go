@@ -655,76 +655,76 @@ func init() {
 func init() { proto.RegisterFile("cosmos/staking/v1beta1/tx.proto", fileDescriptor_0926ef28816b35ab) }
 
 var fileDescriptor_0926ef28816b35ab = []byte{
-	// 1100 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x3a, 0x89, 0x21, 0x13, 0x92, 0x34, 0x9b, 0xa4, 0xb5, 0x97, 0x62, 0x57, 0xdb, 0xd0,
-	0x44, 0x81, 0xac, 0x69, 0x40, 0xfc, 0x30, 0x11, 0x22, 0xae, 0x5b, 0x51, 0xc0, 0x52, 0xb4, 0xa1,
-	0x3d, 0x20, 0x24, 0x6b, 0xbc, 0x3b, 0x59, 0xaf, 0xec, 0xdd, 0xd9, 0xee, 0x8c, 0xa3, 0xfa, 0x86,
-	0x38, 0x21, 0x2e, 0x54, 0x88, 0x03, 0x17, 0xa4, 0x1e, 0x39, 0xe6, 0xd0, 0x03, 0xff, 0x00, 0x52,
-	0xc5, 0xa9, 0xea, 0x09, 0x71, 0x68, 0x51, 0x72, 0x08, 0xff, 0x01, 0x57, 0xb4, 0xbb, 0xb3, 0x3f,
-	0x6d, 0xaf, 0x37, 0x55, 0x7b, 0x80, 0x4b, 0xbc, 0x79, 0xf3, 0xbd, 0x6f, 0x66, 0xbe, 0x6f, 0xde,
-	0xcc, 0x03, 0x15, 0x05, 0x13, 0x03, 0x93, 0x2a, 0xa1, 0xb0, 0xab, 0x9b, 0x5a, 0xf5, 0xf0, 0x6a,
-	0x1b, 0x51, 0x78, 0xb5, 0x4a, 0xef, 0x4a, 0x96, 0x8d, 0x29, 0xe6, 0xcf, 0x7b, 0x00, 0x89, 0x01,
-	0x24, 0x06, 0x10, 0x4a, 0x1a, 0xc6, 0x5a, 0x0f, 0x55, 0x5d, 0x54, 0xbb, 0x7f, 0x50, 0x85, 0xe6,
-	0xc0, 0x4b, 0x11, 0x2a, 0xc9, 0x21, 0xaa, 0x1b, 0x88, 0x50, 0x68, 0x58, 0x0c, 0xb0, 0xa2, 0x61,
-	0x0d, 0xbb, 0x9f, 0x55, 0xe7, 0x8b, 0x45, 0x4b, 0xde, 0x4c, 0x2d, 0x6f, 0x80, 0x4d, 0xeb, 0x0d,
-	0x95, 0xd9, 0x2a, 0xdb, 0x90, 0xa0, 0x60, 0x89, 0x0a, 0xd6, 0x4d, 0x36, 0xbe, 0x36, 0x66, 0x17,
-	0xfe, 0xa2, 0x3d, 0xd4, 0x05, 0x86, 0x32, 0x88, 0x83, 0x70, 0x7e, 0xd8, 0xc0, 0x12, 0x34, 0x74,
-	0x13, 0x57, 0xdd, 0xbf, 0x5e, 0x48, 0xfc, 0x61, 0x06, 0xf0, 0x4d, 0xa2, 0x5d, 0xb3, 0x11, 0xa4,
-	0xe8, 0x36, 0xec, 0xe9, 0x2a, 0xa4, 0xd8, 0xe6, 0xf7, 0xc0, 0x9c, 0x8a, 0x88, 0x62, 0xeb, 0x16,
-	0xd5, 0xb1, 0x59, 0xe4, 0x2e, 0x71, 0x1b, 0x73, 0xdb, 0x97, 0xa5, 0xd1, 0x1a, 0x49, 0x8d, 0x10,
-	0x5a, 0x9f, 0x7d, 0xf8, 0xa4, 0x92, 0xfb, 0xe5, 0xf4, 0x68, 0x93, 0x93, 0xa3, 0x14, 0xbc, 0x0c,
-	0x80, 0x82, 0x0d, 0x43, 0x27, 0xc4, 0x21, 0xcc, 0xbb, 0x84, 0xeb, 0xe3, 0x08, 0xaf, 0x05, 0x48,
-	0x19, 0x52, 0x44, 0xa2, 0xa4, 0x11, 0x16, 0xbe, 0x07, 0x96, 0x0d, 0xdd, 0x6c, 0x11, 0xd4, 0x3b,
-	0x68, 0xa9, 0xa8, 0x87, 0x34, 0xe8, 0xae, 0x76, 0xea, 0x12, 0xb7, 0x31, 0x5b, 0xdf, 0x71, 0x72,
-	0xfe, 0x7c, 0x52, 0xb9, 0xa2, 0xe9, 0xb4, 0xd3, 0x6f, 0x4b, 0x0a, 0x36, 0x98, 0xd8, 0xec, 0x67,
-	0x8b, 0xa8, 0xdd, 0x2a, 0x1d, 0x58, 0x88, 0x48, 0x37, 0x4d, 0xfa, 0xf8, 0xc1, 0x16, 0x60, 0xab,
-	0xb9, 0x69, 0x52, 0x79, 0xc9, 0xd0, 0xcd, 0x7d, 0xd4, 0x3b, 0x68, 0x04, 0xb4, 0xfc, 0x75, 0xb0,
-	0xc4, 0x26, 0xc1, 0x76, 0x0b, 0xaa, 0xaa, 0x8d, 0x08, 0x29, 0x4e, 0xbb, 0x73, 0x15, 0x1f, 0x3f,
-	0xd8, 0x5a, 0x61, 0xd9, 0xbb, 0xde, 0xc8, 0x3e, 0xb5, 0x75, 0x53, 0x93, 0xcf, 0x05, 0x29, 0x2c,
-	0xee, 0xd0, 0x1c, 0xfa, 0x3a, 0x07, 0x34, 0x33, 0x93, 0x68, 0x82, 0x14, 0x9f, 0xe6, 0x06, 0x28,
-	0x58, 0xfd, 0x76, 0x17, 0x0d, 0x8a, 0x05, 0x57, 0xcb, 0x15, 0xc9, 0x3b, 0x8d, 0x92, 0x7f, 0x1a,
-	0xa5, 0x5d, 0x73, 0x50, 0x2f, 0xfe, 0x1e, 0x32, 0x2a, 0xf6, 0xc0, 0xa2, 0x58, 0xda, 0xeb, 0xb7,
-	0x3f, 0x43, 0x03, 0x99, 0x65, 0xf3, 0x35, 0x30, 0x73, 0x08, 0x7b, 0x7d, 0x54, 0x7c, 0xc9, 0xa5,
-	0x29, 0xf9, 0x96, 0x38, 0x47, 0x30, 0xe2, 0x87, 0x1e, 0x73, 0xd6, 0x4b, 0xa9, 0xdd, 0xfe, 0xf6,
-	0x7e, 0x25, 0xf7, 0xf7, 0xfd, 0x4a, 0xee, 0x9b, 0xd3, 0xa3, 0xcd, 0x61, 0x71, 0xdc, 0xe8, 0xd0,
-	0x5e, 0xbf, 0x3b, 0x3d, 0xda, 0x7c, 0x2d, 0xe2, 0xc0, 0xf0, 0xe9, 0x13, 0x2f, 0x02, 0x61, 0x38,
-	0x2a, 0x23, 0x62, 0x61, 0x93, 0x20, 0xf1, 0xd7, 0x29, 0x70, 0xae, 0x49, 0xb4, 0xeb, 0xaa, 0x4e,
-	0x5f, 0xe4, 0x81, 0x1d, 0xe9, 0x53, 0xfe, 0xcc, 0x3e, 0x41, 0xb0, 0x18, 0x9e, 0xd8, 0x96, 0x0d,
-	0x29, 0x62, 0xe7, 0xf3, 0xfd, 0x8c, 0x67, 0xb3, 0x81, 0x94, 0xc8, 0xd9, 0x6c, 0x20, 0x45, 0x5e,
-	0x50, 0x62, 0xe5, 0xc1, 0x77, 0x46, 0x97, 0xc1, 0xf4, 0x99, 0xa6, 0xc9, 0x52, 0x02, 0xb5, 0x8f,
-	0x62, 0x86, 0x8f, 0xb4, 0xf6, 0xd5, 0xb8, 0xb5, 0x31, 0x97, 0x44, 0x01, 0x14, 0x93, 0xb1, 0xc0,
-	0xd6, 0x1f, 0xf3, 0x60, 0xae, 0x49, 0x34, 0x36, 0x1b, 0x1a, 0x5d, 0x6e, 0xdc, 0xf3, 0x29, 0xb7,
-	0xb3, 0xdb, 0xb8, 0x03, 0x0a, 0xd0, 0xc0, 0x7d, 0x93, 0xba, 0xee, 0x65, 0xad, 0x13, 0x96, 0x53,
-	0xfb, 0x20, 0xbd, 0x50, 0x1c, 0xdd, 0xce, 0xc7, 0x75, 0xf3, 0x65, 0x10, 0x57, 0xc1, 0x72, 0xe4,
-	0xdf, 0x40, 0xad, 0x7f, 0xf2, 0xee, 0xbd, 0x5d, 0x47, 0x9a, 0x6e, 0xca, 0x48, 0x7d, 0xce, 0xa2,
-	0x7d, 0x0e, 0x56, 0x43, 0xd1, 0x88, 0xad, 0x64, 0x16, 0x6e, 0x39, 0x48, 0xdb, 0xb7, 0x95, 0x91,
-	0x6c, 0x2a, 0xa1, 0x01, 0xdb, 0x54, 0x66, 0xb6, 0x06, 0xa1, 0xc3, 0x4e, 0x4c, 0x3f, 0x83, 0x13,
-	0x1f, 0x4f, 0x76, 0x22, 0x71, 0x39, 0x25, 0x24, 0x16, 0x2d, 0xf7, 0x72, 0x4a, 0x44, 0x7d, 0x5f,
-	0x78, 0xd9, 0x2d, 0x77, 0xab, 0x87, 0x9c, 0x7a, 0x69, 0x39, 0x0d, 0x01, 0xbb, 0x8b, 0x84, 0xa1,
-	0xfb, 0xf9, 0x0b, 0xbf, 0x5b, 0xa8, 0xcf, 0x3b, 0xeb, 0xbc, 0xf7, 0xb4, 0xc2, 0x79, 0x6b, 0x5d,
-	0x08, 0x19, 0x1c, 0x8c, 0xf8, 0x53, 0x1e, 0xcc, 0x37, 0x89, 0x76, 0xcb, 0x54, 0xff, 0x8f, 0xb5,
-	0xf1, 0xe1, 0x64, 0x47, 0x8a, 0x71, 0x47, 0x42, 0x21, 0xc4, 0x2e, 0x58, 0x8d, 0x05, 0x5e, 0xa8,
-	0x0f, 0x4f, 0xf3, 0xe0, 0xa2, 0xf3, 0x2e, 0x41, 0x53, 0x41, 0xbd, 0x5b, 0x66, 0x1b, 0x9b, 0xaa,
-	0x6e, 0x6a, 0x93, 0x3a, 0x84, 0xff, 0xa6, 0x2d, 0xfc, 0x3a, 0x58, 0x54, 0x9c, 0x07, 0xd8, 0x91,
-	0xaf, 0x83, 0x74, 0xad, 0xe3, 0xd5, 0xdb, 0x94, 0xbc, 0xe0, 0x87, 0x3f, 0x71, 0xa3, 0xb5, 0x4f,
-	0x27, 0xfb, 0xb7, 0x9e, 0x78, 0xee, 0xc7, 0x09, 0x28, 0x5e, 0x01, 0x6b, 0x69, 0xe3, 0xc1, 0xed,
-	0xf7, 0x1b, 0x07, 0x16, 0x1d, 0xdf, 0x2d, 0x15, 0x52, 0xb4, 0x07, 0x6d, 0x68, 0x10, 0xfe, 0x5d,
-	0x30, 0x0b, 0xfb, 0xb4, 0x83, 0x6d, 0x9d, 0x0e, 0x26, 0x8a, 0x1e, 0x42, 0xf9, 0x5d, 0x50, 0xb0,
-	0x5c, 0x06, 0xd6, 0x94, 0x96, 0xc7, 0x35, 0x0d, 0xde, 0x3c, 0x31, 0xad, 0xbc, 0xc4, 0xda, 0x7b,
-	0xce, 0xd6, 0x43, 0x4a, 0x67, 0xcb, 0x6b, 0x91, 0x2d, 0xdf, 0x0d, 0xfa, 0xf5, 0xc4, 0x9a, 0xc5,
-	0x12, 0xb8, 0x90, 0x08, 0xf9, 0x5b, 0xdc, 0xfe, 0xb9, 0x00, 0xa6, 0x9a, 0x44, 0xe3, 0xef, 0x80,
-	0xc5, 0x64, 0x73, 0xbe, 0x39, 0x6e, 0x85, 0xc3, 0x4d, 0x93, 0xb0, 0x9d, 0x1d, 0x1b, 0xd4, 0x4e,
-	0x17, 0xcc, 0xc7, 0x9b, 0xab, 0x8d, 0x14, 0x92, 0x18, 0x52, 0x78, 0x2b, 0x2b, 0x32, 0x98, 0xec,
-	0x2b, 0xf0, 0x72, 0xf0, 0xe4, 0x5f, 0x4e, 0xc9, 0xf6, 0x41, 0xc2, 0x1b, 0x19, 0x40, 0x01, 0xfb,
-	0x1d, 0xb0, 0x98, 0x7c, 0x22, 0xd3, 0xd4, 0x4b, 0x60, 0x53, 0xd5, 0x1b, 0xf7, 0x02, 0xb4, 0x01,
-	0x88, 0xdc, 0xd4, 0xaf, 0xa7, 0x30, 0x84, 0x30, 0x61, 0x2b, 0x13, 0x2c, 0x98, 0xe3, 0x7b, 0x0e,
-	0x94, 0xc6, 0x5f, 0x43, 0xef, 0xa4, 0x79, 0x3e, 0x2e, 0x4b, 0xd8, 0x79, 0x96, 0xac, 0x60, 0x45,
-	0x1d, 0xf0, 0x4a, 0xac, 0x1a, 0xd7, 0xd3, 0x36, 0x14, 0x01, 0x0a, 0xd5, 0x8c, 0x40, 0x7f, 0x26,
-	0x61, 0xe6, 0x6b, 0xa7, 0xf6, 0xea, 0x37, 0x1e, 0x1e, 0x97, 0xb9, 0x47, 0xc7, 0x65, 0xee, 0xaf,
-	0xe3, 0x32, 0x77, 0xef, 0xa4, 0x9c, 0x7b, 0x74, 0x52, 0xce, 0xfd, 0x71, 0x52, 0xce, 0x7d, 0xf9,
-	0x66, 0x6a, 0xb7, 0x1b, 0x16, 0xa3, 0xdb, 0xf7, 0xb6, 0x0b, 0xee, 0x3b, 0xf0, 0xf6, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xa0, 0x87, 0x6e, 0xf3, 0x21, 0x10, 0x00, 0x00,
+	// 1096 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xda, 0x89, 0x21, 0x13, 0x12, 0x37, 0x9b, 0xa4, 0xb5, 0x97, 0x62, 0x57, 0xdb, 0xd0,
+	0x44, 0x81, 0xac, 0x69, 0x40, 0x7c, 0x98, 0x08, 0x11, 0xd7, 0xad, 0x28, 0x60, 0x29, 0xda, 0xd0,
+	0x1e, 0x10, 0x92, 0x35, 0xde, 0x9d, 0xac, 0x57, 0xf6, 0xee, 0x6c, 0x77, 0xc6, 0x51, 0x7d, 0x43,
+	0x9c, 0x10, 0x17, 0x2a, 0xc4, 0x81, 0x0b, 0x52, 0x8f, 0x1c, 0x73, 0xe8, 0x81, 0x7f, 0x00, 0xa9,
+	0xe2, 0x54, 0xf5, 0x84, 0x38, 0xb4, 0x28, 0x39, 0x84, 0xff, 0x80, 0x2b, 0xda, 0xdd, 0xd9, 0x4f,
+	0x7f, 0x6d, 0xaa, 0xf6, 0x00, 0x97, 0x78, 0xf3, 0xe6, 0xf7, 0x7e, 0xef, 0xcd, 0xfb, 0xcd, 0x9b,
+	0x79, 0xa0, 0xa2, 0x60, 0x62, 0x60, 0x52, 0x25, 0x14, 0x76, 0x75, 0x53, 0xab, 0x1e, 0x5e, 0x6d,
+	0x23, 0x0a, 0xaf, 0x56, 0xe9, 0x5d, 0xc9, 0xb2, 0x31, 0xc5, 0xfc, 0x79, 0x0f, 0x20, 0x31, 0x80,
+	0xc4, 0x00, 0x42, 0x49, 0xc3, 0x58, 0xeb, 0xa1, 0xaa, 0x8b, 0x6a, 0xf7, 0x0f, 0xaa, 0xd0, 0x1c,
+	0x78, 0x2e, 0x42, 0x25, 0xb9, 0x44, 0x75, 0x03, 0x11, 0x0a, 0x0d, 0x8b, 0x01, 0x56, 0x34, 0xac,
+	0x61, 0xf7, 0xb3, 0xea, 0x7c, 0x31, 0x6b, 0xc9, 0x8b, 0xd4, 0xf2, 0x16, 0x58, 0x58, 0x6f, 0xa9,
+	0xcc, 0xb2, 0x6c, 0x43, 0x82, 0x82, 0x14, 0x15, 0xac, 0x9b, 0x6c, 0x7d, 0x6d, 0xcc, 0x2e, 0xfc,
+	0xa4, 0x3d, 0xd4, 0x05, 0x86, 0x32, 0x88, 0x83, 0x70, 0x7e, 0xd8, 0xc2, 0x12, 0x34, 0x74, 0x13,
+	0x57, 0xdd, 0xbf, 0x9e, 0x49, 0xfc, 0x61, 0x16, 0xf0, 0x4d, 0xa2, 0x5d, 0xb3, 0x11, 0xa4, 0xe8,
+	0x36, 0xec, 0xe9, 0x2a, 0xa4, 0xd8, 0xe6, 0xf7, 0xc0, 0xbc, 0x8a, 0x88, 0x62, 0xeb, 0x16, 0xd5,
+	0xb1, 0x59, 0xe4, 0x2e, 0x71, 0x1b, 0xf3, 0xdb, 0x97, 0xa5, 0xd1, 0x35, 0x92, 0x1a, 0x21, 0xb4,
+	0x3e, 0xf7, 0xf0, 0x49, 0x25, 0xf3, 0xcb, 0xe9, 0xd1, 0x26, 0x27, 0x47, 0x29, 0x78, 0x19, 0x00,
+	0x05, 0x1b, 0x86, 0x4e, 0x88, 0x43, 0x98, 0x75, 0x09, 0xd7, 0xc7, 0x11, 0x5e, 0x0b, 0x90, 0x32,
+	0xa4, 0x88, 0x44, 0x49, 0x23, 0x2c, 0x7c, 0x0f, 0x2c, 0x1b, 0xba, 0xd9, 0x22, 0xa8, 0x77, 0xd0,
+	0x52, 0x51, 0x0f, 0x69, 0xd0, 0xcd, 0x36, 0x77, 0x89, 0xdb, 0x98, 0xab, 0xef, 0x38, 0x3e, 0x7f,
+	0x3e, 0xa9, 0x5c, 0xd1, 0x74, 0xda, 0xe9, 0xb7, 0x25, 0x05, 0x1b, 0xac, 0xd8, 0xec, 0x67, 0x8b,
+	0xa8, 0xdd, 0x2a, 0x1d, 0x58, 0x88, 0x48, 0x37, 0x4d, 0xfa, 0xf8, 0xc1, 0x16, 0x60, 0xd9, 0xdc,
+	0x34, 0xa9, 0xbc, 0x64, 0xe8, 0xe6, 0x3e, 0xea, 0x1d, 0x34, 0x02, 0x5a, 0xfe, 0x3a, 0x58, 0x62,
+	0x41, 0xb0, 0xdd, 0x82, 0xaa, 0x6a, 0x23, 0x42, 0x8a, 0x33, 0x6e, 0xac, 0xe2, 0xe3, 0x07, 0x5b,
+	0x2b, 0xcc, 0x7b, 0xd7, 0x5b, 0xd9, 0xa7, 0xb6, 0x6e, 0x6a, 0xf2, 0xb9, 0xc0, 0x85, 0xd9, 0x1d,
+	0x9a, 0x43, 0xbf, 0xce, 0x01, 0xcd, 0xec, 0x34, 0x9a, 0xc0, 0xc5, 0xa7, 0xb9, 0x01, 0xf2, 0x56,
+	0xbf, 0xdd, 0x45, 0x83, 0x62, 0xde, 0xad, 0xe5, 0x8a, 0xe4, 0x9d, 0x46, 0xc9, 0x3f, 0x8d, 0xd2,
+	0xae, 0x39, 0xa8, 0x17, 0x7f, 0x0f, 0x19, 0x15, 0x7b, 0x60, 0x51, 0x2c, 0xed, 0xf5, 0xdb, 0x9f,
+	0xa1, 0x81, 0xcc, 0xbc, 0xf9, 0x1a, 0x98, 0x3d, 0x84, 0xbd, 0x3e, 0x2a, 0xbe, 0xe4, 0xd2, 0x94,
+	0x7c, 0x49, 0x9c, 0x23, 0x18, 0xd1, 0x43, 0x8f, 0x29, 0xeb, 0xb9, 0xd4, 0x6e, 0x7f, 0x7b, 0xbf,
+	0x92, 0xf9, 0xfb, 0x7e, 0x25, 0xf3, 0xcd, 0xe9, 0xd1, 0xe6, 0x70, 0x71, 0x5c, 0xeb, 0xd0, 0x5e,
+	0xbf, 0x3b, 0x3d, 0xda, 0x7c, 0x2d, 0xa2, 0xc0, 0xf0, 0xe9, 0x13, 0x2f, 0x02, 0x61, 0xd8, 0x2a,
+	0x23, 0x62, 0x61, 0x93, 0x20, 0xf1, 0xd7, 0x1c, 0x38, 0xd7, 0x24, 0xda, 0x75, 0x55, 0xa7, 0x2f,
+	0xf2, 0xc0, 0x8e, 0xd4, 0x29, 0x7b, 0x66, 0x9d, 0x20, 0x28, 0x84, 0x27, 0xb6, 0x65, 0x43, 0x8a,
+	0xd8, 0xf9, 0x7c, 0x3f, 0xe5, 0xd9, 0x6c, 0x20, 0x25, 0x72, 0x36, 0x1b, 0x48, 0x91, 0x17, 0x95,
+	0x58, 0x7b, 0xf0, 0x9d, 0xd1, 0x6d, 0x30, 0x73, 0xa6, 0x30, 0x69, 0x5a, 0xa0, 0xf6, 0x51, 0x4c,
+	0xf0, 0x91, 0xd2, 0xbe, 0x1a, 0x97, 0x36, 0xa6, 0x92, 0x28, 0x80, 0x62, 0xd2, 0x16, 0xc8, 0xfa,
+	0x63, 0x16, 0xcc, 0x37, 0x89, 0xc6, 0xa2, 0xa1, 0xd1, 0xed, 0xc6, 0x3d, 0x9f, 0x76, 0x3b, 0xbb,
+	0x8c, 0x3b, 0x20, 0x0f, 0x0d, 0xdc, 0x37, 0x69, 0x31, 0x77, 0x86, 0x3e, 0x61, 0x3e, 0xb5, 0x0f,
+	0x26, 0x37, 0x8a, 0x53, 0xb7, 0xf3, 0xf1, 0xba, 0xf9, 0x65, 0x10, 0x57, 0xc1, 0x72, 0xe4, 0xdf,
+	0xa0, 0x5a, 0xff, 0x64, 0xdd, 0x7b, 0xbb, 0x8e, 0x34, 0xdd, 0x94, 0x91, 0xfa, 0x9c, 0x8b, 0xf6,
+	0x39, 0x58, 0x0d, 0x8b, 0x46, 0x6c, 0x25, 0x75, 0xe1, 0x96, 0x03, 0xb7, 0x7d, 0x5b, 0x19, 0xc9,
+	0xa6, 0x12, 0x1a, 0xb0, 0xe5, 0x52, 0xb3, 0x35, 0x08, 0x1d, 0x56, 0x62, 0xe6, 0x19, 0x94, 0xf8,
+	0x78, 0xba, 0x12, 0x89, 0xcb, 0x29, 0x51, 0x62, 0xd1, 0x02, 0xc2, 0xb0, 0xd5, 0xd7, 0x85, 0x97,
+	0xdd, 0x76, 0xb7, 0x7a, 0xc8, 0xe9, 0x97, 0x96, 0x33, 0x10, 0xb0, 0xbb, 0x48, 0x18, 0xba, 0x9f,
+	0xbf, 0xf0, 0xa7, 0x85, 0xfa, 0x82, 0x93, 0xe7, 0xbd, 0xa7, 0x15, 0xce, 0xcb, 0x75, 0x31, 0x64,
+	0x70, 0x30, 0xe2, 0x4f, 0x59, 0xb0, 0xd0, 0x24, 0xda, 0x2d, 0x53, 0xfd, 0x3f, 0xf6, 0xc6, 0x87,
+	0xd3, 0x15, 0x29, 0xc6, 0x15, 0x09, 0x0b, 0x21, 0x76, 0xc1, 0x6a, 0xcc, 0xf0, 0x42, 0x75, 0x78,
+	0x9a, 0x05, 0x17, 0x9d, 0x77, 0x09, 0x9a, 0x0a, 0xea, 0xdd, 0x32, 0xdb, 0xd8, 0x54, 0x75, 0x53,
+	0x9b, 0x36, 0x21, 0xfc, 0x37, 0x65, 0xe1, 0xd7, 0x41, 0x41, 0x71, 0x1e, 0x60, 0xa7, 0x7c, 0x1d,
+	0xa4, 0x6b, 0x1d, 0xaf, 0xdf, 0x72, 0xf2, 0xa2, 0x6f, 0xfe, 0xc4, 0xb5, 0xd6, 0x3e, 0x9d, 0xae,
+	0xdf, 0x7a, 0xe2, 0xb9, 0x1f, 0x57, 0x40, 0xf1, 0x0a, 0x58, 0x9b, 0xb4, 0x1e, 0xdc, 0x7e, 0xbf,
+	0x71, 0xa0, 0xe0, 0xe8, 0x6e, 0xa9, 0x90, 0xa2, 0x3d, 0x68, 0x43, 0x83, 0xf0, 0xef, 0x82, 0x39,
+	0xd8, 0xa7, 0x1d, 0x6c, 0xeb, 0x74, 0x30, 0xb5, 0xe8, 0x21, 0x94, 0xdf, 0x05, 0x79, 0xcb, 0x65,
+	0x60, 0x43, 0x69, 0x79, 0xdc, 0xd0, 0xe0, 0xc5, 0x89, 0xd5, 0xca, 0x73, 0xac, 0xbd, 0xe7, 0x6c,
+	0x3d, 0xa4, 0x74, 0xb6, 0xbc, 0x16, 0xd9, 0xf2, 0xdd, 0x60, 0x5e, 0x4f, 0xe4, 0x2c, 0x96, 0xc0,
+	0x85, 0x84, 0xc9, 0xdf, 0xe2, 0xf6, 0xcf, 0x79, 0x90, 0x6b, 0x12, 0x8d, 0xbf, 0x03, 0x0a, 0xc9,
+	0xe1, 0x7c, 0x73, 0x5c, 0x86, 0xc3, 0x43, 0x93, 0xb0, 0x9d, 0x1e, 0x1b, 0xf4, 0x4e, 0x17, 0x2c,
+	0xc4, 0x87, 0xab, 0x8d, 0x09, 0x24, 0x31, 0xa4, 0xf0, 0x56, 0x5a, 0x64, 0x10, 0xec, 0x2b, 0xf0,
+	0x72, 0xf0, 0xe4, 0x5f, 0x9e, 0xe0, 0xed, 0x83, 0x84, 0x37, 0x52, 0x80, 0x02, 0xf6, 0x3b, 0xa0,
+	0x90, 0x7c, 0x22, 0x27, 0x55, 0x2f, 0x81, 0x15, 0xb6, 0xd3, 0x63, 0x83, 0x90, 0x6d, 0x00, 0x22,
+	0x37, 0xf5, 0xeb, 0x13, 0x18, 0x42, 0x98, 0xb0, 0x95, 0x0a, 0x16, 0xc4, 0xf8, 0x9e, 0x03, 0xa5,
+	0xf1, 0xd7, 0xd0, 0x3b, 0x93, 0x34, 0x1f, 0xe7, 0x25, 0xec, 0x3c, 0x8b, 0x57, 0x90, 0x51, 0x07,
+	0xbc, 0x12, 0xeb, 0xc6, 0xf5, 0x49, 0x1b, 0x8a, 0x00, 0x85, 0x6a, 0x4a, 0xa0, 0x1f, 0x49, 0x98,
+	0xfd, 0xda, 0xe9, 0xbd, 0xfa, 0x8d, 0x87, 0xc7, 0x65, 0xee, 0xd1, 0x71, 0x99, 0xfb, 0xeb, 0xb8,
+	0xcc, 0xdd, 0x3b, 0x29, 0x67, 0x1e, 0x9d, 0x94, 0x33, 0x7f, 0x9c, 0x94, 0x33, 0x5f, 0xbe, 0x39,
+	0x71, 0xda, 0x0d, 0x9b, 0xd1, 0x9d, 0x7b, 0xdb, 0x79, 0xf7, 0x1d, 0x78, 0xfb, 0xdf, 0x01, 0x00,
+	0xa0, 0x87, 0x6e, 0xf3, 0x21, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
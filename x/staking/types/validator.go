@@ -36,6 +36,42 @@ var (
 	BondStatusBonded      = BondStatus_name[int32(Bonded)]
 )
 
+// ValidatorCreationPath identifies how a validator is registered.
+type ValidatorCreationPath int
+
+const (
+	// ValidatorCreationPathNative is used for validators created directly
+	// through MsgCreateValidator.
+	ValidatorCreationPathNative ValidatorCreationPath = iota
+	// ValidatorCreationPathEvm is used for validators registered through the
+	// EVM staking contract and created with CreateEvmValidator.
+	ValidatorCreationPathEvm
+)
+
+func (p ValidatorCreationPath) String() string {
+	switch p {
+	case ValidatorCreationPathNative:
+		return "native"
+	case ValidatorCreationPathEvm:
+		return "evm"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(p))
+	}
+}
+
+// Reasons recorded in the jail history of a validator.
+const (
+	// JailReasonInfraction is recorded for validators jailed by the slashing
+	// or evidence modules.
+	JailReasonInfraction = "infraction"
+	// JailReasonMinSelfDelegation is recorded for validators whose operator
+	// undelegated below the minimum self delegation.
+	JailReasonMinSelfDelegation = "min_self_delegation"
+	// JailReasonJailValidator is recorded for validators jailed by operator
+	// address through JailValidator.
+	JailReasonJailValidator = "jail_validator"
+)
+
 var _ ValidatorI = Validator{}
 
 // NewValidator constructs a new Validator